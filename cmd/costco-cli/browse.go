@@ -0,0 +1,327 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+)
+
+// Interactive browser for receipts and online orders
+
+// browsePageSize is how many transactions the browser lists per page.
+const browsePageSize = 15
+
+// browseSource is what the browser fetches from; *costco.Client satisfies it.
+type browseSource interface {
+	GetAllTransactionItems(ctx context.Context, startDate, endDate string) ([]costco.TransactionWithItems, error)
+	GetOnlineOrders(ctx context.Context, startDate, endDate string, pageNumber, pageSize int) (*costco.OnlineOrdersResponse, error)
+}
+
+// browseEntry is one row of the browser: a receipt or an online order.
+type browseEntry struct {
+	date    time.Time
+	receipt *costco.TransactionWithItems
+	order   *costco.OnlineOrder
+}
+
+func (e browseEntry) kind() string {
+	if e.order != nil {
+		return "order"
+	}
+	if e.receipt.DocumentType == costco.DocumentTypeFuel {
+		return "gas"
+	}
+	return "receipt"
+}
+
+func (e browseEntry) where() string {
+	if e.order != nil {
+		return "Order #" + e.order.OrderNumber
+	}
+	return fmt.Sprintf("%s #%d", e.receipt.WarehouseName, e.receipt.WarehouseNumber)
+}
+
+func (e browseEntry) total() string {
+	if e.order != nil {
+		return money(e.order.OrderTotal, e.order.Currency)
+	}
+	return money(e.receipt.Total, e.receipt.Currency)
+}
+
+// matches reports whether the entry's place, barcode or order number, or any of its
+// items contain query, ignoring case.
+func (e browseEntry) matches(query string) bool {
+	fields := []string{e.where()}
+	if e.order != nil {
+		for _, item := range e.order.OrderLineItems {
+			fields = append(fields, item.ItemDescription, item.ItemNumber)
+		}
+	} else {
+		fields = append(fields, e.receipt.TransactionBarcode)
+		for _, item := range e.receipt.Items {
+			fields = append(fields, item.ItemDescription01, item.FriendlyName(), item.FrenchItemDescription1, item.ItemNumber)
+		}
+	}
+	for _, field := range fields {
+		if strings.Contains(strings.ToLower(field), query) {
+			return true
+		}
+	}
+	return false
+}
+
+// browser is the state of an interactive session.
+type browser struct {
+	source browseSource
+	locale costco.Locale
+	in     *bufio.Scanner
+	out    io.Writer
+	clear  bool // Clear the screen before each view
+	today  time.Time
+
+	start, end string
+	query      string
+	entries    []browseEntry // Everything in the date range, newest first
+	shown      []browseEntry // entries matching query
+	page       int
+}
+
+func tuiCommand() *command {
+	var q queryFlags
+	return &command{
+		name:  "tui",
+		short: "Browse receipts and online orders interactively",
+		long: `Lists transactions page by page. Type a number to open one, /text to search
+items, barcodes, and warehouses, or "d START END" to change the date range.`,
+		flags: q.dateFlags,
+		run: func(ctx context.Context, args []string) error {
+			s, err := q.open()
+			if err != nil {
+				return err
+			}
+			stat, _ := os.Stdout.Stat()
+			b := &browser{
+				source: s.client,
+				locale: s.config.Locale,
+				in:     bufio.NewScanner(os.Stdin),
+				out:    os.Stdout,
+				clear:  stat != nil && stat.Mode()&os.ModeCharDevice != 0,
+				today:  time.Now(),
+				start:  s.start,
+				end:    s.end,
+			}
+			return b.run(ctx)
+		},
+	}
+}
+
+// run loads the date range and reads commands until the user quits or input ends.
+func (b *browser) run(ctx context.Context) error {
+	if err := b.load(ctx); err != nil {
+		return err
+	}
+	for {
+		b.showList()
+		line, ok := b.prompt()
+		if !ok || line == "q" {
+			return nil
+		}
+		quit, err := b.handle(ctx, line)
+		if quit || err != nil {
+			return err
+		}
+	}
+}
+
+// handle runs one list command and reports whether the user quit. Bad input is
+// reported on screen; only a failed fetch after a date change returns an error.
+func (b *browser) handle(ctx context.Context, line string) (bool, error) {
+	pages := max(1, (len(b.shown)+browsePageSize-1)/browsePageSize)
+	switch {
+	case line == "":
+		// Redraw
+	case line == "n":
+		b.page = min(b.page+1, pages-1)
+	case line == "p":
+		b.page = max(b.page-1, 0)
+	case strings.HasPrefix(line, "/"):
+		b.query = strings.ToLower(strings.TrimSpace(line[1:]))
+		b.filter()
+	case line == "d" || strings.HasPrefix(line, "d "):
+		start, end, err := b.parseRange(strings.Fields(line)[1:])
+		if err != nil {
+			b.notice(err.Error())
+			return false, nil
+		}
+		b.start, b.end = start, end
+		return false, b.load(ctx)
+	default:
+		n, err := strconv.Atoi(line)
+		if err != nil || n < 1 || n > len(b.shown) {
+			b.notice(fmt.Sprintf("Unknown command %q", line))
+			return false, nil
+		}
+		b.showDetail(b.shown[n-1])
+		line, ok := b.prompt()
+		return !ok || line == "q", nil
+	}
+	return false, nil
+}
+
+// parseRange reads "d START END" or "d DAYS" arguments.
+func (b *browser) parseRange(args []string) (string, string, error) {
+	switch len(args) {
+	case 1:
+		days, err := strconv.Atoi(args[0])
+		if err != nil || days <= 0 {
+			return "", "", fmt.Errorf("invalid number of days %q", args[0])
+		}
+		return b.today.AddDate(0, 0, -days).Format("2006-01-02"), b.today.Format("2006-01-02"), nil
+	case 2:
+		start, err := time.Parse("2006-01-02", args[0])
+		if err != nil {
+			return "", "", fmt.Errorf("invalid start date %q: use YYYY-MM-DD", args[0])
+		}
+		end, err := time.Parse("2006-01-02", args[1])
+		if err != nil {
+			return "", "", fmt.Errorf("invalid end date %q: use YYYY-MM-DD", args[1])
+		}
+		if end.Before(start) {
+			return "", "", fmt.Errorf("end date %s is before start date %s", args[1], args[0])
+		}
+		return args[0], args[1], nil
+	default:
+		return "", "", fmt.Errorf("usage: d START END (YYYY-MM-DD) or d DAYS")
+	}
+}
+
+// load fetches receipts and online orders for the date range.
+func (b *browser) load(ctx context.Context) error {
+	fmt.Fprintf(b.out, "Loading %s to %s...\n", b.start, b.end)
+
+	transactions, err := b.source.GetAllTransactionItems(ctx, b.start, b.end)
+	if err != nil {
+		return fmt.Errorf("getting receipts: %w", err)
+	}
+	var orders []costco.OnlineOrder
+	for page := 1; ; page++ {
+		resp, err := b.source.GetOnlineOrders(ctx, b.start, b.end, page, 50)
+		if err != nil {
+			return fmt.Errorf("getting orders: %w", err)
+		}
+		orders = append(orders, resp.BCOrders...)
+		if len(resp.BCOrders) == 0 || len(orders) >= resp.TotalNumberOfRecords {
+			break
+		}
+	}
+
+	b.entries = b.entries[:0]
+	for i := range transactions {
+		b.entries = append(b.entries, browseEntry{date: transactions[i].TransactionDate, receipt: &transactions[i]})
+	}
+	for i := range orders {
+		placed := orders[i].OrderPlacedDate
+		date, _ := time.Parse("2006-01-02", placed[:min(10, len(placed))])
+		b.entries = append(b.entries, browseEntry{date: date, order: &orders[i]})
+	}
+	sort.SliceStable(b.entries, func(i, j int) bool {
+		return b.entries[i].date.After(b.entries[j].date)
+	})
+	b.filter()
+	return nil
+}
+
+func (b *browser) filter() {
+	b.shown = b.shown[:0]
+	for _, e := range b.entries {
+		if b.query == "" || e.matches(b.query) {
+			b.shown = append(b.shown, e)
+		}
+	}
+	b.page = 0
+}
+
+func (b *browser) prompt() (string, bool) {
+	fmt.Fprint(b.out, "> ")
+	if !b.in.Scan() {
+		fmt.Fprintln(b.out)
+		return "", false
+	}
+	return strings.TrimSpace(b.in.Text()), true
+}
+
+func (b *browser) notice(message string) {
+	fmt.Fprintf(b.out, "%s (press Enter)\n", message)
+	b.in.Scan()
+}
+
+func (b *browser) clearScreen() {
+	if b.clear {
+		fmt.Fprint(b.out, "\033[H\033[2J")
+	}
+}
+
+func (b *browser) showList() {
+	b.clearScreen()
+	pages := max(1, (len(b.shown)+browsePageSize-1)/browsePageSize)
+
+	fmt.Fprintf(b.out, "Transactions %s to %s", b.start, b.end)
+	if b.query != "" {
+		fmt.Fprintf(b.out, " matching %q", b.query)
+	}
+	fmt.Fprintf(b.out, " (%d)\n\n", len(b.shown))
+
+	from := b.page * browsePageSize
+	for i := from; i < min(from+browsePageSize, len(b.shown)); i++ {
+		e := b.shown[i]
+		fmt.Fprintf(b.out, "%4d  %s  %-7s  %-30s %14s\n", i+1, e.date.Format("2006-01-02"), e.kind(), e.where(), e.total())
+	}
+	if len(b.shown) == 0 {
+		fmt.Fprintln(b.out, "  No transactions")
+	}
+
+	fmt.Fprintf(b.out, "\nPage %d/%d  [n]ext [p]rev  <#> open  /text search  d START END | d DAYS  [q]uit\n", b.page+1, pages)
+}
+
+func (b *browser) showDetail(e browseEntry) {
+	b.clearScreen()
+	if e.order != nil {
+		order := e.order
+		fmt.Fprintf(b.out, "Order #%s  %s  %s\n", order.OrderNumber, order.OrderPlacedDate, order.Status)
+		fmt.Fprintf(b.out, "Total: %s\n\n", money(order.OrderTotal, order.Currency))
+		for _, item := range order.OrderLineItems {
+			fmt.Fprintf(b.out, "  %-10s %-50s %s\n", item.ItemNumber, item.ItemDescription, item.Status)
+		}
+		for _, payment := range order.Payments {
+			fmt.Fprintf(b.out, "\nPaid: %s (%s): %s", payment.CardType, payment.LastFour, money(payment.Amount, order.Currency))
+		}
+	} else {
+		tx := e.receipt
+		fmt.Fprintf(b.out, "%s  %s #%d\n", tx.TransactionDate.Format("2006-01-02 15:04"), tx.WarehouseName, tx.WarehouseNumber)
+		fmt.Fprintf(b.out, "Barcode: %s\n\n", tx.TransactionBarcode)
+		for _, item := range tx.Items {
+			description := item.FriendlyName()
+			if b.locale.IsFrench() && item.FrenchItemDescription1 != "" {
+				description = item.FrenchItemDescription1
+			}
+			quantity := ""
+			if item.Unit > 1 {
+				quantity = fmt.Sprintf("%d @ %s", item.Unit, money(item.ItemUnitPriceAmount, tx.Currency))
+			}
+			fmt.Fprintf(b.out, "  %-10s %-40s %16s %12s\n", item.ItemNumber, description, quantity, money(item.Amount, tx.Currency))
+		}
+		fmt.Fprintf(b.out, "\nTax: %s\nTotal: %s", money(tx.Taxes, tx.Currency), money(tx.Total, tx.Currency))
+		if savings := tx.InstantSavings + tx.CouponSavings; savings > 0 {
+			fmt.Fprintf(b.out, " (saved %s)", money(savings, tx.Currency))
+		}
+	}
+	fmt.Fprintln(b.out, "\n\n[Enter] back  [q]uit")
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeBrowseSource struct {
+	ranges [][2]string
+}
+
+func (f *fakeBrowseSource) GetAllTransactionItems(ctx context.Context, startDate, endDate string) ([]costco.TransactionWithItems, error) {
+	f.ranges = append(f.ranges, [2]string{startDate, endDate})
+	var transactions []costco.TransactionWithItems
+	for day := 1; day <= 20; day++ {
+		transactions = append(transactions, costco.TransactionWithItems{
+			TransactionBarcode: fmt.Sprintf("R%02d", day),
+			TransactionDate:    time.Date(2025, 1, day, 10, 0, 0, 0, time.UTC),
+			WarehouseName:      "Issaquah",
+			WarehouseNumber:    1,
+			Total:              float64(day),
+			Items:              []costco.ReceiptItem{{ItemNumber: "1", ItemDescription01: "KS EGGS", Unit: 1, Amount: float64(day)}},
+		})
+	}
+	transactions[4].Items = append(transactions[4].Items, costco.ReceiptItem{ItemNumber: "9", ItemDescription01: "PAPER TOWEL", Unit: 2, ItemUnitPriceAmount: 20, Amount: 40})
+	return transactions, nil
+}
+
+func (f *fakeBrowseSource) GetOnlineOrders(ctx context.Context, startDate, endDate string, pageNumber, pageSize int) (*costco.OnlineOrdersResponse, error) {
+	if pageNumber > 1 {
+		return &costco.OnlineOrdersResponse{TotalNumberOfRecords: 1}, nil
+	}
+	return &costco.OnlineOrdersResponse{
+		TotalNumberOfRecords: 1,
+		BCOrders: []costco.OnlineOrder{{
+			OrderNumber: "555", OrderPlacedDate: "2025-01-25T09:00:00", OrderTotal: 99.99, Status: "Shipped",
+			OrderLineItems: []costco.OrderLineItem{{ItemNumber: "77", ItemDescription: "Patio Umbrella"}},
+		}},
+	}, nil
+}
+
+func runBrowser(t *testing.T, input string) (string, *fakeBrowseSource) {
+	t.Helper()
+	source := &fakeBrowseSource{}
+	var out bytes.Buffer
+	b := &browser{
+		source: source,
+		in:     bufio.NewScanner(strings.NewReader(input)),
+		out:    &out,
+		today:  time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+		start:  "2025-01-01",
+		end:    "2025-01-31",
+	}
+	require.NoError(t, b.run(context.Background()))
+	return out.String(), source
+}
+
+func TestBrowser_ListAndPage(t *testing.T) {
+	out, _ := runBrowser(t, "n\nq\n")
+
+	assert.Contains(t, out, "Transactions 2025-01-01 to 2025-01-31 (21)")
+	assert.Contains(t, out, "   1  2025-01-25  order    Order #555")
+	assert.Contains(t, out, "   2  2025-01-20  receipt  Issaquah #1")
+	assert.Contains(t, out, "Page 1/2")
+	assert.Contains(t, out, "Page 2/2")
+	assert.Contains(t, out, "  21  2025-01-01  receipt")
+}
+
+func TestBrowser_SearchAndDetail(t *testing.T) {
+	out, _ := runBrowser(t, "/paper towel\n1\n\n/umbrella\n1\nq\n")
+
+	assert.Contains(t, out, `matching "paper towel" (1)`)
+	assert.Contains(t, out, "Barcode: R05")
+	assert.Contains(t, out, "Kirkland Signature Eggs")
+	assert.Contains(t, out, "2 @ $20.00")
+	assert.Contains(t, out, "Total: $5.00")
+	assert.Contains(t, out, `matching "umbrella" (1)`)
+	assert.Contains(t, out, "Order #555  2025-01-25T09:00:00  Shipped")
+}
+
+func TestBrowser_DateRange(t *testing.T) {
+	out, source := runBrowser(t, "d 2025-02-01 2025-02-28\nd 10\nd 2025-02-28 2025-02-01\nok\n42\nok\n")
+
+	assert.Equal(t, [][2]string{
+		{"2025-01-01", "2025-01-31"},
+		{"2025-02-01", "2025-02-28"},
+		{"2025-03-21", "2025-03-31"},
+	}, source.ranges)
+	assert.Contains(t, out, "end date 2025-02-01 is before start date 2025-02-28")
+	assert.Contains(t, out, `Unknown command "42"`)
+}
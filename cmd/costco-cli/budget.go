@@ -0,0 +1,189 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+)
+
+var budgetColumns = []tableColumn{
+	{name: "category", maxWidth: 30},
+	{name: "budget", numeric: true},
+	{name: "spent", numeric: true},
+	{name: "remaining", numeric: true},
+	{name: "used", numeric: true},
+}
+
+func budgetCommand() *command {
+	set := &command{
+		name:     "set",
+		args:     []string{"category", "amount"},
+		short:    "Set the monthly budget for a category",
+		complete: completeBudgetGroups,
+		long: `A category is "total" for all spending, a group ("groceries" for food,
+"household" for paper goods, sundries, and the like), or a department name such as
+produce or "beer wine spirits". Budgets are saved in the profile's config.`,
+		run: func(ctx context.Context, args []string) error {
+			return setBudget(os.Stdout, args[0], args[1])
+		},
+	}
+	remove := &command{
+		name:     "remove",
+		args:     []string{"category"},
+		short:    "Delete the budget for a category",
+		complete: completeBudgets,
+		run: func(ctx context.Context, args []string) error {
+			return removeBudget(os.Stdout, args[0])
+		},
+	}
+
+	var (
+		q    queryFlags
+		opts tableOptions
+	)
+	status := &command{
+		name:  "status",
+		short: "Spending against each budget for a month, over-budget categories in red",
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&q.month, "month", "", "Calendar month: jan, march, or YYYY-MM (default: this month)")
+			q.localFlag(fs)
+			q.outputFlags(fs)
+			opts.register(fs, budgetColumns)
+		},
+		run: func(ctx context.Context, args []string) error {
+			if err := opts.validate(budgetColumns); err != nil {
+				return err
+			}
+			if q.month == "" {
+				q.month = time.Now().Format("2006-01")
+			}
+			s, err := q.open()
+			if err != nil {
+				return err
+			}
+			storedConfig, err := costco.LoadConfig()
+			if err != nil {
+				return fmt.Errorf("loading config: %w", err)
+			}
+			if len(storedConfig.Budgets) == 0 {
+				return fmt.Errorf("no budgets yet. Set one with '%s budget set groceries 600'", cliName())
+			}
+
+			statuses, err := s.client.GetBudgetStatus(ctx, s.start, s.end, storedConfig.Budgets)
+			if err != nil {
+				return fmt.Errorf("getting budget status: %w", err)
+			}
+			if s.output.structured() {
+				return s.output.write(statuses)
+			}
+			fmt.Printf("Budgets %s to %s\n\n", s.start, s.end)
+			return printBudgetStatus(os.Stdout, statuses, opts)
+		},
+	}
+
+	return &command{
+		name:        "budget",
+		short:       "Monthly spending budgets by department or category",
+		subcommands: []*command{set, remove, status},
+	}
+}
+
+// printBudgetStatus prints one row per budget, with over-budget rows red.
+func printBudgetStatus(w io.Writer, statuses []costco.BudgetStatus, opts tableOptions) error {
+	t := newTable(budgetColumns)
+	for _, status := range statuses {
+		style := ""
+		if status.Over {
+			style = styleRed
+		}
+		t.add(styled{status.Category, style}, tableMoney{status.Budget, ""},
+			styled{tableMoney{status.Spent, ""}, style}, styled{tableMoney{status.Remaining, ""}, style},
+			styled{fmt.Sprintf("%.0f%%", status.Percent), style})
+	}
+	return t.render(w, opts)
+}
+
+// loadBudgetConfig loads the profile config that budget set and remove change.
+func loadBudgetConfig() (*costco.StoredConfig, error) {
+	storedConfig, err := costco.LoadConfig()
+	if err != nil {
+		return nil, fmt.Errorf("loading config: %w", err)
+	}
+	if storedConfig == nil {
+		return nil, fmt.Errorf("no configuration found. Run '%s setup' first", cliName())
+	}
+	return storedConfig, nil
+}
+
+func setBudget(out io.Writer, name, value string) error {
+	category, err := costco.BudgetCategory(name)
+	if err != nil {
+		return err
+	}
+	amount, err := strconv.ParseFloat(strings.TrimPrefix(value, "$"), 64)
+	if err != nil || amount <= 0 {
+		return fmt.Errorf("invalid amount %q: use a positive number such as 600", value)
+	}
+
+	storedConfig, err := loadBudgetConfig()
+	if err != nil {
+		return err
+	}
+	if storedConfig.Budgets == nil {
+		storedConfig.Budgets = make(map[string]float64)
+	}
+	storedConfig.Budgets[category] = amount
+	if err := costco.SaveConfig(storedConfig); err != nil {
+		return fmt.Errorf("saving config: %w", err)
+	}
+	fmt.Fprintf(out, "✓ Budget for %s set to %s a month\n", category, money(amount, storedConfig.Currency))
+	return nil
+}
+
+func removeBudget(out io.Writer, name string) error {
+	category, err := costco.BudgetCategory(name)
+	if err != nil {
+		return err
+	}
+	storedConfig, err := loadBudgetConfig()
+	if err != nil {
+		return err
+	}
+	if _, ok := storedConfig.Budgets[category]; !ok {
+		return fmt.Errorf("no budget for %s", category)
+	}
+	delete(storedConfig.Budgets, category)
+	if err := costco.SaveConfig(storedConfig); err != nil {
+		return fmt.Errorf("saving config: %w", err)
+	}
+	fmt.Fprintf(out, "✓ Budget for %s removed\n", category)
+	return nil
+}
+
+// completeBudgetGroups offers total and the built-in groups; department names have
+// spaces and punctuation, so they aren't offered.
+func completeBudgetGroups(prefix string) []string {
+	return matching(append([]string{costco.BudgetTotal}, costco.BudgetGroups()...), prefix)
+}
+
+// completeBudgets offers the categories that have a budget.
+func completeBudgets(prefix string) []string {
+	storedConfig, err := costco.LoadConfig()
+	if err != nil || storedConfig == nil {
+		return nil
+	}
+	categories := make([]string, 0, len(storedConfig.Budgets))
+	for category := range storedConfig.Budgets {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+	return matching(categories, prefix)
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetAndRemoveBudget(t *testing.T) {
+	withTempConfig(t)
+	var out bytes.Buffer
+	assert.ErrorContains(t, setBudget(&out, "groceries", "600"), "setup")
+
+	require.NoError(t, costco.SaveConfig(&costco.StoredConfig{Email: "a@example.com", WarehouseNumber: "847"}))
+	require.NoError(t, setBudget(&out, "Groceries", "600"))
+	require.NoError(t, setBudget(&out, "beer wine spirits", "$80.50"))
+	assert.Contains(t, out.String(), "✓ Budget for groceries set to $600.00 a month")
+
+	assert.ErrorContains(t, setBudget(&out, "vacations", "100"), "unknown budget category")
+	assert.ErrorContains(t, setBudget(&out, "produce", "-5"), "invalid amount")
+
+	require.NoError(t, removeBudget(&out, "groceries"))
+	assert.ErrorContains(t, removeBudget(&out, "groceries"), "no budget for groceries")
+
+	stored, err := costco.LoadConfig()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]float64{"beer, wine & spirits": 80.5}, stored.Budgets)
+	assert.Equal(t, []string{"beer, wine & spirits"}, completeBudgets("b"))
+}
+
+func TestPrintBudgetStatus(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, printBudgetStatus(&out, []costco.BudgetStatus{
+		{Category: "groceries", Budget: 600, Spent: 642.1, Remaining: -42.1, Percent: 107.0, Over: true},
+		{Category: "total", Budget: 1000, Spent: 700, Remaining: 300, Percent: 70},
+	}, tableOptions{}))
+	assert.Equal(t, "CATEGORY     BUDGET    SPENT  REMAINING  USED\n"+
+		"groceries   $600.00  $642.10    $-42.10  107%\n"+
+		"total      $1000.00  $700.00    $300.00   70%\n", out.String())
+}
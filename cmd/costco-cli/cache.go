@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+)
+
+var cacheColumns = []tableColumn{
+	{name: "filter"},
+	{name: "synced_from"},
+	{name: "synced_to"},
+	{name: "records", numeric: true},
+	{name: "oldest"},
+	{name: "newest"},
+}
+
+func cacheCommand() *command {
+	var jsonOut bool
+	stats := &command{
+		name:  "stats",
+		short: "Show what the local store holds",
+		flags: func(fs *flag.FlagSet) {
+			fs.BoolVar(&jsonOut, "json", false, "Output as JSON")
+		},
+		run: func(ctx context.Context, args []string) error {
+			stats, err := costco.TransactionStoreStats()
+			if err != nil {
+				return fmt.Errorf("reading local store: %w", err)
+			}
+			if jsonOut {
+				return writeJSON(stats)
+			}
+			return printStoreStats(os.Stdout, stats)
+		},
+	}
+	clearStore := &command{
+		name:  "clear",
+		short: "Delete the local store; the next sync or -local query fetches everything again",
+		run: func(ctx context.Context, args []string) error {
+			if err := costco.ClearTransactionStore(); err != nil {
+				return fmt.Errorf("clearing local store: %w", err)
+			}
+			fmt.Println("✓ Local store cleared")
+			return nil
+		},
+	}
+	var olderThan string
+	prune := &command{
+		name:  "prune",
+		short: "Drop stored receipts and orders older than a span",
+		long: `Removes receipts and online orders dated before the span and shortens the synced
+ranges to match, so queries reaching further back fetch from the API again.`,
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&olderThan, "older-than", "", "Age to drop: "+spanHelp+" (required)")
+		},
+		run: func(ctx context.Context, args []string) error {
+			if olderThan == "" {
+				return errors.New("-older-than is required, e.g. -older-than 2y")
+			}
+			cutoff, ok := spanBack(olderThan, time.Now())
+			if !ok {
+				return fmt.Errorf("invalid span %q: use %s", olderThan, spanHelp)
+			}
+			removed, err := costco.PruneTransactionStore(cutoff)
+			if err != nil {
+				return fmt.Errorf("pruning local store: %w", err)
+			}
+			fmt.Printf("✓ Removed %d receipts and orders before %s\n", removed, cutoff.Format(dateLayout))
+			return nil
+		},
+	}
+	return &command{
+		name:  "cache",
+		short: "Inspect, prune, or clear the local store kept by sync",
+		long: `The local store (transactions.json in the profile's config directory) holds the
+receipts and online orders fetched by sync and -local queries. The client keeps no
+other cache; every other request goes to the API.`,
+		subcommands: []*command{stats, clearStore, prune},
+	}
+}
+
+func printStoreStats(w io.Writer, stats *costco.StoreStats) error {
+	if stats.Size == 0 {
+		fmt.Fprintf(w, "No local store at %s. Run '%s sync' to create one\n", stats.Path, cliName())
+		return nil
+	}
+	fmt.Fprintf(w, "%s (%s)\n\n", stats.Path, byteSize(stats.Size))
+
+	t := newTable(cacheColumns)
+	segments := stats.Segments
+	if stats.Orders != nil {
+		segments = append(segments, *stats.Orders)
+	}
+	for _, segment := range segments {
+		t.add(segment.Filter, segment.Start, segment.Watermark, segment.Count, segment.Oldest, segment.Newest)
+	}
+	return t.render(w, tableOptions{})
+}
+
+// byteSize formats a file size in B, KB, or MB.
+func byteSize(n int64) string {
+	switch {
+	case n >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return fmt.Sprintf("%.1f KB", float64(n)/(1<<10))
+	default:
+		return fmt.Sprintf("%d B", n)
+	}
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrintStoreStats(t *testing.T) {
+	var out bytes.Buffer
+	require.NoError(t, printStoreStats(&out, &costco.StoreStats{
+		Path: "/home/me/.costco/transactions.json",
+		Size: 1536,
+		Segments: []costco.StoreSegmentStats{
+			{Filter: "all/all", Start: "2024-01-01", Watermark: "2025-03-01", Count: 120, Oldest: "2024-01-03", Newest: "2025-02-27"},
+		},
+		Orders: &costco.StoreSegmentStats{Filter: "orders", Start: "2024-01-01", Watermark: "2025-03-01", Count: 8, Oldest: "2024-02-10", Newest: "2025-01-15"},
+	}))
+	assert.Equal(t, "/home/me/.costco/transactions.json (1.5 KB)\n\n"+
+		"FILTER   SYNCED FROM  SYNCED TO   RECORDS  OLDEST      NEWEST\n"+
+		"all/all  2024-01-01   2025-03-01      120  2024-01-03  2025-02-27\n"+
+		"orders   2024-01-01   2025-03-01        8  2024-02-10  2025-01-15\n", out.String())
+
+	out.Reset()
+	require.NoError(t, printStoreStats(&out, &costco.StoreStats{Path: "/tmp/transactions.json"}))
+	assert.Contains(t, out.String(), "No local store at /tmp/transactions.json")
+}
+
+func TestByteSize(t *testing.T) {
+	assert.Equal(t, "512 B", byteSize(512))
+	assert.Equal(t, "2.0 KB", byteSize(2048))
+	assert.Equal(t, "3.5 MB", byteSize(3.5*(1<<20)))
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"os"
+	"strings"
+)
+
+// Colored output. Color is used only when stdout is a terminal, TERM isn't "dumb",
+// and NO_COLOR (https://no-color.org) is unset or empty.
+
+// ANSI styles
+const (
+	styleReset  = "\033[0m"
+	styleBold   = "\033[1m"
+	styleRed    = "\033[31m"
+	styleGreen  = "\033[32m"
+	styleYellow = "\033[33m"
+)
+
+// colorOutput reports whether paint emits escape codes.
+var colorOutput = colorEnabled(os.Stdout)
+
+func colorEnabled(f *os.File) bool {
+	if os.Getenv("NO_COLOR") != "" || os.Getenv("TERM") == "dumb" {
+		return false
+	}
+	return isTerminal(f)
+}
+
+func isTerminal(f *os.File) bool {
+	stat, err := f.Stat()
+	return err == nil && stat.Mode()&os.ModeCharDevice != 0
+}
+
+// paint wraps text in style when color is enabled.
+func paint(style, text string) string {
+	if !colorOutput || style == "" || text == "" {
+		return text
+	}
+	return style + text + styleReset
+}
+
+// amountStyle is red for negative amounts (refunds, returns) and plain otherwise.
+func amountStyle(amount float64) string {
+	if amount < 0 {
+		return styleRed
+	}
+	return ""
+}
+
+// changeStyle colors a change in spending or price: increases red, decreases green.
+func changeStyle(change float64) string {
+	switch {
+	case change > 0:
+		return styleRed
+	case change < 0:
+		return styleGreen
+	default:
+		return ""
+	}
+}
+
+// statusStyle colors an order or shipment status: done green, problems red, and
+// anything on its way yellow.
+func statusStyle(status string) string {
+	s := strings.ToLower(status)
+	switch {
+	case containsAny(s, "cancel", "fail", "return", "delay", "exception", "refund"):
+		return styleRed
+	case strings.Contains(s, "out for"):
+		return styleYellow // "Out for Delivery" isn't delivered yet
+	case containsAny(s, "deliver", "complete", "picked up", "fulfilled"):
+		return styleGreen
+	case containsAny(s, "ship", "transit", "ready", "process", "ordered", "pending"):
+		return styleYellow
+	default:
+		return ""
+	}
+}
+
+func containsAny(s string, substrings ...string) bool {
+	for _, sub := range substrings {
+		if strings.Contains(s, sub) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// withColor turns colored output on for the duration of the test.
+func withColor(t *testing.T) {
+	t.Helper()
+	original := colorOutput
+	colorOutput = true
+	t.Cleanup(func() { colorOutput = original })
+}
+
+func TestColorEnabled(t *testing.T) {
+	f, err := os.CreateTemp(t.TempDir(), "out")
+	assert.NoError(t, err)
+	defer f.Close()
+	assert.False(t, colorEnabled(f), "not a terminal")
+
+	t.Setenv("NO_COLOR", "1")
+	assert.False(t, colorEnabled(os.Stdout))
+}
+
+func TestPaint(t *testing.T) {
+	assert.Equal(t, "plain", paint(styleRed, "plain"), "color is off outside a terminal")
+
+	withColor(t)
+	assert.Equal(t, "\033[31m-$4.00\033[0m", paint(styleRed, "-$4.00"))
+	assert.Equal(t, "plain", paint("", "plain"))
+}
+
+func TestStatusStyle(t *testing.T) {
+	assert.Equal(t, styleGreen, statusStyle("Delivered"))
+	assert.Equal(t, styleRed, statusStyle("Cancelled"))
+	assert.Equal(t, styleYellow, statusStyle("Out for Delivery"), "on its way, not delivered yet")
+	assert.Equal(t, styleYellow, statusStyle("Shipped"))
+	assert.Equal(t, "", statusStyle("Unknown"))
+}
+
+func TestTableColor(t *testing.T) {
+	withColor(t)
+	tbl := newTable([]tableColumn{{name: "status", status: true}, {name: "amount", numeric: true}})
+	tbl.add("Delivered", tableMoney{-3, ""})
+	tbl.add("Pending", styled{tableMoney{-1, ""}, styleGreen})
+
+	assert.Equal(t, ""+
+		"\033[1mSTATUS\033[0m     \033[1mAMOUNT\033[0m\n"+
+		"\033[32mDelivered\033[0m  \033[31m$-3.00\033[0m\n"+
+		"\033[33mPending\033[0m    \033[32m$-1.00\033[0m\n",
+		renderTable(t, tbl, tableOptions{}))
+}
@@ -0,0 +1,353 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+)
+
+// errUsage reports a command line that doesn't match any command or its flags.
+// Help has already been printed when it is returned.
+var errUsage = errors.New("invalid usage")
+
+// command is one node of the CLI's command tree: either a group of subcommands
+// ("costco-cli orders") or a runnable command ("costco-cli orders list").
+type command struct {
+	name        string
+	args        []string // Names of the required positional arguments, e.g. "barcode"
+	optionalArg string   // Name of an optional positional argument after args
+	repeatArg   bool     // optionalArg may be given any number of times
+	short       string   // One-line summary shown in command lists
+	long        string   // Extra help shown by -h
+	flags       func(fs *flag.FlagSet)
+	run         func(ctx context.Context, args []string) error
+	subcommands []*command
+
+	complete func(prefix string) []string // Completes positional arguments (optional)
+	hidden   bool                         // Left out of command lists
+	rawArgs  bool                         // Passes every argument to run without parsing flags
+}
+
+// execute runs the command named by args, writing help and usage errors to w.
+// parent is the command path leading to c, "" for the root.
+func (c *command) execute(ctx context.Context, w io.Writer, parent string, args []string) error {
+	path := strings.TrimSpace(parent + " " + c.name)
+
+	if len(c.subcommands) > 0 {
+		// Flags given before the subcommand apply to the whole group, e.g. -profile
+		if c.flags != nil {
+			fs := c.flagSet(w, path)
+			if err := fs.Parse(args); err != nil {
+				if errors.Is(err, flag.ErrHelp) {
+					return err
+				}
+				return errUsage
+			}
+			args = fs.Args()
+		}
+		if len(args) == 0 {
+			c.printHelp(w, path, nil)
+			return errUsage
+		}
+		switch args[0] {
+		case "-h", "-help", "--help":
+			c.printHelp(w, path, nil)
+			return flag.ErrHelp
+		case "help":
+			return c.help(w, path, args[1:])
+		}
+		sub := c.find(args[0])
+		if sub == nil {
+			fmt.Fprintf(w, "Unknown command %q for %q\n\n", args[0], path)
+			c.printHelp(w, path, nil)
+			return errUsage
+		}
+		return sub.execute(ctx, w, path, args[1:])
+	}
+
+	if c.rawArgs {
+		return c.run(ctx, args)
+	}
+
+	fs := c.flagSet(w, path)
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+		return errUsage
+	}
+	maxArgs := len(c.args)
+	if c.optionalArg != "" {
+		maxArgs++
+	}
+	if c.repeatArg {
+		maxArgs = max(fs.NArg(), len(c.args))
+	}
+	if n := fs.NArg(); n < len(c.args) || n > maxArgs {
+		if maxArgs > len(c.args) {
+			fmt.Fprintf(w, "%q takes %d or %d argument(s), got %d\n\n", path, len(c.args), maxArgs, n)
+		} else {
+			fmt.Fprintf(w, "%q takes %d argument(s), got %d\n\n", path, len(c.args), n)
+		}
+		fs.Usage()
+		return errUsage
+	}
+	return c.run(ctx, fs.Args())
+}
+
+// help prints the help of the subcommand named by args, as in "costco-cli help orders list".
+func (c *command) help(w io.Writer, path string, args []string) error {
+	target := c
+	for _, name := range args {
+		sub := target.find(name)
+		if sub == nil {
+			fmt.Fprintf(w, "Unknown command %q for %q\n\n", name, path)
+			target.printHelp(w, path, nil)
+			return errUsage
+		}
+		target, path = sub, path+" "+name
+	}
+	var fs *flag.FlagSet
+	if len(target.subcommands) == 0 {
+		fs = target.flagSet(w, path)
+	}
+	target.printHelp(w, path, fs)
+	return flag.ErrHelp
+}
+
+func (c *command) find(name string) *command {
+	for _, sub := range c.subcommands {
+		if sub.name == name {
+			return sub
+		}
+	}
+	return nil
+}
+
+func (c *command) flagSet(w io.Writer, path string) *flag.FlagSet {
+	fs := flag.NewFlagSet(path, flag.ContinueOnError)
+	fs.SetOutput(w)
+	fs.Usage = func() { c.printHelp(w, path, fs) }
+	if c.flags != nil {
+		c.flags(fs)
+	}
+	return fs
+}
+
+func (c *command) printHelp(w io.Writer, path string, fs *flag.FlagSet) {
+	if c.short != "" {
+		fmt.Fprintf(w, "%s\n\n", c.short)
+	}
+	if c.long != "" {
+		fmt.Fprintf(w, "%s\n\n", c.long)
+	}
+
+	fmt.Fprintln(w, "Usage:")
+	if len(c.subcommands) > 0 {
+		if fs == nil && c.flags != nil {
+			fs = c.flagSet(w, path)
+		}
+		if fs != nil && hasFlags(fs) {
+			fmt.Fprintf(w, "  %s [flags] <command>\n\n", path)
+		} else {
+			fmt.Fprintf(w, "  %s <command>\n\n", path)
+		}
+		fmt.Fprintln(w, "Commands:")
+		for _, sub := range c.subcommands {
+			if !sub.hidden {
+				fmt.Fprintf(w, "  %-14s %s\n", sub.name, sub.short)
+			}
+		}
+		if fs != nil && hasFlags(fs) {
+			fmt.Fprintln(w, "\nFlags:")
+			fs.PrintDefaults()
+		}
+		fmt.Fprintf(w, "\nRun '%s <command> -h' for help on a command.\n", path)
+		return
+	}
+
+	usage := path
+	if fs != nil && hasFlags(fs) {
+		usage += " [flags]"
+	}
+	for _, arg := range c.args {
+		usage += " <" + arg + ">"
+	}
+	switch {
+	case c.repeatArg:
+		usage += " [" + c.optionalArg + "...]"
+	case c.optionalArg != "":
+		usage += " [" + c.optionalArg + "]"
+	}
+	fmt.Fprintf(w, "  %s\n", usage)
+	if fs != nil && hasFlags(fs) {
+		fmt.Fprintln(w, "\nFlags:")
+		fs.PrintDefaults()
+	}
+}
+
+func hasFlags(fs *flag.FlagSet) bool {
+	found := false
+	fs.VisitAll(func(*flag.Flag) { found = true })
+	return found
+}
+
+// optionalBool is a boolean flag that remembers whether it was given, so an unset
+// flag can fall back to the stored profile.
+type optionalBool struct {
+	value bool
+	set   bool
+}
+
+func (b *optionalBool) String() string { return strconv.FormatBool(b.value) }
+
+func (b *optionalBool) Set(s string) error {
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		return err
+	}
+	b.value, b.set = v, true
+	return nil
+}
+
+func (b *optionalBool) IsBoolFlag() bool { return true }
+
+// queryFlags are the flags shared by commands that query the account. Each command
+// registers only the ones it uses.
+type queryFlags struct {
+	start   string
+	end     string
+	last    string
+	month   string
+	quarter string
+	ytd     bool
+	docType string
+	json    optionalBool
+	output  outputFormat
+	local   bool
+	tags    []string
+
+	serviceOrders bool
+	noProgress    bool // Set by commands that don't report to a terminal, like serve
+}
+
+func (q *queryFlags) dateFlags(fs *flag.FlagSet) {
+	fs.StringVar(&q.start, "start", "", "Start date: YYYY-MM-DD or "+spanHelp+" (default from config date range)")
+	fs.StringVar(&q.end, "end", "", "End date (YYYY-MM-DD) (default: today)")
+	q.periodFlags(fs)
+}
+
+func (q *queryFlags) typeFlag(fs *flag.FlagSet) {
+	fs.StringVar(&q.docType, "type", "", "Receipt document type: all, warehouse, fuel (default from config)")
+}
+
+func (q *queryFlags) outputFlags(fs *flag.FlagSet) {
+	fs.Var(&q.json, "json", "Output as JSON, same as -output json (default from config)")
+	fs.Func("output", "Output format: text, json, or jsonl for one JSON object per line (default from config)", func(value string) error {
+		format := outputFormat(value)
+		if format != outputText && !format.structured() {
+			return fmt.Errorf("unknown format %q: use text, json, or jsonl", value)
+		}
+		q.output = format
+		return nil
+	})
+}
+
+func (q *queryFlags) tagFlag(fs *flag.FlagSet) {
+	fs.Func("tag", "Only receipts with this tag; repeat or comma-separate for any of several", func(value string) error {
+		for _, tag := range strings.Split(value, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				q.tags = append(q.tags, tag)
+			}
+		}
+		return nil
+	})
+}
+
+func (q *queryFlags) serviceOrdersFlag(fs *flag.FlagSet) {
+	fs.BoolVar(&q.serviceOrders, "service-orders", false, "Include Photo Center and optical orders")
+}
+
+func (q *queryFlags) localFlag(fs *flag.FlagSet) {
+	fs.BoolVar(&q.local, "local", false, "Read receipts from the local store kept by sync, fetching only unsynced days")
+}
+
+// session is what a query command needs: a client built from the stored profile and
+// the flags resolved against the profile's defaults.
+type session struct {
+	client *costco.Client
+	config costco.Config
+	start  string
+	end    string
+	output outputFormat
+}
+
+// open loads the stored profile and tokens and builds a client from them.
+func (q *queryFlags) open() (*session, error) {
+	if err := q.resolveDates(time.Now()); err != nil {
+		return nil, err
+	}
+
+	storedConfig, err := costco.LoadConfig()
+	if err != nil {
+		return nil, fmt.Errorf("loading config: %w", err)
+	}
+	if storedConfig == nil {
+		return nil, fmt.Errorf("no configuration found. Run '%s setup' first", cliName())
+	}
+	if err := storedConfig.Validate(); err != nil {
+		return nil, fmt.Errorf("%w\nFix ~/.costco/config.json or run '%s setup' again", err, cliName())
+	}
+
+	tokens, _ := costco.LoadTokens()
+	if tokens == nil || time.Now().After(tokens.RefreshTokenExpiresAt) {
+		return nil, fmt.Errorf("no valid tokens found. Run '%s login' to sign in", cliName())
+	}
+
+	s := &session{start: q.start, end: q.end}
+
+	// Default date range if not provided
+	if s.start == "" {
+		s.start = time.Now().AddDate(0, 0, -storedConfig.DateRangeDays()).Format("2006-01-02")
+	}
+	if s.end == "" {
+		s.end = time.Now().Format("2006-01-02")
+	}
+
+	// Fall back to the profile's preferred output format and document type
+	switch {
+	case q.output != "":
+		s.output = q.output
+	case q.json.set && q.json.value:
+		s.output = outputJSON
+	case q.json.set:
+		s.output = outputText
+	default:
+		s.output = outputFormat(storedConfig.Format())
+	}
+
+	s.config = storedConfig.ClientConfig()
+	s.config.TokenRefreshBuffer = 5 * time.Minute
+	if q.docType != "" {
+		s.config.DocumentType = q.docType
+	}
+	if q.local {
+		s.config.UseLocalStore = true
+	}
+	s.config.Tags = q.tags
+	s.config.IncludeServiceOrders = q.serviceOrders
+	if !q.noProgress {
+		s.config.Progress = newProgress(s.output.structured())
+	}
+	s.config.Logger = clientLogger()
+
+	s.client = costco.NewClient(s.config)
+	return s, nil
+}
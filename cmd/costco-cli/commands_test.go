@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTree(got *[]string, limit *int) *command {
+	list := &command{
+		name:  "list",
+		short: "List things",
+		flags: func(fs *flag.FlagSet) {
+			fs.IntVar(limit, "limit", 10, "Maximum number of things")
+		},
+		run: func(ctx context.Context, args []string) error {
+			*got = append(*got, "list")
+			return nil
+		},
+	}
+	get := &command{
+		name:  "get",
+		args:  []string{"id"},
+		short: "Show one thing",
+		run: func(ctx context.Context, args []string) error {
+			*got = append(*got, "get "+args[0])
+			return nil
+		},
+	}
+	return &command{
+		name:  "tool",
+		short: "Test tool",
+		subcommands: []*command{
+			{name: "things", short: "Things", subcommands: []*command{list, get}},
+		},
+	}
+}
+
+func TestCommandExecute(t *testing.T) {
+	var got []string
+	var limit int
+	root := newTestTree(&got, &limit)
+	var out bytes.Buffer
+	ctx := context.Background()
+
+	require.NoError(t, root.execute(ctx, &out, "", []string{"things", "list", "-limit", "3"}))
+	assert.Equal(t, 3, limit)
+	require.NoError(t, root.execute(ctx, &out, "", []string{"things", "get", "42"}))
+	assert.Equal(t, []string{"list", "get 42"}, got)
+	assert.Empty(t, out.String())
+
+	assert.ErrorIs(t, root.execute(ctx, &out, "", []string{"things", "get"}), errUsage)
+	assert.Contains(t, out.String(), `"tool things get" takes 1 argument(s), got 0`)
+	assert.Contains(t, out.String(), "tool things get <id>")
+
+	out.Reset()
+	assert.ErrorIs(t, root.execute(ctx, &out, "", []string{"things", "delete"}), errUsage)
+	assert.Contains(t, out.String(), `Unknown command "delete" for "tool things"`)
+
+	out.Reset()
+	assert.ErrorIs(t, root.execute(ctx, &out, "", []string{"things", "list", "-bogus"}), errUsage)
+	assert.Contains(t, out.String(), "flag provided but not defined: -bogus")
+
+	assert.ErrorIs(t, root.execute(ctx, &out, "", nil), errUsage)
+	assert.Len(t, got, 2, "nothing else ran")
+}
+
+func TestCommandHelp(t *testing.T) {
+	var got []string
+	var limit int
+	root := newTestTree(&got, &limit)
+	var out bytes.Buffer
+	ctx := context.Background()
+
+	assert.ErrorIs(t, root.execute(ctx, &out, "", []string{"-h"}), flag.ErrHelp)
+	assert.Contains(t, out.String(), "tool <command>")
+	assert.Contains(t, out.String(), "things")
+
+	out.Reset()
+	assert.ErrorIs(t, root.execute(ctx, &out, "", []string{"things", "list", "-h"}), flag.ErrHelp)
+	assert.Contains(t, out.String(), "tool things list [flags]")
+	assert.Contains(t, out.String(), "Maximum number of things")
+
+	out.Reset()
+	assert.ErrorIs(t, root.execute(ctx, &out, "", []string{"help", "things", "list"}), flag.ErrHelp)
+	assert.Contains(t, out.String(), "Maximum number of things")
+
+	assert.Empty(t, got)
+}
+
+func TestOptionalBool(t *testing.T) {
+	var q queryFlags
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	q.outputFlags(fs)
+	require.NoError(t, fs.Parse(nil))
+	assert.False(t, q.json.set)
+
+	require.NoError(t, fs.Parse([]string{"-json=false"}))
+	assert.True(t, q.json.set)
+	assert.False(t, q.json.value)
+
+	require.NoError(t, fs.Parse([]string{"-json"}))
+	assert.True(t, q.json.value)
+}
+
+func TestOutputFlag(t *testing.T) {
+	var q queryFlags
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	q.outputFlags(fs)
+
+	require.NoError(t, fs.Parse([]string{"-output", "jsonl"}))
+	assert.Equal(t, outputJSONL, q.output)
+	assert.True(t, q.output.structured())
+	assert.ErrorContains(t, fs.Parse([]string{"-output", "yaml"}), "use text, json, or jsonl")
+}
+
+func TestQueryFlagsOpen_NoConfig(t *testing.T) {
+	withTempConfig(t)
+
+	var q queryFlags
+	_, err := q.open()
+	assert.ErrorContains(t, err, "costco-cli setup")
+}
+
+func TestCommandExecute_OptionalArg(t *testing.T) {
+	var got []string
+	cmd := &command{
+		name:        "track",
+		optionalArg: "order",
+		run: func(ctx context.Context, args []string) error {
+			got = append(got, strings.Join(args, " "))
+			return nil
+		},
+	}
+	var out bytes.Buffer
+	ctx := context.Background()
+
+	require.NoError(t, cmd.execute(ctx, &out, "", nil))
+	require.NoError(t, cmd.execute(ctx, &out, "", []string{"O1"}))
+	assert.Equal(t, []string{"", "O1"}, got)
+
+	assert.ErrorIs(t, cmd.execute(ctx, &out, "", []string{"O1", "O2"}), errUsage)
+	assert.Contains(t, out.String(), `"track" takes 0 or 1 argument(s), got 2`)
+	assert.Contains(t, out.String(), "track [order]")
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+)
+
+// Shell completion. The generated scripts call the hidden __complete command with the
+// words typed so far, so new commands and flags complete without regenerating them.
+
+// completionBarcodes is how many recent barcodes "receipts get" offers.
+const completionBarcodes = 20
+
+var completionScripts = map[string]string{
+	"bash": `# bash completion for %[1]s
+_%[2]s() {
+    local IFS=$'\n'
+    COMPREPLY=($(%[1]s __complete "${COMP_WORDS[@]:1:COMP_CWORD}" 2>/dev/null))
+}
+complete -F _%[2]s %[1]s
+`,
+	"zsh": `#compdef %[1]s
+# zsh completion for %[1]s
+_%[2]s() {
+    local -a candidates
+    candidates=(${(f)"$(%[1]s __complete "${(@)words[2,CURRENT]}" 2>/dev/null)"})
+    compadd -a candidates
+}
+compdef _%[2]s %[1]s
+`,
+	"fish": `# fish completion for %[1]s
+function __%[2]s_complete
+    set -l current (commandline -ct)
+    %[1]s __complete (commandline -opc)[2..-1] "$current" 2>/dev/null
+end
+complete -c %[1]s -f -a '(__%[2]s_complete)'
+`,
+}
+
+func completionCommand(root *command) *command {
+	return &command{
+		name:  "completion",
+		args:  []string{"bash|zsh|fish"},
+		short: "Generate a shell completion script",
+		long: `Load completions for the current shell session with:
+
+  bash:  source <(costco-cli completion bash)
+  zsh:   source <(costco-cli completion zsh)
+  fish:  costco-cli completion fish | source
+
+Receipt barcodes complete from the local transaction store (Config.UseLocalStore).`,
+		complete: func(prefix string) []string {
+			return matching([]string{"bash", "fish", "zsh"}, prefix)
+		},
+		run: func(ctx context.Context, args []string) error {
+			return writeCompletionScript(os.Stdout, root.name, args[0])
+		},
+	}
+}
+
+func writeCompletionScript(w io.Writer, program, shell string) error {
+	script, ok := completionScripts[shell]
+	if !ok {
+		return fmt.Errorf("unsupported shell %q: must be bash, zsh, or fish", shell)
+	}
+	function := strings.NewReplacer("-", "_", ".", "_").Replace(program)
+	_, err := fmt.Fprintf(w, script, program, function)
+	return err
+}
+
+// completeCommand is the hidden command the completion scripts call. Its arguments
+// are the words after the program name; the last one is the word being completed.
+func completeCommand(root *command) *command {
+	return &command{
+		name:    "__complete",
+		hidden:  true,
+		rawArgs: true,
+		run: func(ctx context.Context, args []string) error {
+			for _, candidate := range root.completions(args) {
+				fmt.Println(candidate)
+			}
+			return nil
+		},
+	}
+}
+
+// completions returns the candidates for the last of words, which may be empty.
+func (c *command) completions(words []string) []string {
+	if len(words) == 0 {
+		words = []string{""}
+	}
+	prefix := words[len(words)-1]
+	words = words[:len(words)-1]
+
+	target := c
+	for len(words) > 0 && len(target.subcommands) > 0 {
+		// Skip group flags such as "-profile NAME"
+		if strings.HasPrefix(words[0], "-") {
+			if takesValue(target.flagSet(io.Discard, target.name), words[0]) {
+				if len(words) == 1 {
+					return nil // Completing the flag's value
+				}
+				words = words[1:]
+			}
+			words = words[1:]
+			continue
+		}
+		sub := target.find(words[0])
+		if sub == nil {
+			return nil
+		}
+		target, words = sub, words[1:]
+	}
+
+	if len(target.subcommands) > 0 {
+		if strings.HasPrefix(prefix, "-") {
+			return target.flagCompletions(prefix)
+		}
+		var names []string
+		for _, sub := range target.subcommands {
+			if !sub.hidden {
+				names = append(names, sub.name)
+			}
+		}
+		return matching(names, prefix)
+	}
+	if target.rawArgs {
+		return nil
+	}
+
+	fs := target.flagSet(io.Discard, target.name)
+	if strings.HasPrefix(prefix, "-") {
+		return target.flagCompletions(prefix)
+	}
+	if len(words) > 0 && takesValue(fs, words[len(words)-1]) {
+		return nil
+	}
+	if target.complete == nil {
+		return nil
+	}
+	return target.complete(prefix)
+}
+
+func (c *command) flagCompletions(prefix string) []string {
+	var names []string
+	c.flagSet(io.Discard, c.name).VisitAll(func(f *flag.Flag) { names = append(names, "-"+f.Name) })
+	return matching(names, prefix)
+}
+
+// takesValue reports whether word is a flag that consumes the next word as its value.
+func takesValue(fs *flag.FlagSet, word string) bool {
+	name := strings.TrimLeft(word, "-")
+	if name == word || strings.Contains(name, "=") {
+		return false
+	}
+	f := fs.Lookup(name)
+	if f == nil {
+		return false
+	}
+	if b, ok := f.Value.(interface{ IsBoolFlag() bool }); ok && b.IsBoolFlag() {
+		return false
+	}
+	return true
+}
+
+// completeBarcodes offers the newest receipt barcodes from the local store. It never
+// calls the API, so completion stays instant and works offline.
+func completeBarcodes(prefix string) []string {
+	barcodes, err := costco.RecentStoredBarcodes(completionBarcodes)
+	if err != nil {
+		return nil
+	}
+	return matching(barcodes, prefix)
+}
+
+func matching(candidates []string, prefix string) []string {
+	var matches []string
+	for _, candidate := range candidates {
+		if strings.HasPrefix(candidate, prefix) {
+			matches = append(matches, candidate)
+		}
+	}
+	return matches
+}
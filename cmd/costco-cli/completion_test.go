@@ -0,0 +1,38 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompletions(t *testing.T) {
+	withTempConfig(t)
+	root := newRootCommand()
+
+	assert.Equal(t, []string{"orders"}, root.completions([]string{"or"}))
+	assert.NotContains(t, root.completions(nil), "__complete", "hidden commands are not offered")
+	assert.Equal(t, []string{"list", "photos", "track"}, root.completions([]string{"orders", ""}))
+	assert.Equal(t, []string{"-columns", "-end", "-json", "-last", "-month", "-output", "-quarter", "-sort", "-start", "-type", "-wide", "-ytd"}, root.completions([]string{"receipts", "list", "-"}))
+	assert.Equal(t, []string{"-size", "-sort", "-start"}, root.completions([]string{"orders", "list", "-s"}))
+	assert.Equal(t, []string{"zsh"}, root.completions([]string{"completion", "z"}))
+	assert.Empty(t, root.completions([]string{"receipts", "get", ""}), "no local store")
+	assert.Empty(t, root.completions([]string{"bogus", ""}))
+	assert.Empty(t, root.completions([]string{"compare", "-start", ""}), "flag value")
+	assert.Equal(t, []string{"-profile"}, root.completions([]string{"-p"}))
+	assert.Empty(t, root.completions([]string{"-profile", ""}), "global flag value")
+	assert.Equal(t, []string{"list", "photos", "track"}, root.completions([]string{"-profile", "spouse", "orders", ""}))
+}
+
+func TestWriteCompletionScript(t *testing.T) {
+	for _, shell := range []string{"bash", "zsh", "fish"} {
+		var out bytes.Buffer
+		require.NoError(t, writeCompletionScript(&out, "costco-cli", shell))
+		assert.Contains(t, out.String(), "costco-cli __complete")
+		assert.Contains(t, out.String(), "_costco_cli")
+	}
+
+	assert.ErrorContains(t, writeCompletionScript(&bytes.Buffer{}, "costco-cli", "powershell"), "unsupported shell")
+}
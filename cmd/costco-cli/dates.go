@@ -0,0 +1,187 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Dates on the command line are YYYY-MM-DD. A start date can also be a span back
+// from today (30d, 12w, 6m, 1y), and -last, -month, -quarter, and -ytd pick a whole
+// period instead of -start and -end.
+
+const dateLayout = "2006-01-02"
+
+const spanHelp = "a span like 30d, 12w, 6m, or 1y"
+
+// periodFlags registers the flags that pick a period in place of -start and -end.
+func (q *queryFlags) periodFlags(fs *flag.FlagSet) {
+	fs.StringVar(&q.last, "last", "", "Period ending today: "+spanHelp)
+	fs.StringVar(&q.month, "month", "", "Calendar month: jan, march, or YYYY-MM (without a year, the latest one)")
+	fs.StringVar(&q.quarter, "quarter", "", "Calendar quarter: Q1-Q4 or YYYY-Q1 (without a year, the latest one)")
+	fs.BoolVar(&q.ytd, "ytd", false, "Year to date")
+}
+
+// periodSet reports whether one of the period flags is set.
+func (q *queryFlags) periodSet() bool {
+	return q.last != "" || q.month != "" || q.quarter != "" || q.ytd
+}
+
+// resolveDates turns the date flags into YYYY-MM-DD dates in start and end. Either
+// may stay empty for open to fill in from the profile defaults.
+func (q *queryFlags) resolveDates(today time.Time) error {
+	if q.periodSet() {
+		if q.start != "" || q.end != "" {
+			return errors.New("use -start and -end or one of -last, -month, -quarter, and -ytd, not both")
+		}
+		start, end, err := periodRange(q.last, q.month, q.quarter, q.ytd, today)
+		if err != nil {
+			return err
+		}
+		q.start, q.end = start, end
+		return nil
+	}
+
+	start, err := startDate(q.start, today)
+	if err != nil {
+		return err
+	}
+	q.start = start
+	if q.end != "" {
+		if _, err := time.Parse(dateLayout, q.end); err != nil {
+			return fmt.Errorf("invalid end date %q: use YYYY-MM-DD", q.end)
+		}
+	}
+	return nil
+}
+
+// startDate resolves a start date given as YYYY-MM-DD or as a span back from today.
+// An empty value stays empty.
+func startDate(value string, today time.Time) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	if _, err := time.Parse(dateLayout, value); err == nil {
+		return value, nil
+	}
+	start, ok := spanBack(value, today)
+	if !ok {
+		return "", fmt.Errorf("invalid start date %q: use YYYY-MM-DD or %s", value, spanHelp)
+	}
+	return start.Format(dateLayout), nil
+}
+
+// spanBack returns the day a span such as 30d, 12w, 6m, or 1y before today.
+func spanBack(span string, today time.Time) (time.Time, bool) {
+	if len(span) < 2 {
+		return time.Time{}, false
+	}
+	n, err := strconv.Atoi(span[:len(span)-1])
+	if err != nil || n <= 0 {
+		return time.Time{}, false
+	}
+	switch span[len(span)-1] {
+	case 'd':
+		return today.AddDate(0, 0, -n), true
+	case 'w':
+		return today.AddDate(0, 0, -7*n), true
+	case 'm':
+		return today.AddDate(0, -n, 0), true
+	case 'y':
+		return today.AddDate(-n, 0, 0), true
+	}
+	return time.Time{}, false
+}
+
+// periodRange returns the first and last day of the period picked by -last, -month,
+// -quarter, or -ytd, at most one of which may be set. A month or quarter given
+// without a year is the latest one that has started.
+func periodRange(last, month, quarter string, ytd bool, today time.Time) (string, string, error) {
+	set := 0
+	for _, v := range []bool{last != "", month != "", quarter != "", ytd} {
+		if v {
+			set++
+		}
+	}
+	if set > 1 {
+		return "", "", errors.New("use only one of -last, -month, -quarter, and -ytd")
+	}
+
+	today = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC)
+	var start, end time.Time
+	switch {
+	case last != "":
+		var ok bool
+		if start, ok = spanBack(last, today); !ok {
+			return "", "", fmt.Errorf("invalid -last %q: use %s", last, spanHelp)
+		}
+		end = today
+	case month != "":
+		var err error
+		if start, err = parseMonth(month, today); err != nil {
+			return "", "", err
+		}
+		end = start.AddDate(0, 1, -1)
+	case quarter != "":
+		var err error
+		if start, err = parseQuarter(quarter, today); err != nil {
+			return "", "", err
+		}
+		end = start.AddDate(0, 3, -1)
+	case ytd:
+		start, end = time.Date(today.Year(), time.January, 1, 0, 0, 0, 0, time.UTC), today
+	default:
+		return "", "", nil
+	}
+	return start.Format(dateLayout), end.Format(dateLayout), nil
+}
+
+// parseMonth returns the first day of a month given as YYYY-MM or by name.
+func parseMonth(value string, today time.Time) (time.Time, error) {
+	if t, err := time.Parse("2006-01", value); err == nil {
+		return t, nil
+	}
+	name := strings.ToLower(value)
+	if len(name) >= 3 {
+		for m := time.January; m <= time.December; m++ {
+			if strings.HasPrefix(strings.ToLower(m.String()), name) {
+				return latestStart(m, today), nil
+			}
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid month %q: use a name like jan or YYYY-MM", value)
+}
+
+// parseQuarter returns the first day of a quarter given as Q2 or YYYY-Q2.
+func parseQuarter(value string, today time.Time) (time.Time, error) {
+	year, q, hasYear := strings.Cut(strings.ToUpper(value), "-")
+	if !hasYear {
+		q = year
+	}
+	n, err := strconv.Atoi(strings.TrimPrefix(q, "Q"))
+	if !strings.HasPrefix(q, "Q") || err != nil || n < 1 || n > 4 {
+		return time.Time{}, fmt.Errorf("invalid quarter %q: use Q1-Q4 or YYYY-Q1", value)
+	}
+	first := time.Month(3*n - 2)
+	if !hasYear {
+		return latestStart(first, today), nil
+	}
+	y, err := strconv.Atoi(year)
+	if err != nil || len(year) != 4 {
+		return time.Time{}, fmt.Errorf("invalid quarter %q: use Q1-Q4 or YYYY-Q1", value)
+	}
+	return time.Date(y, first, 1, 0, 0, 0, 0, time.UTC), nil
+}
+
+// latestStart returns the first of month in this year, or last year if that is
+// still to come.
+func latestStart(month time.Month, today time.Time) time.Time {
+	year := today.Year()
+	if month > today.Month() {
+		year--
+	}
+	return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartDate(t *testing.T) {
+	today := time.Date(2025, 3, 31, 12, 0, 0, 0, time.UTC)
+	for value, want := range map[string]string{
+		"":           "",
+		"2024-06-01": "2024-06-01",
+		"30d":        "2025-03-01",
+		"2w":         "2025-03-17",
+		"1m":         "2025-03-03", // Feb 31 normalizes, as time.AddDate does
+		"1y":         "2024-03-31",
+	} {
+		got, err := startDate(value, today)
+		require.NoError(t, err, value)
+		assert.Equal(t, want, got, value)
+	}
+
+	for _, value := range []string{"y", "0d", "3x", "-1y", "3/01/2025", "last year"} {
+		_, err := startDate(value, today)
+		assert.Error(t, err, value)
+	}
+}
+
+func TestPeriodRange(t *testing.T) {
+	today := time.Date(2025, 5, 14, 18, 30, 0, 0, time.Local)
+	tests := []struct {
+		last, month, quarter string
+		ytd                  bool
+		start, end           string
+	}{
+		{last: "30d", start: "2025-04-14", end: "2025-05-14"},
+		{month: "jan", start: "2025-01-01", end: "2025-01-31"},
+		{month: "February", start: "2025-02-01", end: "2025-02-28"},
+		{month: "dec", start: "2024-12-01", end: "2024-12-31"}, // latest December
+		{month: "2024-02", start: "2024-02-01", end: "2024-02-29"},
+		{quarter: "Q2", start: "2025-04-01", end: "2025-06-30"},
+		{quarter: "q3", start: "2024-07-01", end: "2024-09-30"},
+		{quarter: "2023-Q4", start: "2023-10-01", end: "2023-12-31"},
+		{ytd: true, start: "2025-01-01", end: "2025-05-14"},
+		{},
+	}
+	for _, tt := range tests {
+		start, end, err := periodRange(tt.last, tt.month, tt.quarter, tt.ytd, today)
+		require.NoError(t, err, tt)
+		assert.Equal(t, [2]string{tt.start, tt.end}, [2]string{start, end}, tt)
+	}
+
+	for _, bad := range []struct{ last, month, quarter string }{
+		{last: "2025-01-01"}, {month: "ja"}, {month: "smarch"}, {quarter: "Q5"}, {quarter: "2"}, {quarter: "25-Q1"},
+	} {
+		_, _, err := periodRange(bad.last, bad.month, bad.quarter, false, today)
+		assert.Error(t, err, bad)
+	}
+	_, _, err := periodRange("30d", "", "", true, today)
+	assert.ErrorContains(t, err, "only one")
+}
+
+func TestResolveDates(t *testing.T) {
+	today := time.Date(2025, 5, 14, 0, 0, 0, 0, time.UTC)
+
+	q := queryFlags{quarter: "Q1"}
+	require.NoError(t, q.resolveDates(today))
+	assert.Equal(t, [2]string{"2025-01-01", "2025-03-31"}, [2]string{q.start, q.end})
+
+	q = queryFlags{start: "6m"}
+	require.NoError(t, q.resolveDates(today))
+	assert.Equal(t, [2]string{"2024-11-14", ""}, [2]string{q.start, q.end})
+
+	q = queryFlags{start: "2025-01-01", ytd: true}
+	assert.ErrorContains(t, q.resolveDates(today), "not both")
+
+	q = queryFlags{end: "5/14/2025"}
+	assert.ErrorContains(t, q.resolveDates(today), "invalid end date")
+}
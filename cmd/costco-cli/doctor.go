@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+)
+
+func doctorCommand() *command {
+	var jsonOut bool
+	return &command{
+		name:  "doctor",
+		short: "Check the config, sign-in, connection, clock, and local store",
+		long: `Runs each check and prints whether it passed, with a hint on how to fix any that
+didn't: the config, the stored tokens, whether Costco's servers can be reached,
+the local clock against theirs, the local store kept by sync, and the shape of the
+receipts and orders responses. Exits with an error if any check fails.`,
+		flags: func(fs *flag.FlagSet) {
+			fs.BoolVar(&jsonOut, "json", false, "Output as JSON")
+		},
+		run: func(ctx context.Context, args []string) error {
+			// Unlike query commands, doctor runs with a missing or broken config so it
+			// can report what's wrong.
+			var config costco.Config
+			storedConfig, configErr := costco.LoadConfig()
+			if configErr == nil && storedConfig == nil {
+				configErr = fmt.Errorf("no configuration found")
+			}
+			if configErr == nil {
+				config = storedConfig.ClientConfig()
+			}
+			config.Logger = clientLogger()
+
+			checks := costco.NewClient(config).Diagnose(ctx)
+			if configErr != nil {
+				checks[0] = costco.DiagnosticCheck{
+					Name: "config", Status: costco.CheckFail, Detail: configErr.Error(),
+					Hint: fmt.Sprintf("Run '%s setup'", cliName()),
+				}
+			}
+
+			if jsonOut {
+				if err := writeJSON(checks); err != nil {
+					return err
+				}
+			} else {
+				printDiagnostics(os.Stdout, checks)
+			}
+			for _, check := range checks {
+				if check.Status == costco.CheckFail {
+					return errors.New("some checks failed")
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// checkMarks are the symbol and color printed for each check status.
+var checkMarks = map[costco.CheckStatus]struct{ symbol, style string }{
+	costco.CheckPass: {"✓", styleGreen},
+	costco.CheckWarn: {"!", styleYellow},
+	costco.CheckFail: {"✗", styleRed},
+	costco.CheckSkip: {"-", ""},
+}
+
+// printDiagnostics prints one line per check, followed by its hint.
+func printDiagnostics(out io.Writer, checks []costco.DiagnosticCheck) {
+	for _, check := range checks {
+		mark := checkMarks[check.Status]
+		fmt.Fprintf(out, "%s %-13s %s\n", paint(mark.style, mark.symbol), check.Name, check.Detail)
+		if check.Hint != "" {
+			fmt.Fprintf(out, "  → %s\n", check.Hint)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintDiagnostics(t *testing.T) {
+	var out bytes.Buffer
+	printDiagnostics(&out, []costco.DiagnosticCheck{
+		{Name: "config", Status: costco.CheckPass, Detail: "warehouse 847"},
+		{Name: "clock", Status: costco.CheckFail, Detail: "local clock is 10m0s off", Hint: "Sync the system clock"},
+		{Name: "schema", Status: costco.CheckSkip, Detail: "needs valid tokens"},
+	})
+	assert.Equal(t, "✓ config        warehouse 847\n"+
+		"✗ clock         local clock is 10m0s off\n"+
+		"  → Sync the system clock\n"+
+		"- schema        needs valid tokens\n", out.String())
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+)
+
+func exportCommand() *command {
+	var (
+		q       queryFlags
+		opts    costco.ExportOptions
+		columns string
+		output  string
+	)
+	transactionColumns, _ := costco.ExportColumns(costco.ExportLevelTransaction)
+	itemColumns, _ := costco.ExportColumns(costco.ExportLevelItem)
+
+	return &command{
+		name:  "export",
+		short: "Export receipts or their line items as CSV or JSON",
+		long: fmt.Sprintf(`Columns for -level transaction:
+  %s
+
+Columns for -level item:
+  %s`, strings.Join(transactionColumns, ", "), strings.Join(itemColumns, ", ")),
+		flags: func(fs *flag.FlagSet) {
+			q.dateFlags(fs)
+			q.typeFlag(fs)
+			q.tagFlag(fs)
+			q.serviceOrdersFlag(fs)
+			fs.StringVar(&opts.Format, "format", costco.ExportFormatCSV, "Output format: csv, json, or jsonl (one object per line)")
+			fs.StringVar(&opts.Level, "level", costco.ExportLevelTransaction, "One row per transaction or per item")
+			fs.StringVar(&columns, "columns", "", "Comma-separated columns to write (default: all)")
+			fs.StringVar(&output, "o", "", "Write to this file instead of stdout")
+		},
+		run: func(ctx context.Context, args []string) error {
+			if columns != "" {
+				opts.Columns = strings.Split(columns, ",")
+				for i := range opts.Columns {
+					opts.Columns[i] = strings.TrimSpace(opts.Columns[i])
+				}
+			}
+			// Catch bad options before fetching anything
+			if err := costco.WriteExport(io.Discard, nil, opts); err != nil {
+				return err
+			}
+
+			s, err := q.open()
+			if err != nil {
+				return err
+			}
+			transactions, err := s.client.GetAllTransactionItems(ctx, s.start, s.end)
+			if err != nil {
+				return fmt.Errorf("getting transactions: %w", err)
+			}
+
+			if output == "" {
+				return costco.WriteExport(os.Stdout, transactions, opts)
+			}
+			f, err := os.Create(output)
+			if err != nil {
+				return err
+			}
+			if err := costco.WriteExport(f, transactions, opts); err != nil {
+				f.Close()
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+			fmt.Fprintf(os.Stderr, "Wrote %d transactions to %s\n", len(transactions), output)
+			return nil
+		},
+	}
+}
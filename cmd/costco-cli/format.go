@@ -0,0 +1,114 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"time"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+)
+
+// money formats an amount for display, appending the currency code for
+// anything other than US dollars so Canadian totals aren't mistaken for USD.
+func money(amount float64, currency string) string {
+	if currency == "" || currency == costco.CurrencyUSD {
+		return fmt.Sprintf("$%.2f", amount)
+	}
+	return fmt.Sprintf("$%.2f %s", amount, currency)
+}
+
+// unitPrice formats a fuel price to the tenth of a cent, as stations post it.
+func unitPrice(amount float64, currency string) string {
+	if currency == "" || currency == costco.CurrencyUSD {
+		return fmt.Sprintf("$%.3f", amount)
+	}
+	return fmt.Sprintf("$%.3f %s", amount, currency)
+}
+
+// yearEarlier returns a YYYY-MM-DD date one year before date, or date unchanged if it doesn't parse.
+func yearEarlier(date string) string {
+	t, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return date
+	}
+	return t.AddDate(-1, 0, 0).Format("2006-01-02")
+}
+
+// writeJSON prints v to stdout as indented JSON.
+func writeJSON(v any) error {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+// outputFormat is how a query command prints its results.
+type outputFormat string
+
+const (
+	outputText  outputFormat = costco.OutputFormatText
+	outputJSON  outputFormat = costco.OutputFormatJSON
+	outputJSONL outputFormat = costco.OutputFormatJSONL // One compact object per line, for jq and friends
+)
+
+// structured reports whether results are printed as JSON rather than tables.
+func (f outputFormat) structured() bool {
+	return f == outputJSON || f == outputJSONL
+}
+
+// write prints v as indented JSON or, for JSONL, one line per element when v is a
+// slice and a single line otherwise.
+func (f outputFormat) write(v any) error {
+	return f.writeRecords(v, v)
+}
+
+// writeRecords is write for a response that wraps a list, such as a page of orders:
+// JSON prints the whole response, JSONL one line per record.
+func (f outputFormat) writeRecords(v, records any) error {
+	if f != outputJSONL {
+		return writeJSON(v)
+	}
+	return writeJSONLines(os.Stdout, records)
+}
+
+// writeJSONLines writes each element of a slice as a compact JSON line, or v as one
+// line when it isn't a slice.
+func writeJSONLines(w io.Writer, v any) error {
+	encoder := json.NewEncoder(w)
+	list := reflect.ValueOf(v)
+	if list.Kind() != reflect.Slice && list.Kind() != reflect.Array {
+		return encoder.Encode(v)
+	}
+	for i := range list.Len() {
+		if err := encoder.Encode(list.Index(i).Interface()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sparkBlocks are the bar heights sparkline draws with, lowest first.
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// sparkline draws values as a row of bars scaled between their min and max.
+// Equal values draw as a flat line.
+func sparkline(values []float64) string {
+	if len(values) == 0 {
+		return ""
+	}
+	low, high := values[0], values[0]
+	for _, v := range values {
+		low, high = min(low, v), max(high, v)
+	}
+	bars := make([]rune, len(values))
+	for i, v := range values {
+		level := 0
+		if high > low {
+			level = int((v - low) / (high - low) * float64(len(sparkBlocks)-1))
+		}
+		bars[i] = sparkBlocks[level]
+	}
+	return string(bars)
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoney(t *testing.T) {
+	assert.Equal(t, "$12.50", money(12.5, ""))
+	assert.Equal(t, "$12.50", money(12.5, "USD"))
+	assert.Equal(t, "$12.50 CAD", money(12.5, "CAD"))
+	assert.Equal(t, "$-4.00", money(-4, ""))
+}
+
+func TestUnitPrice(t *testing.T) {
+	assert.Equal(t, "$3.599", unitPrice(3.599, ""))
+	assert.Equal(t, "$1.649 CAD", unitPrice(1.649, "CAD"))
+}
+
+func TestYearEarlier(t *testing.T) {
+	assert.Equal(t, "2024-03-15", yearEarlier("2025-03-15"))
+	assert.Equal(t, "bogus", yearEarlier("bogus"))
+}
+
+func TestSparkline(t *testing.T) {
+	assert.Equal(t, "", sparkline(nil))
+	assert.Equal(t, "▁▁▁", sparkline([]float64{4, 4, 4}))
+	assert.Equal(t, "▁▆█▁", sparkline([]float64{10, 15, 17, 10}))
+}
+
+func TestWriteJSONLines(t *testing.T) {
+	type row struct {
+		Barcode string  `json:"barcode"`
+		Total   float64 `json:"total"`
+	}
+	var buf bytes.Buffer
+	require.NoError(t, writeJSONLines(&buf, []row{{"R1", 27.5}, {"R2", -3}}))
+	assert.Equal(t, `{"barcode":"R1","total":27.5}`+"\n"+`{"barcode":"R2","total":-3}`+"\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, writeJSONLines(&buf, row{"R3", 1}))
+	assert.Equal(t, `{"barcode":"R3","total":1}`+"\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, writeJSONLines(&buf, []row{}))
+	assert.Empty(t, buf.String())
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+)
+
+var frequentColumns = []tableColumn{
+	{name: "rank", numeric: true},
+	{name: "item"},
+	{name: "description", maxWidth: 40},
+	{name: "times_bought", numeric: true},
+	{name: "quantity", numeric: true, wide: true},
+	{name: "total_spent", numeric: true},
+	{name: "average_price", numeric: true},
+}
+
+func frequentCommand() *command {
+	var (
+		q    queryFlags
+		opts tableOptions
+		top  int
+		by   string
+	)
+	return &command{
+		name:  "frequent",
+		short: "The items you buy most often, or spend the most on",
+		long: `Times bought counts receipts that include the item. Average price is the total
+spent divided by the units bought, after instant savings.`,
+		flags: func(fs *flag.FlagSet) {
+			fs.IntVar(&top, "top", 20, "Number of items to list (0 for all)")
+			fs.StringVar(&by, "by", "count", "Rank by times bought (count) or total spent (spend)")
+			fs.StringVar(&q.start, "since", "", "First day to include: YYYY-MM-DD or "+spanHelp+" (default from config date range)")
+			fs.StringVar(&q.end, "end", "", "Last day to include (YYYY-MM-DD) (default: today)")
+			q.periodFlags(fs)
+			q.localFlag(fs)
+			q.outputFlags(fs)
+			opts.register(fs, frequentColumns)
+		},
+		run: func(ctx context.Context, args []string) error {
+			if by != "count" && by != "spend" {
+				return fmt.Errorf("invalid -by %q: use count or spend", by)
+			}
+			if top < 0 {
+				return fmt.Errorf("-top must not be negative")
+			}
+			if err := opts.validate(frequentColumns); err != nil {
+				return err
+			}
+			s, err := q.open()
+			if err != nil {
+				return err
+			}
+			items, err := s.client.GetFrequentItems(ctx, s.start, s.end, 0)
+			if err != nil {
+				return fmt.Errorf("getting frequent items: %w", err)
+			}
+			items = rankFrequent(items, by, top)
+			if s.output.structured() {
+				return s.output.write(items)
+			}
+			return printFrequent(items, s.start, s.end, s.config.Currency, opts)
+		},
+	}
+}
+
+// rankFrequent orders items by times bought or total spent, breaking ties with the
+// other figure and then the item number, and keeps the first top (0 keeps all).
+func rankFrequent(items []costco.FrequentItem, by string, top int) []costco.FrequentItem {
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		if by == "spend" && a.TotalSpent != b.TotalSpent {
+			return a.TotalSpent > b.TotalSpent
+		}
+		if a.PurchaseCount != b.PurchaseCount {
+			return a.PurchaseCount > b.PurchaseCount
+		}
+		if a.TotalSpent != b.TotalSpent {
+			return a.TotalSpent > b.TotalSpent
+		}
+		return a.ItemNumber < b.ItemNumber
+	})
+	if top > 0 && top < len(items) {
+		items = items[:top]
+	}
+	return items
+}
+
+func printFrequent(items []costco.FrequentItem, startDate, endDate, currency string, opts tableOptions) error {
+	fmt.Printf("Most bought items (%s to %s)\n", startDate, endDate)
+	if len(items) == 0 {
+		fmt.Println("No purchases")
+		return nil
+	}
+	fmt.Println()
+
+	t := newTable(frequentColumns)
+	for i, item := range items {
+		var average float64
+		if item.TotalQuantity != 0 {
+			average = item.TotalSpent / float64(item.TotalQuantity)
+		}
+		t.add(i+1, item.ItemNumber, item.ItemDescription, item.PurchaseCount, item.TotalQuantity,
+			tableMoney{item.TotalSpent, currency}, tableMoney{average, currency})
+	}
+	return t.render(os.Stdout, opts)
+}
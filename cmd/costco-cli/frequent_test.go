@@ -0,0 +1,30 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRankFrequent(t *testing.T) {
+	items := func() []costco.FrequentItem {
+		return []costco.FrequentItem{
+			{ItemNumber: "3", PurchaseCount: 2, TotalSpent: 90},
+			{ItemNumber: "1", PurchaseCount: 5, TotalSpent: 20},
+			{ItemNumber: "2", PurchaseCount: 2, TotalSpent: 90},
+			{ItemNumber: "4", PurchaseCount: 2, TotalSpent: 10},
+		}
+	}
+	numbers := func(items []costco.FrequentItem) []string {
+		var n []string
+		for _, item := range items {
+			n = append(n, item.ItemNumber)
+		}
+		return n
+	}
+
+	assert.Equal(t, []string{"1", "2", "3", "4"}, numbers(rankFrequent(items(), "count", 0)))
+	assert.Equal(t, []string{"2", "3", "1"}, numbers(rankFrequent(items(), "spend", 3)))
+	assert.Equal(t, []string{"1"}, numbers(rankFrequent(items(), "count", 1)))
+}
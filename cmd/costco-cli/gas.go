@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+)
+
+func gasCommand() *command {
+	var (
+		q    queryFlags
+		opts tableOptions
+		year string
+	)
+	return &command{
+		name:  "gas",
+		short: "Fuel bought at Costco gas stations: volume, spend, and price by grade and month",
+		long:  "Pick the period with -year or the usual date flags. Volume is in gallons in the US and liters in Canada.",
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&year, "year", "", "Calendar year (YYYY)")
+			q.dateFlags(fs)
+			q.outputFlags(fs)
+			opts.wideFlag(fs)
+		},
+		run: func(ctx context.Context, args []string) error {
+			start, end, err := summaryRange(year, "")
+			if err != nil {
+				return err
+			}
+			if start != "" {
+				if q.start != "" || q.end != "" || q.periodSet() {
+					return errors.New("use -year without the other date flags")
+				}
+				q.start, q.end = start, end
+			}
+
+			s, err := q.open()
+			if err != nil {
+				return err
+			}
+			summary, err := s.client.GetFuelSummary(ctx, s.start, s.end)
+			if err != nil {
+				return fmt.Errorf("getting fuel summary: %w", err)
+			}
+			if s.output.structured() {
+				return s.output.write(summary)
+			}
+			return printFuelSummary(summary, s.start, s.end, s.config.Currency, opts)
+		},
+	}
+}
+
+func printFuelSummary(summary *costco.FuelSummary, startDate, endDate, currency string, opts tableOptions) error {
+	fmt.Printf("Fuel %s to %s\n", startDate, endDate)
+	fmt.Println(strings.Repeat("=", 80))
+	if summary.FillUps == 0 {
+		fmt.Println("No fill-ups")
+		return nil
+	}
+	unit := strings.ToLower(summary.UnitOfMeasure)
+	fmt.Printf("Volume:         %.1f %s\n", summary.Volume, unit)
+	fmt.Printf("Spent:          %s\n", money(summary.Spent, currency))
+	fmt.Printf("Average price:  %s/%s\n", unitPrice(summary.AveragePrice, currency), unit)
+	if summary.DaysBetween > 0 {
+		fmt.Printf("Fill-ups:       %d (every %.1f days)\n", summary.FillUps, summary.DaysBetween)
+	} else {
+		fmt.Printf("Fill-ups:       %d\n", summary.FillUps)
+	}
+
+	fmt.Println("\nBy grade:")
+	grades := newTable([]tableColumn{
+		{name: "grade"},
+		{name: "volume", numeric: true},
+		{name: "spent", numeric: true},
+		{name: "avg_price", numeric: true},
+	})
+	for _, g := range summary.ByGrade {
+		grades.add(g.Grade, g.Volume, tableMoney{g.Spent, currency}, tableUnitPrice{g.AveragePrice, currency})
+	}
+	if err := grades.render(os.Stdout, opts); err != nil {
+		return err
+	}
+
+	fmt.Println("\nBy month:")
+	months := newTable([]tableColumn{
+		{name: "month"},
+		{name: "fill_ups", numeric: true},
+		{name: "volume", numeric: true},
+		{name: "spent", numeric: true},
+		{name: "avg_price", numeric: true},
+	})
+	for _, m := range summary.ByMonth {
+		months.add(m.Month, m.FillUps, m.Volume, tableMoney{m.Spent, currency}, tableUnitPrice{m.AveragePrice, currency})
+	}
+	return months.render(os.Stdout, opts)
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+)
+
+func importCommand() *command {
+	var (
+		format string
+		dryRun bool
+	)
+	return &command{
+		name:  "import",
+		args:  []string{"file"},
+		short: "Load historical receipts from a CSV or JSON file into the local store",
+		long: `Adds receipts older than Costco's portal keeps to the local store, so -local
+queries and sync-based reports cover them. The file uses export's column names:
+a re-imported "costco export" works as-is, and a hand-entered file needs only a
+date column (YYYY-MM-DD) plus whatever it knows, e.g.
+
+  date,warehouse_name,total
+  2016-05-14,Issaquah,212.47
+
+Rows with item columns (item_number, description, amount) are line items and are
+grouped into receipts by date and barcode. Receipts already stored are replaced.
+Use "-" to read from stdin.`,
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&format, "format", "", "Input format: csv, json, or jsonl (default: from the file extension)")
+			fs.BoolVar(&dryRun, "dry-run", false, "Parse the file and report what would be imported")
+		},
+		run: func(ctx context.Context, args []string) error {
+			path := args[0]
+			if format == "" {
+				format = importFormat(path)
+			}
+			in := io.Reader(os.Stdin)
+			if path != "-" {
+				f, err := os.Open(path)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				in = f
+			}
+			return importReceipts(os.Stdout, in, format, dryRun)
+		},
+	}
+}
+
+// importFormat picks the import format from a file's extension, defaulting to CSV.
+func importFormat(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return costco.ExportFormatJSON
+	case ".jsonl", ".ndjson":
+		return costco.ExportFormatJSONL
+	default:
+		return costco.ExportFormatCSV
+	}
+}
+
+func importReceipts(out io.Writer, in io.Reader, format string, dryRun bool) error {
+	transactions, err := costco.ReadImport(in, format)
+	if err != nil {
+		return err
+	}
+	if len(transactions) == 0 {
+		fmt.Fprintln(out, "No receipts found")
+		return nil
+	}
+
+	oldest, newest := transactions[0].TransactionDate, transactions[0].TransactionDate
+	for _, tx := range transactions {
+		if tx.TransactionDate.Before(oldest) {
+			oldest = tx.TransactionDate
+		}
+		if tx.TransactionDate.After(newest) {
+			newest = tx.TransactionDate
+		}
+	}
+	span := fmt.Sprintf("%s to %s", oldest.Format(dateLayout), newest.Format(dateLayout))
+
+	if dryRun {
+		fmt.Fprintf(out, "Would import %d receipts from %s\n", len(transactions), span)
+		return nil
+	}
+	added, err := costco.ImportTransactions(transactions)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "✓ Imported %d receipts from %s", added, span)
+	if updated := len(transactions) - added; updated > 0 {
+		fmt.Fprintf(out, " (%d already stored, updated)", updated)
+	}
+	fmt.Fprintln(out)
+	return nil
+}
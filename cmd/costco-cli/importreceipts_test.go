@@ -0,0 +1,42 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestImportFormat(t *testing.T) {
+	assert.Equal(t, costco.ExportFormatCSV, importFormat("receipts.csv"))
+	assert.Equal(t, costco.ExportFormatJSON, importFormat("old/Receipts.JSON"))
+	assert.Equal(t, costco.ExportFormatJSONL, importFormat("receipts.ndjson"))
+	assert.Equal(t, costco.ExportFormatCSV, importFormat("-"))
+}
+
+func TestImportReceipts(t *testing.T) {
+	withTempConfig(t)
+	csv := "date,barcode,total\n2016-05-14,H1,212.47\n2015-11-02,H2,80\n"
+
+	var out bytes.Buffer
+	require.NoError(t, importReceipts(&out, strings.NewReader(csv), costco.ExportFormatCSV, true))
+	assert.Equal(t, "Would import 2 receipts from 2015-11-02 to 2016-05-14\n", out.String())
+	stats, err := costco.TransactionStoreStats()
+	require.NoError(t, err)
+	assert.Empty(t, stats.Segments, "dry run stores nothing")
+
+	out.Reset()
+	require.NoError(t, importReceipts(&out, strings.NewReader(csv), costco.ExportFormatCSV, false))
+	assert.Equal(t, "✓ Imported 2 receipts from 2015-11-02 to 2016-05-14\n", out.String())
+
+	out.Reset()
+	require.NoError(t, importReceipts(&out, strings.NewReader(csv), costco.ExportFormatCSV, false))
+	assert.Equal(t, "✓ Imported 0 receipts from 2015-11-02 to 2016-05-14 (2 already stored, updated)\n", out.String())
+
+	out.Reset()
+	require.NoError(t, importReceipts(&out, strings.NewReader("date,total\n"), costco.ExportFormatCSV, false))
+	assert.Equal(t, "No receipts found\n", out.String())
+}
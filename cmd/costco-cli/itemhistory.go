@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+)
+
+var itemHistoryColumns = []tableColumn{
+	{name: "date"},
+	{name: "quantity", numeric: true},
+	{name: "unit_price", numeric: true},
+	{name: "paid", numeric: true},
+	{name: "warehouse", maxWidth: 30},
+	{name: "barcode", wide: true},
+}
+
+func itemHistoryCommand() *command {
+	var (
+		q    queryFlags
+		opts tableOptions
+	)
+	return &command{
+		name:  "item-history",
+		args:  []string{"item-number"},
+		short: "Every purchase of an item with its unit price and price trend",
+		long:  "Unit prices include instant savings. Returns are left out.",
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&q.start, "since", "", "First day to include: YYYY-MM-DD or "+spanHelp+" (default from config date range)")
+			fs.StringVar(&q.end, "end", "", "Last day to include (YYYY-MM-DD) (default: today)")
+			q.periodFlags(fs)
+			q.localFlag(fs)
+			q.outputFlags(fs)
+			opts.register(fs, itemHistoryColumns)
+		},
+		run: func(ctx context.Context, args []string) error {
+			if err := opts.validate(itemHistoryColumns); err != nil {
+				return err
+			}
+			s, err := q.open()
+			if err != nil {
+				return err
+			}
+			history, err := s.client.GetItemPriceHistory(ctx, args[0], s.start, s.end)
+			if err != nil {
+				return fmt.Errorf("getting item history: %w", err)
+			}
+			if s.output.structured() {
+				return s.output.write(history)
+			}
+			return printItemHistory(history, s.start, s.end, s.config.Currency, opts)
+		},
+	}
+}
+
+func printItemHistory(history *costco.ItemPriceHistory, startDate, endDate, currency string, opts tableOptions) error {
+	fmt.Printf("Item %s (%s to %s)\n", history.ItemNumber, startDate, endDate)
+	if len(history.Points) == 0 {
+		fmt.Println("No purchases")
+		return nil
+	}
+	fmt.Println()
+
+	t := newTable(itemHistoryColumns)
+	prices := make([]float64, len(history.Points))
+	for i, p := range history.Points {
+		prices[i] = p.UnitPrice
+		t.add(p.Date, p.Quantity, tableMoney{p.UnitPrice, currency},
+			tableMoney{p.UnitPrice * float64(p.Quantity), currency}, p.Warehouse, p.Barcode)
+	}
+	if err := t.render(os.Stdout, opts); err != nil {
+		return err
+	}
+
+	fmt.Printf("\nPrice trend: %s\n", sparkline(prices))
+	fmt.Printf("Low %s, high %s, average %s over %d purchases\n",
+		money(history.Min, currency), money(history.Max, currency), money(history.Average, currency), len(history.Points))
+	return nil
+}
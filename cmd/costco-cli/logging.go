@@ -0,0 +1,66 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+)
+
+// Logging. The library logs through Config.Logger; the root flags choose the level
+// and format, and logs go to stderr so they never mix with command output.
+
+// logFormats are the handlers -log-format can pick.
+var logFormats = []string{"text", "json"}
+
+// logSettings is set by the root flags before any command runs.
+type logSettings struct {
+	level  slog.LevelVar // Warnings and errors by default
+	format string
+}
+
+var cliLog = newLogSettings()
+
+func newLogSettings() *logSettings {
+	l := &logSettings{format: "text"}
+	l.level.Set(slog.LevelWarn)
+	return l
+}
+
+func (l *logSettings) register(fs *flag.FlagSet) {
+	fs.BoolFunc("verbose", "Log debug detail, including each API request (same as -log-level debug)", func(string) error {
+		l.level.Set(slog.LevelDebug)
+		return nil
+	})
+	fs.BoolFunc("quiet", "Log errors only (same as -log-level error)", func(string) error {
+		l.level.Set(slog.LevelError)
+		return nil
+	})
+	fs.Func("log-level", "Log level: debug, info, warn, or error (default warn)", func(value string) error {
+		return l.level.UnmarshalText([]byte(value))
+	})
+	fs.Func("log-format", "Log format: text or json (default text)", func(value string) error {
+		for _, format := range logFormats {
+			if value == format {
+				l.format = value
+				return nil
+			}
+		}
+		return fmt.Errorf("unknown format %q: use text or json", value)
+	})
+}
+
+// logger builds the logger passed to every client, writing to w.
+func (l *logSettings) logger(w io.Writer) *slog.Logger {
+	opts := &slog.HandlerOptions{Level: &l.level}
+	if l.format == "json" {
+		return slog.New(slog.NewJSONHandler(w, opts))
+	}
+	return slog.New(slog.NewTextHandler(w, opts))
+}
+
+// clientLogger is the Config.Logger for clients the CLI builds.
+func clientLogger() *slog.Logger {
+	return cliLog.logger(os.Stderr)
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogSettings(t *testing.T) {
+	parse := func(args ...string) (*logSettings, error) {
+		l := newLogSettings()
+		fs := flag.NewFlagSet("costco-cli", flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+		l.register(fs)
+		return l, fs.Parse(args)
+	}
+
+	var out bytes.Buffer
+	l, err := parse()
+	require.NoError(t, err)
+	log := l.logger(&out)
+	log.Info("fetching receipts")
+	log.Warn("array fallback", "operation", "receiptsWithCounts")
+	assert.NotContains(t, out.String(), "fetching receipts", "warnings and errors by default")
+	assert.Contains(t, out.String(), `level=WARN msg="array fallback" operation=receiptsWithCounts`)
+
+	out.Reset()
+	l, err = parse("-verbose", "-log-format", "json")
+	require.NoError(t, err)
+	l.logger(&out).Debug("executing graphql query")
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(out.Bytes(), &entry))
+	assert.Equal(t, "DEBUG", entry["level"])
+
+	out.Reset()
+	l, err = parse("-quiet")
+	require.NoError(t, err)
+	l.logger(&out).Warn("array fallback")
+	assert.Empty(t, out.String())
+
+	l, err = parse("-log-level", "info")
+	require.NoError(t, err)
+	assert.Equal(t, slog.LevelInfo, l.level.Level())
+
+	_, err = parse("-log-level", "loud")
+	assert.Error(t, err)
+	_, err = parse("-log-format", "xml")
+	assert.Error(t, err)
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+)
+
+func loginCommand() *command {
+	var refresh bool
+	return &command{
+		name:  "login",
+		short: "Sign in with a token response from your browser",
+		long: `Costco sign-in runs in the browser, with its captcha and verification steps, so
+login saves the token response the browser received. Paste it when prompted or
+pipe a saved copy with "costco-cli login < token.json". With -refresh, the stored
+refresh token is exchanged for new tokens instead, without the browser.`,
+		flags: func(fs *flag.FlagSet) {
+			fs.BoolVar(&refresh, "refresh", false, "Renew the session with the stored refresh token")
+		},
+		run: func(ctx context.Context, args []string) error {
+			if refresh {
+				return refreshLogin(os.Stdout)
+			}
+			return runImportTokens()
+		},
+	}
+}
+
+// importTokenCommand is the original name of login, kept so existing scripts work.
+func importTokenCommand() *command {
+	return &command{
+		name:   "import-token",
+		short:  "Same as login",
+		hidden: true,
+		run: func(ctx context.Context, args []string) error {
+			return runImportTokens()
+		},
+	}
+}
+
+func logoutCommand() *command {
+	return &command{
+		name:  "logout",
+		short: "Sign out by deleting the stored tokens",
+		long: `Deletes the profile's tokens.json; the config and local store are kept. Costco
+offers no way to revoke a refresh token, so it stays valid at Costco until it
+expires. Sign out on costco.com to end the browser session as well.`,
+		run: func(ctx context.Context, args []string) error {
+			return logout(os.Stdout)
+		},
+	}
+}
+
+// refreshLogin renews the stored session with its refresh token.
+func refreshLogin(out io.Writer) error {
+	tokens, err := costco.LoadTokens()
+	if err != nil {
+		return fmt.Errorf("loading tokens: %w", err)
+	}
+	if tokens == nil || tokens.RefreshToken == "" {
+		return fmt.Errorf("not signed in. Run '%s login' to sign in", cliName())
+	}
+
+	var config costco.Config
+	if storedConfig, err := costco.LoadConfig(); err == nil && storedConfig != nil {
+		config = storedConfig.ClientConfig()
+	}
+	config.Tokens = tokens
+	config.Logger = clientLogger()
+	if err := costco.NewClient(config).RefreshSession(); err != nil {
+		return err
+	}
+
+	tokens, err = costco.LoadTokens()
+	if err != nil || tokens == nil {
+		return fmt.Errorf("reading refreshed tokens: %v", err)
+	}
+	fmt.Fprintln(out, "✓ Session refreshed")
+	fmt.Fprintf(out, "  ID token valid until:      %s\n", tokens.TokenExpiry.Format("2006-01-02 15:04:05 MST"))
+	fmt.Fprintf(out, "  Refresh token valid until: %s\n", tokens.RefreshTokenExpiresAt.Format("2006-01-02 15:04:05 MST"))
+	return nil
+}
+
+func logout(out io.Writer) error {
+	tokens, err := costco.LoadTokens()
+	if err != nil {
+		return fmt.Errorf("loading tokens: %w", err)
+	}
+	if tokens == nil {
+		fmt.Fprintln(out, "Not signed in")
+		return nil
+	}
+	if err := costco.ClearTokens(); err != nil {
+		return fmt.Errorf("removing tokens: %w", err)
+	}
+	fmt.Fprintf(out, "✓ Signed out of profile %q. Run '%s login' to sign in again\n", costco.ActiveProfile(), cliName())
+	return nil
+}
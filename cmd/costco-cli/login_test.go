@@ -0,0 +1,43 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogout(t *testing.T) {
+	withTempConfig(t)
+	var out bytes.Buffer
+
+	require.NoError(t, logout(&out))
+	assert.Equal(t, "Not signed in\n", out.String())
+
+	require.NoError(t, costco.SaveTokens(&costco.StoredTokens{IDToken: "id", RefreshToken: "refresh", TokenExpiry: time.Now().Add(time.Hour)}))
+	out.Reset()
+	require.NoError(t, logout(&out))
+	assert.Contains(t, out.String(), `✓ Signed out of profile "default"`)
+
+	tokens, err := costco.LoadTokens()
+	require.NoError(t, err)
+	assert.Nil(t, tokens)
+}
+
+func TestRefreshLogin_NotSignedIn(t *testing.T) {
+	withTempConfig(t)
+	err := refreshLogin(&bytes.Buffer{})
+	assert.EqualError(t, err, "not signed in. Run 'costco-cli login' to sign in")
+}
+
+func TestImportTokenAlias(t *testing.T) {
+	root := newRootCommand()
+	alias := root.find("import-token")
+	require.NotNil(t, alias)
+	assert.True(t, alias.hidden)
+	assert.NotNil(t, root.find("login"))
+	assert.NotNil(t, root.find("logout"))
+}
@@ -2,189 +2,474 @@ package main
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
 	"log"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/eshaffer321/costco-go/pkg/costco"
 )
 
 func main() {
-	var (
-		command    = flag.String("cmd", "", "Command: setup, import-token, info, orders, receipts, receipt-detail")
-		startDate  = flag.String("start", "", "Start date (YYYY-MM-DD)")
-		endDate    = flag.String("end", "", "End date (YYYY-MM-DD)")
-		barcode    = flag.String("barcode", "", "Receipt barcode (for receipt-detail)")
-		pageNumber = flag.Int("page", 1, "Page number for orders")
-		pageSize   = flag.Int("size", 10, "Page size for orders")
-		outputJSON = flag.Bool("json", false, "Output as JSON")
-	)
-
-	flag.Parse()
+	if err := useProfileFromEnv(); err != nil {
+		log.Fatal(err)
+	}
+	err := newRootCommand().execute(context.Background(), os.Stderr, "", os.Args[1:])
+	switch {
+	case err == nil, errors.Is(err, flag.ErrHelp):
+	case errors.Is(err, errUsage):
+		os.Exit(2)
+	default:
+		log.Fatal(err)
+	}
+}
 
-	// Handle setup and info commands first
-	if *command == "setup" {
-		if err := setupCredentials(); err != nil {
-			log.Fatal(err)
-		}
-		return
+func newRootCommand() *command {
+	root := &command{
+		name:  "costco-cli",
+		short: "Query Costco orders, receipts, and membership details",
+		flags: func(fs *flag.FlagSet) {
+			fs.Func("profile", "Profile to use for this command (default $"+profileEnv+" or \"default\")", costco.UseProfile)
+			cliLog.register(fs)
+		},
+		subcommands: []*command{
+			setupCommand(),
+			loginCommand(),
+			logoutCommand(),
+			infoCommand(),
+			doctorCommand(),
+			profileCommand(),
+			ordersCommand(),
+			receiptsCommand(),
+			openCommand(),
+			compareCommand(),
+			tuiCommand(),
+			syncCommand(),
+			cacheCommand(),
+			importCommand(),
+			tagCommand(),
+			noteCommand(),
+			exportCommand(),
+			searchCommand(),
+			itemHistoryCommand(),
+			frequentCommand(),
+			gasCommand(),
+			summaryCommand(),
+			reportCommand(),
+			budgetCommand(),
+			watchCommand(),
+			serveCommand(),
+		},
 	}
+	root.subcommands = append(root.subcommands, completionCommand(root), completeCommand(root), importTokenCommand())
+	return root
+}
 
-	if *command == "import-token" {
-		if err := runImportTokens(); err != nil {
-			log.Fatal(err)
-		}
-		return
+func setupCommand() *command {
+	return &command{
+		name:  "setup",
+		short: "Store your email, warehouse, and query defaults",
+		long:  "Prompts for each setting and saves it to ~/.costco/config.json. Re-run it to change them.",
+		run: func(ctx context.Context, args []string) error {
+			return setupCredentials()
+		},
 	}
+}
 
-	if *command == "info" {
-		fmt.Println(costco.GetConfigInfo())
-		return
+func infoCommand() *command {
+	return &command{
+		name:  "info",
+		short: "Show config, token status, and membership",
+		run: func(ctx context.Context, args []string) error {
+			fmt.Println(costco.GetConfigInfo())
+			showMembership(ctx)
+			return nil
+		},
 	}
+}
 
-	// Load stored config
-	storedConfig, err := costco.LoadConfig()
-	if err != nil {
-		log.Fatalf("Error loading config: %v", err)
+func ordersCommand() *command {
+	var (
+		q        queryFlags
+		opts     tableOptions
+		page     int
+		pageSize int
+		all      bool
+	)
+	list := &command{
+		name:  "list",
+		short: "List online orders",
+		flags: func(fs *flag.FlagSet) {
+			q.dateFlags(fs)
+			fs.IntVar(&page, "page", 1, "Page number")
+			fs.IntVar(&pageSize, "size", 10, "Page size")
+			fs.BoolVar(&all, "all", false, "List every order in the date range instead of one page")
+			q.outputFlags(fs)
+			opts.register(fs, orderColumns)
+		},
+		run: func(ctx context.Context, args []string) error {
+			if err := opts.validate(orderColumns); err != nil {
+				return err
+			}
+			s, err := q.open()
+			if err != nil {
+				return err
+			}
+			if all {
+				return getAllOrders(ctx, s.client, s.start, s.end, s.output, opts)
+			}
+			return getOrders(ctx, s.client, s.start, s.end, page, pageSize, s.output, opts)
+		},
 	}
 
-	if storedConfig == nil {
-		log.Fatal("No configuration found. Run 'costco-cli -cmd setup' first")
+	var (
+		photoQuery queryFlags
+		photoOpts  tableOptions
+	)
+	photos := &command{
+		name:  "photos",
+		short: "List Photo Center orders",
+		flags: func(fs *flag.FlagSet) {
+			photoQuery.dateFlags(fs)
+			photoQuery.outputFlags(fs)
+			photoOpts.register(fs, photoOrderColumns)
+		},
+		run: func(ctx context.Context, args []string) error {
+			if err := photoOpts.validate(photoOrderColumns); err != nil {
+				return err
+			}
+			s, err := photoQuery.open()
+			if err != nil {
+				return err
+			}
+			return getPhotoOrders(ctx, s.client, s.start, s.end, s.output, photoOpts)
+		},
 	}
 
-	// Check if we have valid tokens
-	tokens, _ := costco.LoadTokens()
-	if tokens == nil || time.Now().After(tokens.RefreshTokenExpiresAt) {
-		log.Fatal("No valid tokens found. Run 'costco-cli -cmd import-token' to import tokens from your browser")
+	return &command{
+		name:        "orders",
+		short:       "Online and Photo Center orders",
+		subcommands: []*command{list, photos, trackCommand()},
 	}
+}
 
-	// Default date range if not provided
-	if *startDate == "" {
-		*startDate = time.Now().AddDate(0, -3, 0).Format("2006-01-02")
+func receiptsCommand() *command {
+	var (
+		listQuery queryFlags
+		listOpts  tableOptions
+	)
+	list := &command{
+		name:  "list",
+		short: "List warehouse and gas station receipts",
+		flags: func(fs *flag.FlagSet) {
+			listQuery.dateFlags(fs)
+			listQuery.typeFlag(fs)
+			listQuery.outputFlags(fs)
+			listOpts.register(fs, receiptColumns)
+		},
+		run: func(ctx context.Context, args []string) error {
+			if err := listOpts.validate(receiptColumns); err != nil {
+				return err
+			}
+			s, err := listQuery.open()
+			if err != nil {
+				return err
+			}
+			return getReceipts(ctx, s.client, s.start, s.end, s.config.DocumentType, s.config.DocumentSubType, s.output, listOpts)
+		},
 	}
-	if *endDate == "" {
-		*endDate = time.Now().Format("2006-01-02")
+
+	var (
+		getQuery queryFlags
+		getOpts  tableOptions
+		getType  = costco.DocumentTypeWarehouse
+	)
+	get := &command{
+		name:  "get",
+		args:  []string{"barcode"},
+		short: "Show a receipt with all of its line items",
+		flags: func(fs *flag.FlagSet) {
+			fs.Func("type", "Receipt document type: warehouse, fuel, or carwash (default warehouse)", func(value string) error {
+				switch value {
+				case costco.DocumentTypeWarehouse, costco.DocumentTypeFuel, costco.DocumentTypeCarWash:
+					getType = value
+					return nil
+				}
+				return fmt.Errorf("unknown type %q: use warehouse, fuel, or carwash", value)
+			})
+			getQuery.outputFlags(fs)
+			getOpts.register(fs, receiptItemColumns)
+		},
+		complete: completeBarcodes,
+		run: func(ctx context.Context, args []string) error {
+			if err := getOpts.validate(receiptItemColumns); err != nil {
+				return err
+			}
+			s, err := getQuery.open()
+			if err != nil {
+				return err
+			}
+			return getReceiptDetail(ctx, s.client, args[0], getType, s.config.Locale, s.output, getOpts)
+		},
 	}
 
-	config := costco.Config{
-		Email:              storedConfig.Email,
-		WarehouseNumber:    storedConfig.WarehouseNumber,
-		TokenRefreshBuffer: 5 * time.Minute,
+	var (
+		findQuery queryFlags
+		findOpts  tableOptions
+		date      string
+		amount    float64
+		latest    bool
+	)
+	find := &command{
+		name:  "find",
+		short: "Find a receipt by its date and total and show its line items",
+		long: `Looks up the receipt instead of needing its barcode. -date and -amount narrow the
+search; without -date the config date range is searched. The newest matching
+receipt is shown, so -latest alone shows your most recent receipt.`,
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&date, "date", "", "Receipt date (YYYY-MM-DD)")
+			fs.Float64Var(&amount, "amount", 0, "Receipt total, e.g. 269.13")
+			fs.BoolVar(&latest, "latest", false, "Show the most recent receipt")
+			findQuery.outputFlags(fs)
+			findOpts.register(fs, receiptItemColumns)
+		},
+		run: func(ctx context.Context, args []string) error {
+			if date == "" && amount == 0 && !latest {
+				return fmt.Errorf("use -date, -amount, or -latest to pick a receipt")
+			}
+			if err := findOpts.validate(receiptItemColumns); err != nil {
+				return err
+			}
+			findQuery.start, findQuery.end = date, date
+			s, err := findQuery.open()
+			if err != nil {
+				return err
+			}
+			receipt, err := s.client.FindReceipt(ctx, s.start, s.end, amount)
+			if err != nil {
+				return fmt.Errorf("finding receipt: %w", err)
+			}
+			return printReceiptDetail(receipt, s.config.Locale, s.output, findOpts)
+		},
 	}
 
-	client := costco.NewClient(config)
-	ctx := context.Background()
+	return &command{
+		name:        "receipts",
+		short:       "Warehouse and gas station receipts",
+		subcommands: []*command{list, get, find},
+	}
+}
 
-	switch *command {
-	case "orders":
-		getOrders(ctx, client, *startDate, *endDate, *pageNumber, *pageSize, *outputJSON)
-	case "receipts":
-		getReceipts(ctx, client, *startDate, *endDate, *outputJSON)
-	case "receipt-detail":
-		if *barcode == "" {
-			log.Fatal("Barcode is required for receipt-detail command")
-		}
-		getReceiptDetail(ctx, client, *barcode, *outputJSON)
-	default:
-		log.Fatalf("Unknown command: %s", *command)
+func compareCommand() *command {
+	var (
+		q       queryFlags
+		opts    tableOptions
+		vsStart string
+		vsEnd   string
+	)
+	return &command{
+		name:  "compare",
+		short: "Compare spending, trips, and prices between two periods",
+		long:  "The baseline defaults to the same range one year earlier.",
+		flags: func(fs *flag.FlagSet) {
+			q.dateFlags(fs)
+			fs.StringVar(&vsStart, "vs-start", "", "Baseline start date (default: -start one year earlier)")
+			fs.StringVar(&vsEnd, "vs-end", "", "Baseline end date (default: -end one year earlier)")
+			q.outputFlags(fs)
+			opts.wideFlag(fs)
+		},
+		run: func(ctx context.Context, args []string) error {
+			s, err := q.open()
+			if err != nil {
+				return err
+			}
+			baseline := costco.DateRange{StartDate: vsStart, EndDate: vsEnd}
+			if baseline.StartDate == "" {
+				baseline.StartDate = yearEarlier(s.start)
+			}
+			if baseline.EndDate == "" {
+				baseline.EndDate = yearEarlier(s.end)
+			}
+			return comparePeriods(ctx, s.client, baseline, costco.DateRange{StartDate: s.start, EndDate: s.end}, s.output, opts)
+		},
 	}
 }
 
-func getOrders(ctx context.Context, client *costco.Client, startDate, endDate string, pageNumber, pageSize int, outputJSON bool) {
+var orderColumns = []tableColumn{
+	{name: "order"},
+	{name: "date"},
+	{name: "status", status: true},
+	{name: "total", numeric: true},
+	{name: "warehouse"},
+	{name: "items", numeric: true},
+	{name: "first_item", maxWidth: 40},
+	{name: "payment", wide: true},
+}
+
+func getOrders(ctx context.Context, client *costco.Client, startDate, endDate string, pageNumber, pageSize int, output outputFormat, opts tableOptions) error {
 	orders, err := client.GetOnlineOrders(ctx, startDate, endDate, pageNumber, pageSize)
 	if err != nil {
-		log.Fatalf("Error getting orders: %v", err)
+		return fmt.Errorf("getting orders: %w", err)
 	}
 
-	if outputJSON {
-		encoder := json.NewEncoder(os.Stdout)
-		encoder.SetIndent("", "  ")
-		if err := encoder.Encode(orders); err != nil {
-			log.Fatalf("Error encoding JSON: %v", err)
-		}
-		return
+	if output.structured() {
+		return output.writeRecords(orders, orders.BCOrders)
 	}
 
 	fmt.Printf("Online Orders (%s to %s)\n", startDate, endDate)
-	fmt.Printf("Page %d of %d total records\n", pageNumber, orders.TotalNumberOfRecords)
-	fmt.Println("=" + string(make([]byte, 80)))
+	fmt.Printf("Page %d of %d total records\n\n", pageNumber, orders.TotalNumberOfRecords)
+	return printOrders(orders.BCOrders, opts)
+}
 
-	for _, order := range orders.BCOrders {
-		fmt.Printf("\nOrder #%s\n", order.OrderNumber)
-		fmt.Printf("  Date: %s\n", order.OrderPlacedDate)
-		fmt.Printf("  Status: %s\n", order.Status)
-		fmt.Printf("  Total: $%.2f\n", order.OrderTotal)
-		fmt.Printf("  Warehouse: %s\n", order.WarehouseNumber)
+// getAllOrders lists every online order in the date range, fetching all pages.
+func getAllOrders(ctx context.Context, client *costco.Client, startDate, endDate string, output outputFormat, opts tableOptions) error {
+	orders := []costco.OnlineOrder{}
+	for order, err := range client.OnlineOrders(ctx, startDate, endDate) {
+		if err != nil {
+			return fmt.Errorf("getting orders: %w", err)
+		}
+		if output == outputJSONL {
+			// Stream each order as its page arrives
+			if err := writeJSONLines(os.Stdout, order); err != nil {
+				return err
+			}
+			continue
+		}
+		orders = append(orders, order)
+	}
+	if output == outputJSONL {
+		return nil
+	}
+
+	if output.structured() {
+		return output.write(orders)
+	}
+
+	fmt.Printf("Online Orders (%s to %s)\n", startDate, endDate)
+	fmt.Printf("%d orders\n\n", len(orders))
+	return printOrders(orders, opts)
+}
 
+func printOrders(orders []costco.OnlineOrder, opts tableOptions) error {
+	t := newTable(orderColumns)
+	for _, order := range orders {
+		firstItem := ""
 		if len(order.OrderLineItems) > 0 {
-			fmt.Printf("  Items: %d\n", len(order.OrderLineItems))
-			for i, item := range order.OrderLineItems {
-				if i < 3 {
-					fmt.Printf("    - %s (Status: %s)\n", item.ItemDescription, item.Status)
-				}
-			}
-			if len(order.OrderLineItems) > 3 {
-				fmt.Printf("    ... and %d more items\n", len(order.OrderLineItems)-3)
-			}
+			firstItem = order.OrderLineItems[0].ItemDescription
+		}
+		var payments []string
+		for _, payment := range order.Payments {
+			payments = append(payments, fmt.Sprintf("%s (%s) %s", payment.CardType, payment.LastFour, money(payment.Amount, order.Currency)))
 		}
+		t.add(order.OrderNumber, order.OrderPlacedDate[:min(10, len(order.OrderPlacedDate))], order.Status,
+			tableMoney{order.OrderTotal, order.Currency}, order.WarehouseNumber, len(order.OrderLineItems),
+			firstItem, strings.Join(payments, ", "))
 	}
+	return t.render(os.Stdout, opts)
 }
 
-func getReceipts(ctx context.Context, client *costco.Client, startDate, endDate string, outputJSON bool) {
-	// Convert date format for receipts API (M/DD/YYYY)
-	startTime, _ := time.Parse("2006-01-02", startDate)
-	endTime, _ := time.Parse("2006-01-02", endDate)
-	startDateFormatted := fmt.Sprintf("%d/%02d/%d", startTime.Month(), startTime.Day(), startTime.Year())
-	endDateFormatted := fmt.Sprintf("%d/%02d/%d", endTime.Month(), endTime.Day(), endTime.Year())
+var photoOrderColumns = []tableColumn{
+	{name: "order"},
+	{name: "date"},
+	{name: "status", status: true},
+	{name: "total", numeric: true},
+	{name: "prints", numeric: true},
+	{name: "items", maxWidth: 50, wide: true},
+}
 
-	receipts, err := client.GetReceipts(ctx, startDateFormatted, endDateFormatted, "all", "all")
+func getPhotoOrders(ctx context.Context, client *costco.Client, startDate, endDate string, output outputFormat, opts tableOptions) error {
+	orders, err := client.GetPhotoOrders(ctx, startDate, endDate)
 	if err != nil {
-		log.Fatalf("Error getting receipts: %v", err)
+		return fmt.Errorf("getting photo orders: %w", err)
+	}
+
+	if output.structured() {
+		return output.write(orders)
 	}
 
-	if outputJSON {
-		encoder := json.NewEncoder(os.Stdout)
-		encoder.SetIndent("", "  ")
-		if err := encoder.Encode(receipts); err != nil {
-			log.Fatalf("Error encoding JSON: %v", err)
+	fmt.Printf("Photo Center Orders (%s to %s)\n\n", startDate, endDate)
+
+	t := newTable(photoOrderColumns)
+	for _, order := range orders {
+		prints := 0
+		var items []string
+		for _, item := range order.Items {
+			prints += item.Quantity
+			items = append(items, fmt.Sprintf("%d x %s", item.Quantity, item.Description))
 		}
-		return
+		t.add(order.OrderNumber, order.OrderDate, order.Status, tableMoney{order.Total, order.Currency},
+			prints, strings.Join(items, ", "))
+	}
+	return t.render(os.Stdout, opts)
+}
+
+var receiptColumns = []tableColumn{
+	{name: "date"},
+	{name: "type"},
+	{name: "warehouse", maxWidth: 30},
+	{name: "barcode"},
+	{name: "total", numeric: true},
+	{name: "items", numeric: true},
+}
+
+func getReceipts(ctx context.Context, client *costco.Client, startDate, endDate, documentType, documentSubType string, output outputFormat, opts tableOptions) error {
+	if documentType == "" {
+		documentType = costco.DefaultDocumentType
+	}
+	if documentSubType == "" {
+		documentSubType = costco.DefaultDocumentSubType
+	}
+
+	receipts, err := client.GetReceipts(ctx, startDate, endDate, documentType, documentSubType)
+	if err != nil {
+		return fmt.Errorf("getting receipts: %w", err)
+	}
+
+	if output.structured() {
+		return output.writeRecords(receipts, receipts.Receipts)
 	}
 
 	fmt.Printf("Receipts (%s to %s)\n", startDate, endDate)
-	fmt.Printf("In-Warehouse: %d, Gas Station: %d, Car Wash: %d\n",
+	fmt.Printf("In-Warehouse: %d, Gas Station: %d, Car Wash: %d\n\n",
 		receipts.InWarehouse, receipts.GasStation, receipts.CarWash)
-	fmt.Println("=" + string(make([]byte, 80)))
 
+	t := newTable(receiptColumns)
 	for _, receipt := range receipts.Receipts {
-		fmt.Printf("\n%s - %s\n", receipt.TransactionDateTime, receipt.ReceiptType)
-		fmt.Printf("  Warehouse: %s\n", receipt.WarehouseName)
-		fmt.Printf("  Barcode: %s\n", receipt.TransactionBarcode)
-		fmt.Printf("  Total: $%.2f\n", receipt.Total)
-		fmt.Printf("  Items: %d\n", receipt.TotalItemCount)
+		date := strings.Replace(receipt.TransactionDateTime, "T", " ", 1)
+		t.add(date[:min(16, len(date))], receipt.ReceiptType, receipt.WarehouseName, receipt.TransactionBarcode,
+			tableMoney{receipt.Total, receipt.Currency}, receipt.TotalItemCount)
 	}
+	return t.render(os.Stdout, opts)
+}
+
+var receiptItemColumns = []tableColumn{
+	{name: "item"},
+	{name: "description", maxWidth: 40},
+	{name: "description2", wide: true},
+	{name: "department", wide: true},
+	{name: "quantity", numeric: true},
+	{name: "unit_price", numeric: true},
+	{name: "amount", numeric: true},
 }
 
-func getReceiptDetail(ctx context.Context, client *costco.Client, barcode string, outputJSON bool) {
-	receipt, err := client.GetReceiptDetail(ctx, barcode, "warehouse")
+func getReceiptDetail(ctx context.Context, client *costco.Client, barcode, documentType string, locale costco.Locale, output outputFormat, opts tableOptions) error {
+	receipt, err := client.GetReceiptDetail(ctx, barcode, documentType)
 	if err != nil {
-		log.Fatalf("Error getting receipt detail: %v", err)
+		return fmt.Errorf("getting receipt detail: %w", err)
 	}
+	return printReceiptDetail(receipt, locale, output, opts)
+}
 
-	if outputJSON {
-		encoder := json.NewEncoder(os.Stdout)
-		encoder.SetIndent("", "  ")
-		if err := encoder.Encode(receipt); err != nil {
-			log.Fatalf("Error encoding JSON: %v", err)
-		}
-		return
+func printReceiptDetail(receipt *costco.Receipt, locale costco.Locale, output outputFormat, opts tableOptions) error {
+	if output.structured() {
+		return output.write(receipt)
 	}
 
 	fmt.Printf("Receipt Detail\n")
-	fmt.Println("=" + string(make([]byte, 80)))
+	fmt.Println(strings.Repeat("=", 80))
 	fmt.Printf("Date: %s\n", receipt.TransactionDateTime)
 	fmt.Printf("Warehouse: %s (#%d)\n", receipt.WarehouseName, receipt.WarehouseNumber)
 	fmt.Printf("Address: %s, %s, %s %s\n",
@@ -194,26 +479,113 @@ func getReceiptDetail(ctx context.Context, client *costco.Client, barcode string
 	fmt.Printf("Member: %s\n", receipt.MembershipNumber)
 	fmt.Println()
 
-	fmt.Println("Items:")
+	t := newTable(receiptItemColumns)
 	for _, item := range receipt.ItemArray {
-		fmt.Printf("  %s - %s %s\n", item.ItemNumber, item.ItemDescription01, item.ItemDescription02)
-		if item.Unit > 1 {
-			fmt.Printf("    Qty: %d @ $%.2f = $%.2f\n", item.Unit, item.ItemUnitPriceAmount, item.Amount)
-		} else {
-			fmt.Printf("    $%.2f\n", item.Amount)
+		var amount interface{} = tableMoney{item.Amount, receipt.Currency}
+		if item.IsDiscount() {
+			amount = styled{amount, styleGreen}
 		}
+		t.add(item.ItemNumber, item.Description(locale), item.ItemDescription02, costco.DepartmentName(item.ItemDepartmentNumber),
+			item.Unit, tableMoney{item.ItemUnitPriceAmount, receipt.Currency}, amount)
+	}
+	if err := t.render(os.Stdout, opts); err != nil {
+		return err
 	}
 
 	fmt.Println()
-	fmt.Printf("Subtotal: $%.2f\n", receipt.SubTotal)
-	fmt.Printf("Tax: $%.2f\n", receipt.Taxes)
-	fmt.Printf("Total: $%.2f\n", receipt.Total)
+	fmt.Printf("Subtotal: %s\n", money(receipt.SubTotal, receipt.Currency))
+	fmt.Printf("Tax: %s\n", money(receipt.Taxes, receipt.Currency))
+	if receipt.Region() == costco.RegionCA {
+		for _, tax := range receipt.TaxBreakdown() {
+			fmt.Printf("  %s (%.3g%%): %s\n", tax.Legend, tax.Percent, money(tax.Amount, receipt.Currency))
+		}
+	}
+	fmt.Println(paint(styleBold, "Total: "+money(receipt.Total, receipt.Currency)))
 
 	if len(receipt.TenderArray) > 0 {
 		fmt.Println("\nPayment:")
 		for _, tender := range receipt.TenderArray {
-			fmt.Printf("  %s (%s): $%.2f\n",
-				tender.TenderDescription, tender.DisplayAccountNumber, tender.AmountTender)
+			fmt.Printf("  %s (%s): %s\n",
+				tender.TenderDescription, tender.DisplayAccountNumber, money(tender.AmountTender, receipt.Currency))
+		}
+	}
+	return nil
+}
+
+func comparePeriods(ctx context.Context, client *costco.Client, baseline, current costco.DateRange, output outputFormat, opts tableOptions) error {
+	cmp, err := client.ComparePeriods(ctx, baseline, current)
+	if err != nil {
+		return fmt.Errorf("comparing periods: %w", err)
+	}
+
+	if output.structured() {
+		return output.write(cmp)
+	}
+
+	fmt.Printf("%s to %s vs. %s to %s\n", current.StartDate, current.EndDate, baseline.StartDate, baseline.EndDate)
+	fmt.Println(strings.Repeat("=", 80))
+	fmt.Printf("Spend: %s -> %s (%s)\n", money(cmp.Total.A, ""), money(cmp.Total.B, ""),
+		paint(changeStyle(cmp.Total.Change), fmt.Sprintf("%+.1f%%", cmp.Total.Percent)))
+	fmt.Printf("Trips: %.0f -> %.0f\n", cmp.Trips.A, cmp.Trips.B)
+
+	fmt.Println("\nBy department:")
+	departments := newTable([]tableColumn{
+		{name: "department", maxWidth: 30},
+		{name: "before", numeric: true},
+		{name: "after", numeric: true},
+		{name: "change", numeric: true},
+	})
+	for _, dept := range cmp.Departments {
+		departments.add(dept.Name, tableMoney{dept.Spend.A, ""}, tableMoney{dept.Spend.B, ""},
+			styled{tableMoney{dept.Spend.Change, ""}, changeStyle(dept.Spend.Change)})
+	}
+	if err := departments.render(os.Stdout, opts); err != nil {
+		return err
+	}
+
+	fmt.Println("\nPrice changes:")
+	prices := newTable([]tableColumn{
+		{name: "item", maxWidth: 30},
+		{name: "before", numeric: true},
+		{name: "after", numeric: true},
+		{name: "change_%", numeric: true},
+	})
+	for _, item := range cmp.Items[:min(10, len(cmp.Items))] {
+		prices.add(item.ItemDescription, tableMoney{item.UnitPrice.A, ""}, tableMoney{item.UnitPrice.B, ""},
+			styled{item.UnitPrice.Percent, changeStyle(item.UnitPrice.Percent)})
+	}
+	return prices.render(os.Stdout, opts)
+}
+
+// showMembership prints membership details for the info command. It is skipped
+// quietly when there is no config or valid session yet.
+func showMembership(ctx context.Context) {
+	storedConfig, err := costco.LoadConfig()
+	if err != nil || storedConfig == nil {
+		return
+	}
+	tokens, _ := costco.LoadTokens()
+	if tokens == nil || time.Now().After(tokens.RefreshTokenExpiresAt) {
+		return
+	}
+
+	config := storedConfig.ClientConfig()
+	config.Logger = clientLogger()
+	membership, err := costco.NewClient(config).GetMembership(ctx)
+	if err != nil {
+		fmt.Printf("Membership: unavailable (%v)\n", err)
+		return
+	}
+
+	fmt.Println("Membership:")
+	fmt.Printf("  Number: %s\n", membership.MembershipNumber)
+	fmt.Printf("  Type: %s\n", membership.MembershipType)
+	fmt.Printf("  Member since: %s\n", membership.MemberSinceDate)
+	fmt.Printf("  Renews: %s\n", membership.RenewalDate)
+	if len(membership.Cardholders) > 0 {
+		fmt.Println("  Cardholders:")
+		for _, holder := range membership.Cardholders {
+			fmt.Printf("    %s %s (%s)\n", holder.FirstName, holder.LastName, holder.CardholderType)
 		}
 	}
 }
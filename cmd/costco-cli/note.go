@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+)
+
+func noteCommand() *command {
+	var (
+		item   string
+		remove bool
+	)
+	return &command{
+		name:        "note",
+		args:        []string{"barcode"},
+		optionalArg: "text",
+		repeatArg:   true,
+		short:       "Write a note on a receipt or one of its items",
+		long: `Notes are kept in ~/.costco/notes.json and never sent to Costco. Exports carry
+them in the note column (and receipt_note at -level item). With no text, prints
+the receipt's notes.`,
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&item, "item", "", "Item number to note instead of the whole receipt")
+			fs.BoolVar(&remove, "remove", false, "Remove the note")
+		},
+		run: func(ctx context.Context, args []string) error {
+			return noteReceipt(os.Stdout, args[0], item, strings.Join(args[1:], " "), remove)
+		},
+		complete: completeBarcodes,
+	}
+}
+
+func noteReceipt(out io.Writer, barcode, item, text string, remove bool) error {
+	if remove || text != "" {
+		if remove {
+			text = ""
+		}
+		if err := costco.SetNote(barcode, item, text); err != nil {
+			return err
+		}
+		if remove {
+			fmt.Fprintln(out, "✓ Note removed")
+		} else {
+			fmt.Fprintln(out, "✓ Note saved")
+		}
+		return nil
+	}
+
+	notes, err := costco.LoadNotes()
+	if err != nil {
+		return err
+	}
+	return printNotes(out, barcode, notes[barcode])
+}
+
+func printNotes(out io.Writer, barcode string, notes *costco.ReceiptNotes) error {
+	if notes == nil {
+		fmt.Fprintf(out, "%s has no notes\n", barcode)
+		return nil
+	}
+	if notes.Note != "" {
+		fmt.Fprintf(out, "%s: %s\n", barcode, notes.Note)
+	}
+	items := make([]string, 0, len(notes.Items))
+	for number := range notes.Items {
+		items = append(items, number)
+	}
+	sort.Strings(items)
+	for _, number := range items {
+		fmt.Fprintf(out, "  item %s: %s\n", number, notes.Items[number])
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNoteReceipt(t *testing.T) {
+	withTempConfig(t)
+
+	var out bytes.Buffer
+	require.NoError(t, noteReceipt(&out, "R1", "", "", false))
+	assert.Equal(t, "R1 has no notes\n", out.String())
+
+	out.Reset()
+	require.NoError(t, noteReceipt(&out, "R1", "", "split with Dave", false))
+	require.NoError(t, noteReceipt(&out, "R1", "200", "birthday", false))
+	require.NoError(t, noteReceipt(&out, "R1", "100", "for the office", false))
+	assert.Equal(t, "✓ Note saved\n✓ Note saved\n✓ Note saved\n", out.String())
+
+	out.Reset()
+	require.NoError(t, noteReceipt(&out, "R1", "", "", false))
+	assert.Equal(t, "R1: split with Dave\n  item 100: for the office\n  item 200: birthday\n", out.String())
+
+	out.Reset()
+	require.NoError(t, noteReceipt(&out, "R1", "", "", true))
+	require.NoError(t, noteReceipt(&out, "R1", "", "", false))
+	assert.Equal(t, "✓ Note removed\n  item 100: for the office\n  item 200: birthday\n", out.String())
+}
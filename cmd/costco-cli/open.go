@@ -0,0 +1,95 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+)
+
+// minBarcodeLength tells receipt barcodes (21-23 digits) from online order numbers.
+const minBarcodeLength = 20
+
+func openCommand() *command {
+	var printOnly bool
+	return &command{
+		name:  "open",
+		args:  []string{"order-or-barcode"},
+		short: "Open an online order or receipt on costco.com",
+		long: `Opens the order's page in the default browser ($BROWSER if set), for what the
+API can't do, such as returns. A number of 20 or more digits is a receipt barcode;
+Costco has no page for a single receipt, so those open Orders & Purchases, where
+the receipt is listed by date. Canadian profiles open costco.ca.`,
+		flags: func(fs *flag.FlagSet) {
+			fs.BoolVar(&printOnly, "print", false, "Print the URL instead of opening it")
+		},
+		run: func(ctx context.Context, args []string) error {
+			link := webURL(args[0], profileRegion())
+			if printOnly {
+				fmt.Println(link)
+				return nil
+			}
+			return openBrowser(os.Stderr, link)
+		},
+	}
+}
+
+// webURL returns the costco.com page for an order number or receipt barcode.
+func webURL(id string, region costco.Region) string {
+	if isBarcode(id) {
+		return costco.ReceiptsURL(region)
+	}
+	return costco.OrderURL(id, region)
+}
+
+func isBarcode(id string) bool {
+	if len(id) < minBarcodeLength {
+		return false
+	}
+	return strings.Trim(id, "0123456789") == ""
+}
+
+// profileRegion is the country of the active profile, from its locale or currency.
+func profileRegion() costco.Region {
+	storedConfig, err := costco.LoadConfig()
+	if err != nil || storedConfig == nil {
+		return costco.RegionUS
+	}
+	if storedConfig.Locale.Region() == costco.RegionCA || storedConfig.Currency == costco.CurrencyCAD {
+		return costco.RegionCA
+	}
+	return costco.RegionUS
+}
+
+// openBrowser starts the browser on url without waiting for it, printing the URL
+// as well in case nothing opens.
+func openBrowser(out io.Writer, url string) error {
+	fmt.Fprintf(out, "Opening %s\n", url)
+	name, args := browserCommand(runtime.GOOS, os.Getenv("BROWSER"))
+	if err := exec.Command(name, append(args, url)...).Start(); err != nil {
+		return fmt.Errorf("opening browser: %w", err)
+	}
+	return nil
+}
+
+// browserCommand returns the command that opens a URL: $BROWSER when set, otherwise
+// the platform's opener.
+func browserCommand(goos, browser string) (string, []string) {
+	if browser != "" {
+		return browser, nil
+	}
+	switch goos {
+	case "darwin":
+		return "open", nil
+	case "windows":
+		return "rundll32", []string{"url.dll,FileProtocolHandler"}
+	default:
+		return "xdg-open", nil
+	}
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebURL(t *testing.T) {
+	assert.Equal(t, costco.OrderURL("1234567890", costco.RegionUS), webURL("1234567890", costco.RegionUS))
+	assert.Equal(t, costco.ReceiptsURL(costco.RegionCA), webURL("21134300501862509051323", costco.RegionCA))
+	assert.False(t, isBarcode("ORD-0012345678901234567890"))
+}
+
+func TestBrowserCommand(t *testing.T) {
+	name, args := browserCommand("linux", "")
+	assert.Equal(t, "xdg-open", name)
+	assert.Empty(t, args)
+
+	name, _ = browserCommand("darwin", "")
+	assert.Equal(t, "open", name)
+
+	name, args = browserCommand("windows", "")
+	assert.Equal(t, "rundll32", name)
+	assert.Equal(t, []string{"url.dll,FileProtocolHandler"}, args)
+
+	name, _ = browserCommand("linux", "firefox")
+	assert.Equal(t, "firefox", name)
+}
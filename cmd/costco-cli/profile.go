@@ -0,0 +1,126 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+)
+
+// Named profiles for several Costco accounts. The global -profile flag (or the
+// COSTCO_PROFILE environment variable) picks the profile every command uses.
+
+// profileEnv names the environment variable -profile defaults to.
+const profileEnv = "COSTCO_PROFILE"
+
+// cliName is how to invoke the CLI for the active profile, for hints in messages.
+func cliName() string {
+	if profile := costco.ActiveProfile(); profile != costco.DefaultProfile {
+		return "costco-cli -profile " + profile
+	}
+	return "costco-cli"
+}
+
+// useProfileFromEnv selects the profile named by COSTCO_PROFILE, if set.
+func useProfileFromEnv() error {
+	if name := os.Getenv(profileEnv); name != "" {
+		if err := costco.UseProfile(name); err != nil {
+			return fmt.Errorf("%s: %w", profileEnv, err)
+		}
+	}
+	return nil
+}
+
+func profileCommand() *command {
+	list := &command{
+		name:  "list",
+		short: "List saved profiles",
+		run: func(ctx context.Context, args []string) error {
+			return listProfiles()
+		},
+	}
+	add := &command{
+		name:  "add",
+		args:  []string{"name"},
+		short: "Create a profile and run setup for it",
+		run: func(ctx context.Context, args []string) error {
+			return addProfile(args[0])
+		},
+	}
+	remove := &command{
+		name:     "remove",
+		args:     []string{"name"},
+		short:    "Delete a profile with its config, tokens, and local store",
+		complete: completeProfiles,
+		run: func(ctx context.Context, args []string) error {
+			if err := costco.RemoveProfile(args[0]); err != nil {
+				return err
+			}
+			fmt.Printf("Removed profile %q\n", args[0])
+			return nil
+		},
+	}
+	return &command{
+		name:        "profile",
+		short:       "Manage profiles for several Costco accounts",
+		subcommands: []*command{list, add, remove},
+	}
+}
+
+func listProfiles() error {
+	profiles, err := costco.ListProfiles()
+	if err != nil {
+		return fmt.Errorf("listing profiles: %w", err)
+	}
+	if len(profiles) == 0 {
+		fmt.Println("No profiles yet. Run 'costco-cli setup' or 'costco-cli profile add <name>'")
+		return nil
+	}
+
+	active := costco.ActiveProfile()
+	defer costco.UseProfile(active)
+
+	t := newTable([]tableColumn{{name: "active"}, {name: "profile"}, {name: "email"}, {name: "warehouse"}})
+	for _, name := range profiles {
+		if err := costco.UseProfile(name); err != nil {
+			return err
+		}
+		email, warehouse := "", ""
+		if config, err := costco.LoadConfig(); err == nil && config != nil {
+			email, warehouse = config.Email, config.WarehouseNumber
+		}
+		marker := ""
+		if name == active {
+			marker = "*"
+		}
+		t.add(marker, name, email, warehouse)
+	}
+	return t.render(os.Stdout, tableOptions{})
+}
+
+func addProfile(name string) error {
+	if name == costco.DefaultProfile {
+		return fmt.Errorf("%q is the built-in profile; run 'costco-cli setup' to change it", name)
+	}
+	if err := costco.UseProfile(name); err != nil {
+		return err
+	}
+	existing, err := costco.LoadConfig()
+	if err != nil {
+		return fmt.Errorf("loading config: %w", err)
+	}
+	if existing != nil {
+		return fmt.Errorf("profile %q already exists; run '%s setup' to change it", name, cliName())
+	}
+	return setupCredentials()
+}
+
+// completeProfiles offers the saved profile names.
+func completeProfiles(prefix string) []string {
+	profiles, err := costco.ListProfiles()
+	if err != nil {
+		return nil
+	}
+	return matching(profiles, prefix)
+}
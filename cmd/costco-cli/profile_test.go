@@ -0,0 +1,58 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileFlag(t *testing.T) {
+	withTempConfig(t)
+	t.Cleanup(func() { costco.UseProfile(costco.DefaultProfile) })
+	var out bytes.Buffer
+	ctx := context.Background()
+
+	err := newRootCommand().execute(ctx, &out, "", []string{"-profile", "spouse", "profile", "list"})
+	require.NoError(t, err)
+	assert.Equal(t, "spouse", costco.ActiveProfile())
+	assert.Equal(t, "costco-cli -profile spouse", cliName())
+
+	_, err = (&queryFlags{}).open()
+	assert.EqualError(t, err, "no configuration found. Run 'costco-cli -profile spouse setup' first")
+
+	err = newRootCommand().execute(ctx, &out, "", []string{"-profile", "../other", "info"})
+	assert.ErrorIs(t, err, errUsage)
+	assert.Contains(t, out.String(), "invalid profile name")
+
+	out.Reset()
+	err = newRootCommand().execute(ctx, &out, "", []string{"-h"})
+	assert.Contains(t, out.String(), "costco-cli [flags] <command>")
+	assert.Contains(t, out.String(), "-profile")
+}
+
+func TestUseProfileFromEnv(t *testing.T) {
+	t.Cleanup(func() { costco.UseProfile(costco.DefaultProfile) })
+
+	t.Setenv(profileEnv, "work")
+	require.NoError(t, useProfileFromEnv())
+	assert.Equal(t, "work", costco.ActiveProfile())
+
+	t.Setenv(profileEnv, "a/b")
+	assert.ErrorContains(t, useProfileFromEnv(), profileEnv)
+}
+
+func TestAddProfile_Existing(t *testing.T) {
+	withTempConfig(t)
+	t.Cleanup(func() { costco.UseProfile(costco.DefaultProfile) })
+
+	require.NoError(t, costco.UseProfile("spouse"))
+	require.NoError(t, costco.SaveConfig(&costco.StoredConfig{Email: "spouse@example.com", WarehouseNumber: "1"}))
+
+	assert.EqualError(t, addProfile("spouse"), `profile "spouse" already exists; run 'costco-cli -profile spouse setup' to change it`)
+	assert.ErrorContains(t, addProfile(costco.DefaultProfile), "built-in profile")
+	assert.Equal(t, []string{"spouse"}, completeProfiles("sp"))
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+)
+
+// progressWidth is the number of cells in the progress bar.
+const progressWidth = 30
+
+// progressBar draws receipt fetch progress on one terminal line, as in
+//
+//	Fetching receipts  37/120 [=========>                    ] 4.2/s  ETA 20s
+//
+// and clears the line when the batch is done.
+type progressBar struct {
+	out   io.Writer
+	now   func() time.Time
+	start time.Time
+}
+
+// newProgress returns the Config.Progress callback for a query command, or nil
+// when output is JSON, stderr isn't a terminal that can redraw a line, or -verbose
+// logging would write over the bar.
+func newProgress(json bool) func(done, total int) {
+	if json || cliLog.level.Level() < slog.LevelWarn || os.Getenv("TERM") == "dumb" || !isTerminal(os.Stderr) {
+		return nil
+	}
+	return (&progressBar{out: os.Stderr, now: time.Now}).update
+}
+
+func (p *progressBar) update(done, total int) {
+	if done == 0 {
+		p.start = p.now()
+	}
+	if done >= total {
+		fmt.Fprint(p.out, "\r\033[K")
+		return
+	}
+
+	filled := progressWidth * done / total
+	bar := strings.Repeat("=", filled) + ">" + strings.Repeat(" ", progressWidth-filled-1)
+	line := fmt.Sprintf("Fetching receipts %*d/%d [%s]", len(fmt.Sprint(total)), done, total, bar)
+	if elapsed := p.now().Sub(p.start); done > 0 && elapsed > 0 {
+		rate := float64(done) / elapsed.Seconds()
+		eta := time.Duration(float64(total-done) / rate * float64(time.Second))
+		line += fmt.Sprintf(" %.1f/s  ETA %s", rate, eta.Round(time.Second))
+	}
+	fmt.Fprintf(p.out, "\r\033[K%s", line)
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProgressBar(t *testing.T) {
+	var out bytes.Buffer
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	p := &progressBar{out: &out, now: func() time.Time { return now }}
+
+	p.update(0, 120)
+	assert.Equal(t, "\r\033[KFetching receipts   0/120 [>                             ]", out.String())
+
+	out.Reset()
+	now = now.Add(10 * time.Second)
+	p.update(40, 120)
+	assert.Equal(t, "\r\033[KFetching receipts  40/120 [==========>                   ] 4.0/s  ETA 20s", out.String())
+
+	out.Reset()
+	p.update(120, 120)
+	assert.Equal(t, "\r\033[K", out.String(), "the line is cleared when done")
+}
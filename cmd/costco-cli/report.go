@@ -0,0 +1,171 @@
+package main
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"flag"
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"time"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+)
+
+//go:embed report.html.tmpl
+var reportTemplateText string
+
+var reportTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"money": func(amount float64) string { return money(amount, "") },
+}).Parse(reportTemplateText))
+
+// Size of the monthly spend chart's SVG viewBox
+const (
+	reportChartWidth  = 720.0
+	reportChartHeight = 220.0
+)
+
+func reportCommand() *command {
+	var (
+		q    queryFlags
+		year string
+		span string
+		out  string
+	)
+	return &command{
+		name:  "report",
+		short: "Write a self-contained HTML report: monthly spend, departments, top items, and savings",
+		long: `The report is a single HTML file with the charts drawn inline, so it opens offline
+and can be mailed or archived as is. It covers this year unless -year, -range, or
+the date flags pick another period. Receipts are fetched once; -local reads them
+from the store kept by sync.`,
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&year, "year", "", "Calendar year (YYYY) (default: this year)")
+			fs.StringVar(&span, "range", "", "Date range (YYYY-MM-DD..YYYY-MM-DD)")
+			fs.StringVar(&out, "out", "report.html", "File to write")
+			q.dateFlags(fs)
+			q.localFlag(fs)
+			q.tagFlag(fs)
+		},
+		run: func(ctx context.Context, args []string) error {
+			start, end, err := summaryRange(year, span)
+			if err != nil {
+				return err
+			}
+			datesSet := q.start != "" || q.end != "" || q.periodSet()
+			if start != "" && datesSet {
+				return errors.New("use -year or -range without the other date flags")
+			}
+			if start == "" && !datesSet {
+				year = time.Now().Format("2006")
+				start, end, _ = summaryRange(year, "")
+			}
+			if start != "" {
+				q.start, q.end = start, end
+			}
+
+			s, err := q.open()
+			if err != nil {
+				return err
+			}
+			overview, err := s.client.GetOverview(ctx, s.start, s.end)
+			if err != nil {
+				return fmt.Errorf("getting report data: %w", err)
+			}
+
+			title := fmt.Sprintf("Costco spending %s to %s", s.start, s.end)
+			if year != "" {
+				title = "Costco spending " + year
+			}
+			f, err := os.Create(out)
+			if err != nil {
+				return err
+			}
+			if err := writeReport(f, title, overview, time.Now()); err != nil {
+				f.Close()
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+			fmt.Fprintf(os.Stderr, "✓ Wrote %s\n", out)
+			return nil
+		},
+	}
+}
+
+// reportPage is the data the report template renders.
+type reportPage struct {
+	Title        string
+	Generated    string
+	Summary      *costco.Summary
+	Months       []reportBar
+	Departments  []reportBar
+	SavingsItems []costco.ItemSavings
+	ChartWidth   float64
+	ChartHeight  float64
+}
+
+// reportBar is one bar of a report chart. Percent is the value's share of the
+// largest bar; X, Y, Width, and Height place month bars in the SVG chart.
+type reportBar struct {
+	Label   string
+	Value   float64
+	Detail  string
+	Percent float64
+	X, Y    float64
+	Width   float64
+	Height  float64
+}
+
+func writeReport(w io.Writer, title string, overview *costco.Overview, now time.Time) error {
+	page := reportPage{
+		Title:       title,
+		Generated:   now.Format("2006-01-02 15:04"),
+		Summary:     overview.Summary,
+		ChartWidth:  reportChartWidth,
+		ChartHeight: reportChartHeight,
+	}
+
+	points := overview.Months.Points
+	largest := 0.0
+	for _, point := range points {
+		largest = max(largest, point.Spend)
+	}
+	slot := reportChartWidth / float64(max(len(points), 1))
+	plot := reportChartHeight - 20 // Leave room for the month labels
+	for i, point := range points {
+		bar := reportBar{
+			Label:  point.Start.Format("Jan"),
+			Value:  point.Spend,
+			Detail: fmt.Sprintf("%s: %s over %d trips, saved %s", point.Label, money(point.Spend, ""), point.Trips, money(point.Savings, "")),
+			X:      float64(i)*slot + slot*0.15,
+			Width:  slot * 0.7,
+		}
+		if largest > 0 && point.Spend > 0 {
+			bar.Percent = point.Spend / largest * 100
+			bar.Height = plot * point.Spend / largest
+		}
+		bar.Y = plot - bar.Height
+		page.Months = append(page.Months, bar)
+	}
+
+	departments := overview.Summary.Departments
+	largest = 0
+	for _, dept := range departments {
+		largest = max(largest, dept.Total)
+	}
+	for _, dept := range departments {
+		bar := reportBar{Label: dept.Department, Value: dept.Total, Detail: fmt.Sprintf("%d items", dept.ItemCount)}
+		if largest > 0 && dept.Total > 0 {
+			bar.Percent = dept.Total / largest * 100
+		}
+		page.Departments = append(page.Departments, bar)
+	}
+
+	page.SavingsItems = overview.Savings.ByItem[:min(10, len(overview.Savings.ByItem))]
+
+	return reportTemplate.Execute(w, page)
+}
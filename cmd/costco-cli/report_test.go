@@ -0,0 +1,51 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteReport(t *testing.T) {
+	month := func(m time.Month) time.Time { return time.Date(2025, m, 1, 0, 0, 0, 0, time.UTC) }
+	overview := &costco.Overview{
+		Summary: &costco.Summary{
+			StartDate: "2025-01-01",
+			EndDate:   "2025-03-31",
+			SpendingPeriod: costco.SpendingPeriod{
+				Total: 600, TripCount: 4, AverageBasket: 150, Tax: 12.5,
+				TopItems: []costco.FrequentItem{{ItemDescription: "Eggs <5 dozen>", TotalQuantity: 3, TotalSpent: 27}},
+			},
+			Savings: costco.Savings{InstantSavings: 20, Total: 24, CouponSavings: 4},
+			Departments: []costco.SpendingByDepartment{
+				{Department: "Produce", Total: 400, ItemCount: 12},
+				{Department: "Deli", Total: 100, ItemCount: 3},
+			},
+		},
+		Months: &costco.SpendSeries{Interval: costco.ReportPeriodMonth, Points: []costco.SeriesPoint{
+			{Start: month(1), Label: "2025-01", Spend: 400, Trips: 3},
+			{Start: month(2), Label: "2025-02"},
+			{Start: month(3), Label: "2025-03", Spend: 200, Trips: 1},
+		}},
+		Savings: &costco.SavingsSummary{ByItem: []costco.ItemSavings{{ItemDescription: "Paper Towels", Savings: 4, DiscountCount: 1}}},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, writeReport(&buf, "Costco spending 2025", overview, time.Date(2025, 4, 1, 9, 30, 0, 0, time.UTC)))
+	html := buf.String()
+
+	assert.Contains(t, html, "<title>Costco spending 2025</title>")
+	assert.Contains(t, html, "generated 2025-04-01 09:30")
+	assert.Contains(t, html, `<div class="value">$600.00</div><div class="label">Spent</div>`)
+	assert.Contains(t, html, `<rect x="36" y="0" width="168" height="200"><title>2025-01: $400.00 over 3 trips, saved $0.00</title></rect>`)
+	assert.Contains(t, html, `<rect x="276" y="200" width="168" height="0">`, "empty month")
+	assert.Contains(t, html, `<div style="width: 25.0%">`)
+	assert.Contains(t, html, "Eggs &lt;5 dozen&gt;")
+	assert.Contains(t, html, "Paper Towels")
+	assert.NotContains(t, html, "ZgotmplZ")
+	assert.NotContains(t, html, "http", "the report needs nothing from the network")
+}
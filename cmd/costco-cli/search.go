@@ -0,0 +1,84 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+)
+
+func searchCommand() *command {
+	var (
+		q    queryFlags
+		opts tableOptions
+	)
+	return &command{
+		name:  "search",
+		args:  []string{"query"},
+		short: "Find every purchase of items matching a description",
+		long: `Every word of the query must appear in the item's receipt description, its
+expanded name ("paper towel" finds "KS PPR TWL"), or its second line. An item
+number finds that item. Quote multi-word queries.`,
+		flags: func(fs *flag.FlagSet) {
+			q.dateFlags(fs)
+			q.localFlag(fs)
+			q.outputFlags(fs)
+			opts.register(fs, searchColumns)
+		},
+		run: func(ctx context.Context, args []string) error {
+			if err := opts.validate(searchColumns); err != nil {
+				return err
+			}
+			s, err := q.open()
+			if err != nil {
+				return err
+			}
+			matches, err := s.client.SearchPurchases(ctx, args[0], s.start, s.end)
+			if err != nil {
+				return fmt.Errorf("searching purchases: %w", err)
+			}
+			if s.output.structured() {
+				return s.output.write(matches)
+			}
+			return printSearchResults(args[0], s.start, s.end, matches, opts)
+		},
+	}
+}
+
+var searchColumns = []tableColumn{
+	{name: "date"},
+	{name: "item"},
+	{name: "description", maxWidth: 36},
+	{name: "quantity", numeric: true},
+	{name: "paid", numeric: true},
+	{name: "warehouse", maxWidth: 30},
+	{name: "barcode", wide: true},
+}
+
+func printSearchResults(query, startDate, endDate string, matches []costco.PurchaseMatch, opts tableOptions) error {
+	fmt.Printf("%d purchases matching %q (%s to %s)\n", len(matches), query, startDate, endDate)
+	if len(matches) == 0 {
+		return nil
+	}
+	fmt.Println()
+
+	var units int
+	var paid float64
+	t := newTable(searchColumns)
+	for _, m := range matches {
+		var spent interface{} = tableMoney{m.Price + m.Discount, ""}
+		if m.Discount < 0 {
+			spent = styled{spent, styleGreen} // Bought on sale
+		}
+		t.add(m.Date, m.ItemNumber, m.Description, m.Quantity, spent, m.Warehouse, m.Barcode)
+		units += m.Quantity
+		paid += m.Price + m.Discount
+	}
+	if err := t.render(os.Stdout, opts); err != nil {
+		return err
+	}
+	fmt.Printf("\nTotal: %d units, %s\n", units, money(paid, ""))
+	return nil
+}
@@ -0,0 +1,322 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+)
+
+func serveCommand() *command {
+	var (
+		q     queryFlags
+		addr  string
+		token string
+	)
+	return &command{
+		name:  "serve",
+		short: "Serve orders, receipts, and analytics as a local JSON API",
+		long: `Runs an HTTP server that answers with the same data as the query commands, so
+dashboards and shortcuts can read it without the Go library. Tokens are loaded
+and refreshed by the server, so keep it on localhost, or set -token when
+listening on other interfaces. Clients then send "Authorization: Bearer TOKEN".
+Requests naming any host other than localhost, a loopback or listen address, or
+(when listening on every interface) an IP address are refused, so web pages
+can't reach the API through DNS rebinding.
+
+Endpoints (all GET, JSON):
+  /health
+  /api/orders               Online orders
+  /api/receipts             Receipt list (?type=all|warehouse|fuel)
+  /api/receipts/{barcode}   One receipt's details (?type=warehouse|fuel|carwash)
+  /api/transactions         Receipts with their items, tags, and notes
+  /api/summary              Spending summary
+  /api/spending             Spending by department
+  /api/frequent             Most bought items (?limit=20)
+  /api/items/{number}       Purchase history of an item
+  /api/budget               Budget status (default: this month)
+  /api/membership           Membership and cardholders
+
+Dated endpoints take start and end (YYYY-MM-DD, or a span like 6m for start),
+or last, month, quarter, or ytd=true, as the query commands' flags do.`,
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&addr, "addr", "localhost:8080", "Address to listen on; :8080 listens on every interface")
+			fs.StringVar(&token, "token", "", "Require this bearer token on every request")
+			q.localFlag(fs)
+		},
+		run: func(ctx context.Context, args []string) error {
+			// Requests run concurrently and nobody watches the server's stderr
+			q.noProgress = true
+			s, err := q.open()
+			if err != nil {
+				return err
+			}
+			storedConfig, err := costco.LoadConfig()
+			if err != nil {
+				return fmt.Errorf("loading config: %w", err)
+			}
+			if !isLoopback(addr) && token == "" {
+				fmt.Fprintf(os.Stderr, "Warning: %s is reachable from other machines, and the API needs no credentials; set -token\n", addr)
+			}
+
+			api := &apiServer{
+				client:    s.client,
+				addr:      addr,
+				token:     token,
+				rangeDays: storedConfig.DateRangeDays(),
+				budgets:   storedConfig.Budgets,
+				logger:    clientLogger(),
+				now:       time.Now,
+			}
+			server := &http.Server{Addr: addr, Handler: api.handler(), ReadHeaderTimeout: 10 * time.Second}
+
+			ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			go func() {
+				<-ctx.Done()
+				shutdown, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				server.Shutdown(shutdown)
+			}()
+
+			fmt.Fprintf(os.Stderr, "Serving on http://%s (Ctrl+C to stop)\n", addr)
+			if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+				return err
+			}
+			return nil
+		},
+	}
+}
+
+// isLoopback reports whether a listen address only accepts local connections.
+func isLoopback(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// apiServer answers the serve endpoints from one client.
+type apiServer struct {
+	client    *costco.Client
+	addr      string             // Listen address, for checking Host headers
+	token     string             // Bearer token clients must send, if set
+	rangeDays int                // Default query window, from the profile
+	budgets   map[string]float64 // Budgets for /api/budget, from the profile
+	logger    *slog.Logger
+	now       func() time.Time
+}
+
+// dateRange is a request's resolved start and end dates.
+type dateRange struct {
+	start, end string
+}
+
+func (a *apiServer) handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /health", func(w http.ResponseWriter, r *http.Request) {
+		writeAPIJSON(w, http.StatusOK, map[string]string{"status": "ok"})
+	})
+	mux.Handle("GET /api/orders", a.dated(func(r *http.Request, d dateRange) (any, error) {
+		orders := []costco.OnlineOrder{}
+		for order, err := range a.client.OnlineOrders(r.Context(), d.start, d.end) {
+			if err != nil {
+				return nil, err
+			}
+			orders = append(orders, order)
+		}
+		return orders, nil
+	}))
+	mux.Handle("GET /api/receipts", a.dated(func(r *http.Request, d dateRange) (any, error) {
+		documentType := r.URL.Query().Get("type")
+		if documentType == "" {
+			documentType = costco.DefaultDocumentType
+		}
+		return a.client.GetReceipts(r.Context(), d.start, d.end, documentType, costco.DefaultDocumentSubType)
+	}))
+	mux.HandleFunc("GET /api/receipts/{barcode}", func(w http.ResponseWriter, r *http.Request) {
+		documentType := r.URL.Query().Get("type")
+		if documentType == "" {
+			documentType = costco.DocumentTypeWarehouse
+		}
+		receipt, err := a.client.GetReceiptDetail(r.Context(), r.PathValue("barcode"), documentType)
+		a.respond(w, r, receipt, err)
+	})
+	mux.Handle("GET /api/transactions", a.dated(func(r *http.Request, d dateRange) (any, error) {
+		return a.client.GetAllTransactionItems(r.Context(), d.start, d.end)
+	}))
+	mux.Handle("GET /api/summary", a.dated(func(r *http.Request, d dateRange) (any, error) {
+		return a.client.GetSummary(r.Context(), d.start, d.end)
+	}))
+	mux.Handle("GET /api/spending", a.dated(func(r *http.Request, d dateRange) (any, error) {
+		return a.client.GetSpendingSummary(r.Context(), d.start, d.end)
+	}))
+	mux.Handle("GET /api/frequent", a.dated(func(r *http.Request, d dateRange) (any, error) {
+		limit := 20
+		if value := r.URL.Query().Get("limit"); value != "" {
+			n, err := strconv.Atoi(value)
+			if err != nil || n < 0 {
+				return nil, badRequest{fmt.Errorf("invalid limit %q", value)}
+			}
+			limit = n
+		}
+		return a.client.GetFrequentItems(r.Context(), d.start, d.end, limit)
+	}))
+	mux.Handle("GET /api/items/{number}", a.dated(func(r *http.Request, d dateRange) (any, error) {
+		return a.client.GetItemHistory(r.Context(), r.PathValue("number"), d.start, d.end)
+	}))
+	mux.HandleFunc("GET /api/budget", func(w http.ResponseWriter, r *http.Request) {
+		if query := r.URL.Query(); !hasDates(query) {
+			query.Set("month", a.now().Format("2006-01"))
+			r.URL.RawQuery = query.Encode()
+		}
+		a.dated(func(r *http.Request, d dateRange) (any, error) {
+			if len(a.budgets) == 0 {
+				return nil, badRequest{fmt.Errorf("no budgets yet. Set one with '%s budget set groceries 600'", cliName())}
+			}
+			return a.client.GetBudgetStatus(r.Context(), d.start, d.end, a.budgets)
+		}).ServeHTTP(w, r)
+	})
+	mux.HandleFunc("GET /api/membership", func(w http.ResponseWriter, r *http.Request) {
+		membership, err := a.client.GetMembership(r.Context())
+		a.respond(w, r, membership, err)
+	})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a.logger.Info("api request", slog.String("method", r.Method), slog.String("path", r.URL.Path))
+		if !a.allowedHost(r.Host) {
+			writeAPIJSON(w, http.StatusForbidden, map[string]string{"error": fmt.Sprintf("host %q not allowed", r.Host)})
+			return
+		}
+		if !a.authorized(r) {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeAPIJSON(w, http.StatusUnauthorized, map[string]string{"error": "missing or invalid bearer token"})
+			return
+		}
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// allowedHost reports whether a request's Host header names this server: localhost,
+// a loopback IP, the listen host, or any IP when listening on every interface. A
+// page served from another name, rebound to this machine's address, still sends
+// that name and is refused.
+func (a *apiServer) allowedHost(hostport string) bool {
+	host, _, err := net.SplitHostPort(hostport)
+	if err != nil {
+		host = hostport
+	}
+	host = strings.ToLower(strings.Trim(host, "[]"))
+	if host == "localhost" {
+		return true
+	}
+	listenHost, _, _ := net.SplitHostPort(a.addr)
+	if listenHost != "" && strings.EqualFold(host, listenHost) {
+		return true
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	if ip.IsLoopback() {
+		return true
+	}
+	listenIP := net.ParseIP(listenHost)
+	return listenHost == "" || listenIP != nil && listenIP.IsUnspecified()
+}
+
+// authorized reports whether a request carries the server's bearer token, when it has one.
+func (a *apiServer) authorized(r *http.Request) bool {
+	if a.token == "" {
+		return true
+	}
+	got, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	return ok && subtle.ConstantTimeCompare([]byte(got), []byte(a.token)) == 1
+}
+
+// badRequest marks an error caused by the request rather than by Costco.
+type badRequest struct{ error }
+
+// dateParams are the query parameters dated endpoints take, named after the query flags.
+var dateParams = []string{"start", "end", "last", "month", "quarter", "ytd"}
+
+// hasDates reports whether a request picks its own dates.
+func hasDates(query url.Values) bool {
+	for _, param := range dateParams {
+		if query.Has(param) {
+			return true
+		}
+	}
+	return false
+}
+
+// dated resolves a request's date parameters the way the query commands resolve
+// their flags, then answers with what fetch returns.
+func (a *apiServer) dated(fetch func(r *http.Request, d dateRange) (any, error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		q := queryFlags{
+			start:   query.Get("start"),
+			end:     query.Get("end"),
+			last:    query.Get("last"),
+			month:   query.Get("month"),
+			quarter: query.Get("quarter"),
+			ytd:     query.Get("ytd") == "true",
+		}
+		now := a.now()
+		if err := q.resolveDates(now); err != nil {
+			a.respond(w, r, nil, badRequest{err})
+			return
+		}
+		d := dateRange{start: q.start, end: q.end}
+		if d.start == "" {
+			d.start = now.AddDate(0, 0, -a.rangeDays).Format(dateLayout)
+		}
+		if d.end == "" {
+			d.end = now.Format(dateLayout)
+		}
+
+		v, err := fetch(r, d)
+		a.respond(w, r, v, err)
+	})
+}
+
+// respond writes v as JSON, or the error: 400 for bad requests, 502 when the
+// Costco API call failed.
+func (a *apiServer) respond(w http.ResponseWriter, r *http.Request, v any, err error) {
+	var bad badRequest
+	switch {
+	case errors.As(err, &bad):
+		writeAPIJSON(w, http.StatusBadRequest, map[string]string{"error": err.Error()})
+	case err != nil:
+		a.logger.Warn("api request failed", slog.String("path", r.URL.Path), slog.String("error", err.Error()))
+		writeAPIJSON(w, http.StatusBadGateway, map[string]string{"error": err.Error()})
+	default:
+		writeAPIJSON(w, http.StatusOK, v)
+	}
+}
+
+func writeAPIJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testAPIServer() *apiServer {
+	return &apiServer{
+		addr:      "localhost:8080",
+		rangeDays: 30,
+		logger:    slog.New(slog.NewTextHandler(io.Discard, nil)),
+		now:       func() time.Time { return time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC) },
+	}
+}
+
+func apiGet(t *testing.T, h http.Handler, target string) *httptest.ResponseRecorder {
+	t.Helper()
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "http://localhost:8080"+target, nil))
+	return rec
+}
+
+func TestAPIServer_Dates(t *testing.T) {
+	api := testAPIServer()
+	var got dateRange
+	h := api.dated(func(r *http.Request, d dateRange) (any, error) {
+		got = d
+		return []string{}, nil
+	})
+
+	tests := []struct {
+		query string
+		want  dateRange
+	}{
+		{"", dateRange{"2025-05-16", "2025-06-15"}},
+		{"?start=2025-01-01&end=2025-01-31", dateRange{"2025-01-01", "2025-01-31"}},
+		{"?start=6m", dateRange{"2024-12-15", "2025-06-15"}},
+		{"?month=2025-02", dateRange{"2025-02-01", "2025-02-28"}},
+		{"?ytd=true", dateRange{"2025-01-01", "2025-06-15"}},
+	}
+	for _, tt := range tests {
+		rec := apiGet(t, h, "/api/summary"+tt.query)
+		require.Equal(t, http.StatusOK, rec.Code, tt.query)
+		assert.Equal(t, tt.want, got, tt.query)
+		assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+		assert.Equal(t, "[]\n", rec.Body.String())
+	}
+
+	rec := apiGet(t, h, "/api/summary?start=someday")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"error":"invalid start date \"someday\"`)
+}
+
+func TestAPIServer_Errors(t *testing.T) {
+	api := testAPIServer()
+	h := api.dated(func(r *http.Request, d dateRange) (any, error) {
+		return nil, errors.New("upstream down")
+	})
+	rec := apiGet(t, h, "/api/summary")
+	assert.Equal(t, http.StatusBadGateway, rec.Code)
+	assert.JSONEq(t, `{"error":"upstream down"}`, rec.Body.String())
+
+	mux := api.handler()
+	assert.Equal(t, http.StatusOK, apiGet(t, mux, "/health").Code)
+	assert.Equal(t, http.StatusNotFound, apiGet(t, mux, "/api/nothing").Code)
+
+	rec = apiGet(t, mux, "/api/budget")
+	assert.Equal(t, http.StatusBadRequest, rec.Code, "no budgets in the profile")
+	assert.Contains(t, rec.Body.String(), "no budgets yet")
+
+	rec = apiGet(t, mux, "/api/frequent?limit=lots")
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "http://localhost:8080/api/summary", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+}
+
+func TestAPIServer_Host(t *testing.T) {
+	tests := []struct {
+		addr, host string
+		want       bool
+	}{
+		{"localhost:8080", "localhost:8080", true},
+		{"localhost:8080", "127.0.0.1:8080", true},
+		{"localhost:8080", "[::1]:8080", true},
+		{"localhost:8080", "evil.example:8080", false},
+		{"localhost:8080", "192.168.1.10:8080", false},
+		{"192.168.1.10:8080", "192.168.1.10:8080", true},
+		{"192.168.1.10:8080", "192.168.1.11:8080", false},
+		{"nas.lan:8080", "NAS.lan:8080", true},
+		{":8080", "192.168.1.10:8080", true},
+		{":8080", "[fd00::1]:8080", true},
+		{":8080", "evil.example", false},
+		{"0.0.0.0:8080", "10.0.0.2", true},
+	}
+	for _, tt := range tests {
+		api := testAPIServer()
+		api.addr = tt.addr
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "/health", nil)
+		req.Host = tt.host
+		api.handler().ServeHTTP(rec, req)
+		if tt.want {
+			assert.Equal(t, http.StatusOK, rec.Code, "%s on %s", tt.host, tt.addr)
+		} else {
+			assert.Equal(t, http.StatusForbidden, rec.Code, "%s on %s", tt.host, tt.addr)
+		}
+	}
+}
+
+func TestAPIServer_Token(t *testing.T) {
+	api := testAPIServer()
+	api.token = "s3cret"
+	h := api.handler()
+
+	rec := apiGet(t, h, "/health")
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Equal(t, "Bearer", rec.Header().Get("WWW-Authenticate"))
+
+	for auth, want := range map[string]int{
+		"Bearer s3cret": http.StatusOK,
+		"Bearer wrong":  http.StatusUnauthorized,
+		"s3cret":        http.StatusUnauthorized,
+	} {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, "http://localhost:8080/health", nil)
+		req.Header.Set("Authorization", auth)
+		h.ServeHTTP(rec, req)
+		assert.Equal(t, want, rec.Code, auth)
+	}
+}
+
+func TestIsLoopback(t *testing.T) {
+	assert.True(t, isLoopback("localhost:8080"))
+	assert.True(t, isLoopback("127.0.0.1:8080"))
+	assert.True(t, isLoopback("[::1]:8080"))
+	assert.False(t, isLoopback(":8080"))
+	assert.False(t, isLoopback("0.0.0.0:8080"))
+	assert.False(t, isLoopback("192.168.1.10:8080"))
+}
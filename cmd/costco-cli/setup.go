@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/eshaffer321/costco-go/pkg/costco"
@@ -36,7 +37,7 @@ func setupCredentials() error {
 	}
 
 	// Get warehouse
-	defaultWarehouse := "847"
+	defaultWarehouse := costco.DefaultWarehouse
 	if existingConfig != nil && existingConfig.WarehouseNumber != "" {
 		defaultWarehouse = existingConfig.WarehouseNumber
 		fmt.Printf("Warehouse Number [%s]: ", defaultWarehouse)
@@ -50,19 +51,83 @@ func setupCredentials() error {
 		warehouse = defaultWarehouse
 	}
 
-	// Save config
-	config := &costco.StoredConfig{
-		Email:           email,
-		WarehouseNumber: warehouse,
+	// Start from existing query defaults so re-running setup keeps them
+	config := &costco.StoredConfig{}
+	if existingConfig != nil {
+		*config = *existingConfig
+	}
+	config.Email = email
+	config.WarehouseNumber = warehouse
+
+	// Get default date range
+	fmt.Printf("Default date range in days [%d]: ", config.DateRangeDays())
+	days, _ := reader.ReadString('\n')
+	days = strings.TrimSpace(days)
+	if days != "" {
+		n, err := strconv.Atoi(days)
+		if err != nil || n <= 0 {
+			return fmt.Errorf("invalid date range %q: must be a positive number of days", days)
+		}
+		config.DefaultDateRangeDays = n
+	}
+
+	// Get output format
+	fmt.Printf("Output format (text/json/jsonl) [%s]: ", config.Format())
+	format, _ := reader.ReadString('\n')
+	format = strings.TrimSpace(format)
+	if format != "" {
+		if format != costco.OutputFormatText && !outputFormat(format).structured() {
+			return fmt.Errorf("invalid output format %q: must be text, json, or jsonl", format)
+		}
+		config.OutputFormat = format
+	}
+
+	// Get locale
+	defaultLocale := config.Locale
+	if defaultLocale == "" {
+		defaultLocale = costco.LocaleEnUS
+	}
+	fmt.Printf("Locale (en-US/en-CA/fr-CA) [%s]: ", defaultLocale)
+	locale, _ := reader.ReadString('\n')
+	locale = strings.TrimSpace(locale)
+	if locale != "" {
+		config.Locale = costco.Locale(locale)
+		config.Currency = config.Locale.DefaultCurrency()
+	}
+
+	// Get secret backend
+	useKeychain := "n"
+	if config.SecretBackend == costco.SecretBackendKeychain {
+		useKeychain = "y"
+	}
+	fmt.Printf("Store email in OS keychain? (y/n) [%s]: ", useKeychain)
+	answer, _ := reader.ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	if answer == "" {
+		answer = useKeychain
+	}
+	if answer == "y" || answer == "yes" {
+		config.SecretBackend = costco.SecretBackendKeychain
+	} else {
+		config.SecretBackend = costco.SecretBackendFile
 	}
 
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	// Save config
 	if err := costco.SaveConfig(config); err != nil {
 		return fmt.Errorf("failed to save config: %w", err)
 	}
 
-	fmt.Println("\n✓ Configuration saved to ~/.costco/config.json")
+	if profile := costco.ActiveProfile(); profile != costco.DefaultProfile {
+		fmt.Printf("\n✓ Configuration saved to profile %q\n", profile)
+	} else {
+		fmt.Println("\n✓ Configuration saved to ~/.costco/config.json")
+	}
 	fmt.Println("\nSetup complete! Next, run:")
-	fmt.Println("  costco-cli -cmd import-token")
+	fmt.Printf("  %s login\n", cliName())
 	fmt.Println("\nThen log in to costco.com in your browser and paste the OAuth token response.")
 
 	return nil
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+)
+
+func summaryCommand() *command {
+	var (
+		q    queryFlags
+		opts tableOptions
+		year string
+		span string
+	)
+	return &command{
+		name:  "summary",
+		short: "Spending, trips, savings, departments, and top items for a period",
+		long:  "Pick the period with -year or -range, or with the date flags every query command takes.",
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&year, "year", "", "Calendar year (YYYY)")
+			fs.StringVar(&span, "range", "", "Date range (YYYY-MM-DD..YYYY-MM-DD)")
+			q.dateFlags(fs)
+			q.localFlag(fs)
+			q.tagFlag(fs)
+			q.outputFlags(fs)
+			opts.wideFlag(fs)
+		},
+		run: func(ctx context.Context, args []string) error {
+			start, end, err := summaryRange(year, span)
+			if err != nil {
+				return err
+			}
+			if start != "" {
+				if q.start != "" || q.end != "" || q.periodSet() {
+					return errors.New("use -year or -range without the other date flags")
+				}
+				q.start, q.end = start, end
+			}
+
+			s, err := q.open()
+			if err != nil {
+				return err
+			}
+			summary, err := s.client.GetSummary(ctx, s.start, s.end)
+			if err != nil {
+				return fmt.Errorf("getting summary: %w", err)
+			}
+			if s.output.structured() {
+				return s.output.write(summary)
+			}
+			return printSummary(summary, opts)
+		},
+	}
+}
+
+// summaryRange turns the -year or -range flag into a date range. It returns empty
+// dates when neither is set.
+func summaryRange(year, span string) (string, string, error) {
+	if year != "" && span != "" {
+		return "", "", errors.New("use only one of -year and -range")
+	}
+
+	switch {
+	case year != "":
+		t, err := time.Parse("2006", year)
+		if err != nil {
+			return "", "", fmt.Errorf("invalid year %q: use YYYY", year)
+		}
+		return t.Format("2006-01-02"), t.AddDate(1, 0, -1).Format("2006-01-02"), nil
+	case span != "":
+		start, end, ok := strings.Cut(span, "..")
+		if !ok {
+			return "", "", fmt.Errorf("invalid range %q: use YYYY-MM-DD..YYYY-MM-DD", span)
+		}
+		for _, date := range []string{start, end} {
+			if _, err := time.Parse("2006-01-02", date); err != nil {
+				return "", "", fmt.Errorf("invalid range %q: use YYYY-MM-DD..YYYY-MM-DD", span)
+			}
+		}
+		if end < start {
+			return "", "", fmt.Errorf("invalid range %q: end is before start", span)
+		}
+		return start, end, nil
+	}
+	return "", "", nil
+}
+
+func printSummary(summary *costco.Summary, opts tableOptions) error {
+	fmt.Printf("Summary %s to %s\n", summary.StartDate, summary.EndDate)
+	fmt.Println(strings.Repeat("=", 80))
+	fmt.Printf("Spent:          %s\n", money(summary.Total, ""))
+	fmt.Printf("Trips:          %d (avg %s)\n", summary.TripCount, money(summary.AverageBasket, ""))
+	fmt.Printf("Tax:            %s\n", money(summary.Tax, ""))
+	if summary.Refunds > 0 {
+		fmt.Printf("Refunds:        %s\n", paint(styleRed, money(summary.Refunds, "")))
+	}
+	fmt.Printf("Saved:          %s (coupons %s)\n", paint(styleGreen, money(summary.Savings.Total, "")), money(summary.Savings.CouponSavings, ""))
+
+	if len(summary.Departments) > 0 {
+		fmt.Println("\nBy department:")
+		departments := newTable([]tableColumn{
+			{name: "department", maxWidth: 30},
+			{name: "total", numeric: true},
+			{name: "items", numeric: true},
+		})
+		for _, dept := range summary.Departments {
+			departments.add(dept.Department, tableMoney{dept.Total, ""}, dept.ItemCount)
+		}
+		if err := departments.render(os.Stdout, opts); err != nil {
+			return err
+		}
+	}
+
+	if len(summary.TopItems) > 0 {
+		fmt.Println("\nTop items:")
+		items := newTable([]tableColumn{
+			{name: "item", maxWidth: 36},
+			{name: "total", numeric: true},
+			{name: "quantity", numeric: true},
+		})
+		for _, item := range summary.TopItems {
+			items.add(item.ItemDescription, tableMoney{item.TotalSpent, ""}, item.TotalQuantity)
+		}
+		return items.render(os.Stdout, opts)
+	}
+	return nil
+}
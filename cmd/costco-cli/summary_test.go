@@ -0,0 +1,28 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummaryRange(t *testing.T) {
+	start, end, err := summaryRange("2025", "")
+	assert.NoError(t, err)
+	assert.Equal(t, [2]string{"2025-01-01", "2025-12-31"}, [2]string{start, end})
+
+	start, end, err = summaryRange("", "2025-03-01..2025-03-15")
+	assert.NoError(t, err)
+	assert.Equal(t, [2]string{"2025-03-01", "2025-03-15"}, [2]string{start, end})
+
+	start, _, err = summaryRange("", "")
+	assert.NoError(t, err)
+	assert.Empty(t, start)
+
+	_, _, err = summaryRange("2025", "2025-03-01..2025-03-15")
+	assert.ErrorContains(t, err, "only one")
+	_, _, err = summaryRange("", "2025-03-15..2025-03-01")
+	assert.ErrorContains(t, err, "end is before start")
+	_, _, err = summaryRange("", "2025-03-01")
+	assert.ErrorContains(t, err, "invalid range")
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+)
+
+func syncCommand() *command {
+	var (
+		q    queryFlags
+		full bool
+	)
+	return &command{
+		name:  "sync",
+		short: "Pull new receipts and online orders into the local store",
+		long: `Fetches everything since the last sync into ~/.costco/transactions.json. The
+first sync starts at -start (default from config date range). With -full, the
+whole range from -start, or from the start of the store, is fetched again.`,
+		flags: func(fs *flag.FlagSet) {
+			fs.StringVar(&q.start, "start", "", "First day to sync on the first sync or with -full: YYYY-MM-DD or "+spanHelp)
+			fs.BoolVar(&full, "full", false, "Fetch the whole range again to backfill or refresh the store")
+			q.outputFlags(fs)
+		},
+		run: func(ctx context.Context, args []string) error {
+			explicitStart := q.start
+			s, err := q.open()
+			if err != nil {
+				return err
+			}
+			startDate := s.start
+			if full && explicitStart == "" {
+				startDate = ""
+			}
+
+			result, err := s.client.SyncStore(ctx, startDate, full)
+			if err != nil {
+				return fmt.Errorf("syncing: %w", err)
+			}
+			if s.output.structured() {
+				return s.output.write(result)
+			}
+			return printSyncResult(result)
+		},
+	}
+}
+
+func printSyncResult(result *costco.SyncResult) error {
+	fmt.Printf("Synced %s to %s\n", result.StartDate, result.EndDate)
+
+	var receiptsTotal float64
+	receipts := newTable([]tableColumn{
+		{name: "date"},
+		{name: "warehouse"},
+		{name: "barcode"},
+		{name: "total", numeric: true},
+	})
+	for _, tx := range result.NewReceipts {
+		receiptsTotal += tx.Total
+		receipts.add(tx.TransactionDate, fmt.Sprintf("%s #%d", tx.WarehouseName, tx.WarehouseNumber),
+			tx.TransactionBarcode, tableMoney{tx.Total, tx.Currency})
+	}
+	fmt.Printf("\nNew receipts: %d (%s)\n", len(result.NewReceipts), money(receiptsTotal, ""))
+	if len(result.NewReceipts) > 0 {
+		if err := receipts.render(os.Stdout, tableOptions{}); err != nil {
+			return err
+		}
+	}
+
+	var ordersTotal float64
+	orders := newTable([]tableColumn{
+		{name: "date"},
+		{name: "order"},
+		{name: "total", numeric: true},
+	})
+	for _, order := range result.NewOrders {
+		ordersTotal += order.OrderTotal
+		orders.add(order.OrderPlacedDate[:min(10, len(order.OrderPlacedDate))], order.OrderNumber,
+			tableMoney{order.OrderTotal, order.Currency})
+	}
+	fmt.Printf("\nNew online orders: %d (%s)\n", len(result.NewOrders), money(ordersTotal, ""))
+	if len(result.NewOrders) > 0 {
+		if err := orders.render(os.Stdout, tableOptions{}); err != nil {
+			return err
+		}
+	}
+
+	fmt.Printf("\nStore: %d receipts, %d online orders\n", result.TotalReceipts, result.TotalOrders)
+	if result.FailedReceipts > 0 {
+		fmt.Printf("\nWarning: %d receipts couldn't be fetched; the next sync retries them\n", result.FailedReceipts)
+	}
+	return nil
+}
@@ -0,0 +1,285 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Aligned table output shared by the list and report commands
+
+// tableColumn describes one column a command can print.
+type tableColumn struct {
+	name     string // Used by -columns and -sort
+	numeric  bool   // Right-aligned and sorted by value
+	maxWidth int    // Cells are cut to this width unless -wide (0 = no limit)
+	wide     bool   // Only shown with -wide, unless picked with -columns
+	status   bool   // Colored by statusStyle
+}
+
+// tableMoney is a money cell: printed with money and sorted by amount.
+type tableMoney struct {
+	amount   float64
+	currency string
+}
+
+// tableUnitPrice is a fuel price per gallon or liter: printed with unitPrice and
+// sorted by amount.
+type tableUnitPrice tableMoney
+
+// styled is a cell with an explicit style, overriding the default coloring.
+type styled struct {
+	value interface{}
+	style string
+}
+
+// table collects rows, one value per column, and renders them aligned.
+// Values may be strings, ints, float64s, tableMoney, or time.Time (printed as a date),
+// optionally wrapped in styled. Negative money is red unless styled otherwise.
+type table struct {
+	columns []tableColumn
+	rows    [][]interface{}
+}
+
+func newTable(columns []tableColumn) *table {
+	return &table{columns: columns}
+}
+
+func (t *table) add(values ...interface{}) {
+	t.rows = append(t.rows, values)
+}
+
+// tableOptions are the -columns, -sort, and -wide flags.
+type tableOptions struct {
+	columns string
+	sort    string
+	wide    bool
+}
+
+// register adds the table flags for a command printing columns; the help lists them.
+func (o *tableOptions) register(fs *flag.FlagSet, columns []tableColumn) {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		names[i] = column.name
+	}
+	fs.StringVar(&o.columns, "columns", "", "Comma-separated columns to show: "+strings.Join(names, ", "))
+	fs.StringVar(&o.sort, "sort", "", "Column to sort by; prefix with - for descending, e.g. -sort=-total")
+	fs.BoolVar(&o.wide, "wide", false, "Show every column and don't truncate long values")
+}
+
+// wideFlag adds only -wide, for reports that print several tables.
+func (o *tableOptions) wideFlag(fs *flag.FlagSet) {
+	fs.BoolVar(&o.wide, "wide", false, "Don't truncate long values")
+}
+
+// validate checks the options against a command's columns, so mistakes are reported
+// before anything is fetched.
+func (o tableOptions) validate(columns []tableColumn) error {
+	_, err := o.selected(columns)
+	if err != nil {
+		return err
+	}
+	_, _, err = o.sortColumn(columns)
+	return err
+}
+
+func (o tableOptions) selected(columns []tableColumn) ([]int, error) {
+	var indexes []int
+	if o.columns == "" {
+		for i, column := range columns {
+			if o.wide || !column.wide {
+				indexes = append(indexes, i)
+			}
+		}
+		return indexes, nil
+	}
+	for _, name := range strings.Split(o.columns, ",") {
+		i := columnIndex(columns, strings.TrimSpace(name))
+		if i < 0 {
+			return nil, unknownColumn(columns, name)
+		}
+		indexes = append(indexes, i)
+	}
+	return indexes, nil
+}
+
+// sortColumn returns the index of the sort column (-1 for none) and whether it's descending.
+func (o tableOptions) sortColumn(columns []tableColumn) (int, bool, error) {
+	if o.sort == "" {
+		return -1, false, nil
+	}
+	name, descending := strings.CutPrefix(o.sort, "-")
+	i := columnIndex(columns, name)
+	if i < 0 {
+		return -1, false, unknownColumn(columns, name)
+	}
+	return i, descending, nil
+}
+
+func columnIndex(columns []tableColumn, name string) int {
+	for i, column := range columns {
+		if column.name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func unknownColumn(columns []tableColumn, name string) error {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		names[i] = column.name
+	}
+	return fmt.Errorf("unknown column %q: must be one of %s", name, strings.Join(names, ", "))
+}
+
+// render writes the table with upper-case headers, two spaces between columns.
+func (t *table) render(w io.Writer, opts tableOptions) error {
+	indexes, err := opts.selected(t.columns)
+	if err != nil {
+		return err
+	}
+	sortBy, descending, err := opts.sortColumn(t.columns)
+	if err != nil {
+		return err
+	}
+
+	rows := t.rows
+	if sortBy >= 0 {
+		rows = append([][]interface{}(nil), t.rows...)
+		sort.SliceStable(rows, func(i, j int) bool {
+			if descending {
+				return lessCell(rows[j][sortBy], rows[i][sortBy])
+			}
+			return lessCell(rows[i][sortBy], rows[j][sortBy])
+		})
+	}
+
+	cells := make([][]string, 0, len(rows)+1)
+	header := make([]string, len(indexes))
+	for c, i := range indexes {
+		header[c] = strings.ToUpper(strings.ReplaceAll(t.columns[i].name, "_", " "))
+	}
+	cells = append(cells, header)
+	for _, row := range rows {
+		line := make([]string, len(indexes))
+		for c, i := range indexes {
+			text := formatCell(row[i])
+			if max := t.columns[i].maxWidth; max > 0 && !opts.wide && len([]rune(text)) > max {
+				text = string([]rune(text)[:max-3]) + "..."
+			}
+			line[c] = text
+		}
+		cells = append(cells, line)
+	}
+
+	widths := make([]int, len(indexes))
+	for _, line := range cells {
+		for c, text := range line {
+			widths[c] = max(widths[c], len([]rune(text)))
+		}
+	}
+
+	for r, line := range cells {
+		var b strings.Builder
+		for c, text := range line {
+			if c > 0 {
+				b.WriteString("  ")
+			}
+			pad := strings.Repeat(" ", widths[c]-len([]rune(text)))
+			// Color after measuring so escape codes don't skew the alignment
+			if r == 0 {
+				text = paint(styleBold, text)
+			} else {
+				text = paint(t.cellStyle(indexes[c], rows[r-1][indexes[c]], text), text)
+			}
+			if t.columns[indexes[c]].numeric {
+				b.WriteString(pad + text)
+			} else {
+				b.WriteString(text + pad)
+			}
+		}
+		if _, err := fmt.Fprintln(w, strings.TrimRight(b.String(), " ")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cellStyle picks the color of a cell: an explicit styled style, the column's
+// status color, or red for negative money.
+func (t *table) cellStyle(column int, value interface{}, text string) string {
+	if s, ok := value.(styled); ok {
+		return s.style
+	}
+	if t.columns[column].status {
+		return statusStyle(text)
+	}
+	if m, ok := value.(tableMoney); ok {
+		return amountStyle(m.amount)
+	}
+	return ""
+}
+
+func formatCell(value interface{}) string {
+	switch v := value.(type) {
+	case styled:
+		return formatCell(v.value)
+	case string:
+		return v
+	case int:
+		return strconv.Itoa(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', 2, 64)
+	case tableMoney:
+		return money(v.amount, v.currency)
+	case tableUnitPrice:
+		return unitPrice(v.amount, v.currency)
+	case time.Time:
+		if v.IsZero() {
+			return ""
+		}
+		return v.Format("2006-01-02")
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func lessCell(a, b interface{}) bool {
+	if s, ok := a.(styled); ok {
+		a = s.value
+	}
+	if s, ok := b.(styled); ok {
+		b = s.value
+	}
+	if x, ok := cellNumber(a); ok {
+		if y, ok := cellNumber(b); ok {
+			return x < y
+		}
+	}
+	if x, ok := a.(time.Time); ok {
+		if y, ok := b.(time.Time); ok {
+			return x.Before(y)
+		}
+	}
+	return strings.ToLower(formatCell(a)) < strings.ToLower(formatCell(b))
+}
+
+func cellNumber(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case float64:
+		return v, true
+	case tableMoney:
+		return v.amount, true
+	case tableUnitPrice:
+		return v.amount, true
+	default:
+		return 0, false
+	}
+}
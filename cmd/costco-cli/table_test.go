@@ -0,0 +1,85 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testColumns = []tableColumn{
+	{name: "item"},
+	{name: "description", maxWidth: 12},
+	{name: "total", numeric: true},
+	{name: "note", wide: true},
+}
+
+func testTable() *table {
+	t := newTable(testColumns)
+	t.add("1", "KIRKLAND SIGNATURE EGGS", tableMoney{5.99, ""}, "weekly")
+	t.add("22", "MILK", tableMoney{10, ""}, "")
+	t.add("3", "BANANAS", tableMoney{1.49, ""}, "organic")
+	return t
+}
+
+func renderTable(t *testing.T, tbl *table, opts tableOptions) string {
+	var buf bytes.Buffer
+	require.NoError(t, tbl.render(&buf, opts))
+	return buf.String()
+}
+
+func TestTableRender(t *testing.T) {
+	assert.Equal(t, ""+
+		"ITEM  DESCRIPTION    TOTAL\n"+
+		"1     KIRKLAND ...   $5.99\n"+
+		"22    MILK          $10.00\n"+
+		"3     BANANAS        $1.49\n",
+		renderTable(t, testTable(), tableOptions{}))
+}
+
+func TestTableWide(t *testing.T) {
+	assert.Equal(t, ""+
+		"ITEM  DESCRIPTION               TOTAL  NOTE\n"+
+		"1     KIRKLAND SIGNATURE EGGS   $5.99  weekly\n"+
+		"22    MILK                     $10.00\n"+
+		"3     BANANAS                   $1.49  organic\n",
+		renderTable(t, testTable(), tableOptions{wide: true}))
+}
+
+func TestTableColumnsAndSort(t *testing.T) {
+	assert.Equal(t, ""+
+		" TOTAL  NOTE\n"+
+		"$10.00\n"+
+		" $5.99  weekly\n"+
+		" $1.49  organic\n",
+		renderTable(t, testTable(), tableOptions{columns: "total, note", sort: "-total"}))
+
+	// Text columns sort as text, numbers by value
+	assert.Equal(t, "ITEM\n1\n22\n3\n", renderTable(t, testTable(), tableOptions{columns: "item", sort: "item"}))
+	assert.Equal(t, "DESCRIPTION\nBANANAS\nKIRKLAND ...\nMILK\n",
+		renderTable(t, testTable(), tableOptions{columns: "description", sort: "description"}))
+}
+
+func TestTableOptionsValidate(t *testing.T) {
+	assert.NoError(t, tableOptions{columns: "item,note", sort: "-total"}.validate(testColumns))
+
+	err := tableOptions{columns: "item,price"}.validate(testColumns)
+	assert.EqualError(t, err, `unknown column "price": must be one of item, description, total, note`)
+
+	err = tableOptions{sort: "-price"}.validate(testColumns)
+	assert.ErrorContains(t, err, `unknown column "price"`)
+}
+
+func TestTableOptionsRegister(t *testing.T) {
+	var opts tableOptions
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	opts.register(fs, testColumns)
+
+	require.NoError(t, fs.Parse([]string{"-columns", "item,total", "-sort=-total", "-wide"}))
+	assert.Equal(t, tableOptions{columns: "item,total", sort: "-total", wide: true}, opts)
+	assert.Contains(t, fs.Lookup("columns").Usage, "item, description, total, note")
+}
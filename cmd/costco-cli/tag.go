@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+)
+
+func tagCommand() *command {
+	var remove bool
+	return &command{
+		name:        "tag",
+		args:        []string{"barcode"},
+		optionalArg: "tag",
+		repeatArg:   true,
+		short:       "Label a receipt with your own tags, e.g. vacation or house-project",
+		long: `Tags are kept in ~/.costco/tags.json and never sent to Costco. Filter export
+and summary by them with -tag. With no tags, prints the receipt's current tags.`,
+		flags: func(fs *flag.FlagSet) {
+			fs.BoolVar(&remove, "remove", false, "Remove the given tags, or all tags when none are given")
+		},
+		run: func(ctx context.Context, args []string) error {
+			return tagReceipt(os.Stdout, args[0], args[1:], remove)
+		},
+		complete: completeBarcodes,
+	}
+}
+
+func tagReceipt(out io.Writer, barcode string, tags []string, remove bool) error {
+	var (
+		current []string
+		err     error
+	)
+	switch {
+	case remove:
+		current, err = costco.UntagTransaction(barcode, tags...)
+	case len(tags) > 0:
+		current, err = costco.TagTransaction(barcode, tags...)
+	default:
+		var all costco.TransactionTags
+		all, err = costco.LoadTags()
+		current = all[barcode]
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(current) == 0 {
+		fmt.Fprintf(out, "%s has no tags\n", barcode)
+		return nil
+	}
+	fmt.Fprintf(out, "%s: %s\n", barcode, strings.Join(current, ", "))
+	return nil
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagReceipt(t *testing.T) {
+	withTempConfig(t)
+
+	var out bytes.Buffer
+	require.NoError(t, tagReceipt(&out, "R1", []string{"vacation", "house-project"}, false))
+	assert.Equal(t, "R1: house-project, vacation\n", out.String())
+
+	out.Reset()
+	require.NoError(t, tagReceipt(&out, "R1", nil, false))
+	assert.Equal(t, "R1: house-project, vacation\n", out.String())
+
+	out.Reset()
+	require.NoError(t, tagReceipt(&out, "R1", []string{"vacation"}, true))
+	assert.Equal(t, "R1: house-project\n", out.String())
+
+	out.Reset()
+	require.NoError(t, tagReceipt(&out, "R1", nil, true))
+	assert.Equal(t, "R1 has no tags\n", out.String())
+}
+
+func TestTagCommandArgs(t *testing.T) {
+	withTempConfig(t)
+
+	var got []string
+	cmd := tagCommand()
+	cmd.run = func(ctx context.Context, args []string) error {
+		got = args
+		return nil
+	}
+	var out bytes.Buffer
+	require.NoError(t, cmd.execute(context.Background(), &out, "costco-cli", []string{"R1", "a", "b", "c"}))
+	assert.Equal(t, []string{"R1", "a", "b", "c"}, got)
+
+	assert.ErrorIs(t, cmd.execute(context.Background(), &out, "costco-cli", nil), errUsage)
+	assert.Contains(t, out.String(), "costco-cli tag [flags] <barcode> [tag...]")
+}
+
+func TestTagFlag(t *testing.T) {
+	var q queryFlags
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	q.tagFlag(fs)
+	require.NoError(t, fs.Parse([]string{"-tag", "vacation,house-project", "-tag", "gifts"}))
+	assert.Equal(t, []string{"vacation", "house-project", "gifts"}, q.tags)
+}
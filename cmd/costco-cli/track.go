@@ -0,0 +1,177 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+)
+
+var trackColumns = []tableColumn{
+	{name: "order"},
+	{name: "items", maxWidth: 30},
+	{name: "carrier"},
+	{name: "tracking"},
+	{name: "status", status: true},
+	{name: "latest_event", maxWidth: 40},
+	{name: "event_date", wide: true},
+	{name: "estimated_delivery"},
+	{name: "url", wide: true},
+}
+
+// trackedShipment is one package of an online order with its carrier tracking.
+type trackedShipment struct {
+	OrderNumber string                   `json:"orderNumber"`
+	Items       []string                 `json:"items"`
+	Tracking    *costco.ShipmentTracking `json:"tracking"`
+}
+
+func trackCommand() *command {
+	var (
+		q    queryFlags
+		opts tableOptions
+	)
+	return &command{
+		name:        "track",
+		optionalArg: "order-number",
+		short:       "Live carrier tracking for shipments still on their way",
+		long: `Lists every shipment of the orders in the date range that hasn't been delivered,
+with its latest tracking event from the carrier. Given an order number, all of
+that order's shipments are shown, delivered or not. When the live lookup fails,
+the tracking saved with the order is shown instead.`,
+		flags: func(fs *flag.FlagSet) {
+			q.dateFlags(fs)
+			q.outputFlags(fs)
+			opts.register(fs, trackColumns)
+		},
+		run: func(ctx context.Context, args []string) error {
+			if err := opts.validate(trackColumns); err != nil {
+				return err
+			}
+			var orderNumber string
+			if len(args) > 0 {
+				orderNumber = args[0]
+			}
+			s, err := q.open()
+			if err != nil {
+				return err
+			}
+
+			var orders []costco.OnlineOrder
+			for order, err := range s.client.OnlineOrders(ctx, s.start, s.end) {
+				if err != nil {
+					return fmt.Errorf("getting orders: %w", err)
+				}
+				if orderNumber == "" || order.OrderNumber == orderNumber {
+					orders = append(orders, order)
+				}
+				if order.OrderNumber == orderNumber {
+					break
+				}
+			}
+			if orderNumber != "" && len(orders) == 0 {
+				return fmt.Errorf("order %s not found from %s to %s; set -start to look further back", orderNumber, s.start, s.end)
+			}
+
+			shipments := orderShipments(orders, orderNumber == "")
+			trackShipments(ctx, s.client, shipments, os.Stderr)
+			if s.output.structured() {
+				return s.output.write(shipments)
+			}
+			return printShipments(shipments, opts)
+		},
+	}
+}
+
+// orderShipments collects the packages of orders, one per tracking number, with
+// the tracking saved with the order. With inFlight, delivered packages are left out.
+func orderShipments(orders []costco.OnlineOrder, inFlight bool) []trackedShipment {
+	shipments := []trackedShipment{}
+	for _, order := range orders {
+		byNumber := make(map[string]int)
+		for _, item := range order.OrderLineItems {
+			sh := item.Shipment
+			if sh == nil || sh.TrackingNumber == "" || inFlight && sh.DeliveredDate != "" {
+				continue
+			}
+			if i, seen := byNumber[sh.TrackingNumber]; seen {
+				shipments[i].Items = append(shipments[i].Items, item.ItemDescription)
+				continue
+			}
+			byNumber[sh.TrackingNumber] = len(shipments)
+			shipments = append(shipments, trackedShipment{
+				OrderNumber: order.OrderNumber,
+				Items:       []string{item.ItemDescription},
+				Tracking:    savedTracking(order.OrderNumber, sh),
+			})
+		}
+	}
+	return shipments
+}
+
+// savedTracking is the tracking snapshot taken with the order query.
+func savedTracking(orderNumber string, sh *costco.Shipment) *costco.ShipmentTracking {
+	tracking := &costco.ShipmentTracking{
+		OrderNumber:          orderNumber,
+		TrackingNumber:       sh.TrackingNumber,
+		CarrierName:          sh.CarrierName,
+		TrackingSiteURL:      sh.TrackingSiteURL,
+		Status:               sh.Status,
+		EstimatedArrivalDate: sh.EstimatedArrivalDate,
+		DeliveredDate:        sh.DeliveredDate,
+		IsDeliveryDelayed:    sh.IsDeliveryDelayed,
+	}
+	if sh.TrackingEvent != nil {
+		tracking.Events = []costco.TrackingEvent{*sh.TrackingEvent}
+	}
+	return tracking
+}
+
+// trackShipments replaces each saved snapshot with live carrier tracking. Failed
+// lookups keep the snapshot and are reported to errOut.
+func trackShipments(ctx context.Context, client *costco.Client, shipments []trackedShipment, errOut io.Writer) {
+	for i, sh := range shipments {
+		live, err := client.GetTracking(ctx, sh.OrderNumber, sh.Tracking.TrackingNumber)
+		if err != nil {
+			fmt.Fprintf(errOut, "tracking %s: %v (showing the saved status)\n", sh.Tracking.TrackingNumber, err)
+			continue
+		}
+		shipments[i].Tracking = live
+	}
+}
+
+func printShipments(shipments []trackedShipment, opts tableOptions) error {
+	if len(shipments) == 0 {
+		fmt.Println("No shipments on their way")
+		return nil
+	}
+
+	t := newTable(trackColumns)
+	for _, sh := range shipments {
+		tr := sh.Tracking
+		status := tr.Status
+		if tr.IsDeliveryDelayed && !tr.Delivered() {
+			status += " (delayed)"
+		}
+		var event, eventDate string
+		estimate := tr.EstimatedArrivalDate
+		if latest := tr.LatestEvent(); latest != nil {
+			event, eventDate = latest.Event, latest.EventDate
+			if estimate == "" {
+				estimate = latest.EstimatedDeliveryDate
+			}
+		}
+		if tr.Delivered() {
+			estimate = "delivered " + tr.DeliveredDate[:min(10, len(tr.DeliveredDate))]
+		} else {
+			estimate = estimate[:min(10, len(estimate))]
+		}
+		t.add(sh.OrderNumber, strings.Join(sh.Items, ", "), tr.CarrierName, tr.TrackingNumber, status,
+			event, eventDate, estimate, tr.TrackingSiteURL)
+	}
+	return t.render(os.Stdout, opts)
+}
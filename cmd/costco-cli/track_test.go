@@ -0,0 +1,37 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderShipments(t *testing.T) {
+	onTheWay := &costco.Shipment{
+		TrackingNumber: "1Z1", CarrierName: "UPS", Status: "Shipped", EstimatedArrivalDate: "2025-03-04",
+		TrackingEvent: &costco.TrackingEvent{Event: "In Transit", EventDate: "2025-03-02"},
+	}
+	delivered := &costco.Shipment{TrackingNumber: "1Z2", CarrierName: "UPS", DeliveredDate: "2025-02-27"}
+	orders := []costco.OnlineOrder{{
+		OrderNumber: "O1",
+		OrderLineItems: []costco.OrderLineItem{
+			{ItemDescription: "TV", Shipment: onTheWay},
+			{ItemDescription: "Mount", Shipment: onTheWay},
+			{ItemDescription: "Cable", Shipment: delivered},
+			{ItemDescription: "Warranty"},
+		},
+	}}
+
+	shipments := orderShipments(orders, true)
+	require.Len(t, shipments, 1)
+	assert.Equal(t, "O1", shipments[0].OrderNumber)
+	assert.Equal(t, []string{"TV", "Mount"}, shipments[0].Items, "one row per package")
+	assert.Equal(t, "1Z1", shipments[0].Tracking.TrackingNumber)
+	assert.Equal(t, "In Transit", shipments[0].Tracking.LatestEvent().Event)
+
+	shipments = orderShipments(orders, false)
+	require.Len(t, shipments, 2, "delivered packages are kept when asked for")
+	assert.True(t, shipments[1].Tracking.Delivered())
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+)
+
+// webhookTimeout bounds each webhook delivery.
+const webhookTimeout = 10 * time.Second
+
+func watchCommand() *command {
+	var (
+		q        queryFlags
+		interval time.Duration
+		days     int
+		webhook  string
+		notify   string
+	)
+	return &command{
+		name:  "watch",
+		short: "Run continuously and report new receipts, orders, and shipment status changes",
+		long: `Polls every -interval. The first poll only records what exists; after that each new
+receipt, new online order, and order or shipment status change is printed (as one
+JSON line with -json or -output jsonl) and sent to the -webhook (POSTed as JSON)
+and the -exec command. The command runs with sh -c, gets the event as JSON on
+stdin, and COSTCO_EVENT_KIND and COSTCO_EVENT_MESSAGE in its environment. Failed
+deliveries are reported and skipped. Stop with Ctrl-C.`,
+		flags: func(fs *flag.FlagSet) {
+			fs.DurationVar(&interval, "interval", costco.DefaultActivityInterval, "Time between polls")
+			fs.IntVar(&days, "days", costco.DefaultActivityLookbackDays, "Days of receipts and orders to compare")
+			fs.StringVar(&webhook, "webhook", "", "URL to POST each event to as JSON")
+			fs.StringVar(&notify, "exec", "", `Command to run for each event, e.g. 'notify-send Costco "$COSTCO_EVENT_MESSAGE"'`)
+			q.outputFlags(fs)
+		},
+		run: func(ctx context.Context, args []string) error {
+			if interval < time.Minute {
+				return fmt.Errorf("interval %s is too short: poll at most once a minute", interval)
+			}
+			s, err := q.open()
+			if err != nil {
+				return err
+			}
+
+			ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			deliver := eventDelivery{out: os.Stdout, errOut: os.Stderr, json: s.output.structured(), webhook: webhook, exec: notify, http: http.DefaultClient}
+			fmt.Fprintf(os.Stderr, "Watching every %s for new receipts, orders, and status changes (Ctrl-C to stop)\n", interval)
+			err = s.client.PollActivity(ctx, costco.ActivityOptions{Interval: interval, LookbackDays: days},
+				func(e costco.ActivityEvent) error { return deliver.send(ctx, e) })
+			if errors.Is(err, context.Canceled) {
+				return nil
+			}
+			return err
+		},
+	}
+}
+
+// eventDelivery sends activity events to stdout and the optional webhook and command.
+type eventDelivery struct {
+	out     io.Writer
+	errOut  io.Writer // Failed webhook and command deliveries are reported here
+	json    bool
+	webhook string
+	exec    string
+	http    *http.Client
+}
+
+// send prints the event and hands it to the webhook and command. Only a failed
+// write to out is returned, which stops watching.
+func (d eventDelivery) send(ctx context.Context, e costco.ActivityEvent) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	if d.json {
+		_, err = fmt.Fprintf(d.out, "%s\n", data)
+	} else {
+		_, err = fmt.Fprintf(d.out, "%s  %s\n", e.Detected.Format("2006-01-02 15:04"), paint(statusStyle(e.Status), e.Message))
+	}
+	if err != nil {
+		return err
+	}
+
+	if d.webhook != "" {
+		if err := d.postWebhook(ctx, data); err != nil {
+			fmt.Fprintf(d.errOut, "webhook: %v\n", err)
+		}
+	}
+	if d.exec != "" {
+		if err := d.runCommand(ctx, e, data); err != nil {
+			fmt.Fprintf(d.errOut, "exec: %v\n", err)
+		}
+	}
+	return nil
+}
+
+func (d eventDelivery) postWebhook(ctx context.Context, data []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.webhook, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := d.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s returned %s", d.webhook, resp.Status)
+	}
+	return nil
+}
+
+func (d eventDelivery) runCommand(ctx context.Context, e costco.ActivityEvent, data []byte) error {
+	cmd := exec.CommandContext(ctx, "sh", "-c", d.exec)
+	cmd.Stdin = bytes.NewReader(data)
+	cmd.Stdout = d.out
+	cmd.Stderr = d.errOut
+	cmd.Env = append(os.Environ(), "COSTCO_EVENT_KIND="+e.Kind, "COSTCO_EVENT_MESSAGE="+e.Message)
+	return cmd.Run()
+}
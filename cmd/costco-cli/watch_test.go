@@ -0,0 +1,65 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testEvent() costco.ActivityEvent {
+	return costco.ActivityEvent{
+		Kind:     costco.ActivityStatusChange,
+		Detected: time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC),
+		Message:  "Order #O1 TV: Shipped -> Delivered",
+		Status:   "Delivered",
+	}
+}
+
+func TestEventDelivery(t *testing.T) {
+	var posted costco.ActivityEvent
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&posted))
+	}))
+	defer server.Close()
+
+	var out, errOut bytes.Buffer
+	d := eventDelivery{
+		out: &out, errOut: &errOut, webhook: server.URL, http: server.Client(),
+		exec: `printf '%s|' "$COSTCO_EVENT_KIND"; cat`,
+	}
+	require.NoError(t, d.send(context.Background(), testEvent()))
+
+	assert.Equal(t, "Delivered", posted.Status)
+	assert.Empty(t, errOut.String())
+	lines := out.String()
+	assert.Contains(t, lines, "2025-03-01 09:30  Order #O1 TV: Shipped -> Delivered\n")
+	assert.Contains(t, lines, `status_change|{"kind":"status_change"`)
+}
+
+func TestEventDelivery_JSONAndFailures(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	var out, errOut bytes.Buffer
+	d := eventDelivery{out: &out, errOut: &errOut, json: true, webhook: server.URL, http: server.Client(), exec: "exit 3"}
+	require.NoError(t, d.send(context.Background(), testEvent()), "failed deliveries don't stop watching")
+
+	var printed costco.ActivityEvent
+	require.NoError(t, json.Unmarshal(out.Bytes(), &printed))
+	assert.Equal(t, costco.ActivityStatusChange, printed.Kind)
+	assert.Contains(t, errOut.String(), "webhook: "+server.URL+" returned 502 Bad Gateway")
+	assert.Contains(t, errOut.String(), "exec: exit status 3")
+}
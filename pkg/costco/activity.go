@@ -0,0 +1,199 @@
+package costco
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// Polling for new receipts and online order status changes
+
+// DefaultActivityInterval is how often PollActivity checks for new activity.
+const DefaultActivityInterval = time.Hour
+
+// DefaultActivityLookbackDays is how many days of receipts and online orders
+// PollActivity compares between polls.
+const DefaultActivityLookbackDays = 30
+
+// Activity event kinds for ActivityEvent.Kind
+const (
+	ActivityNewReceipt   = "new_receipt"   // A receipt posted
+	ActivityNewOrder     = "new_order"     // An online order was placed
+	ActivityStatusChange = "status_change" // An online order line or its shipment changed status
+)
+
+// ActivityEvent is one change PollActivity detected.
+type ActivityEvent struct {
+	Kind     string                `json:"kind"`
+	Detected time.Time             `json:"detected"`
+	Message  string                `json:"message"` // One-line summary, e.g. for notifications
+	Receipt  *TransactionWithItems `json:"receipt,omitempty"`
+	Order    *OnlineOrder          `json:"order,omitempty"`
+
+	// Set for ActivityStatusChange
+	Item           *OrderLineItem `json:"item,omitempty"`
+	PreviousStatus string         `json:"previousStatus,omitempty"`
+	Status         string         `json:"status,omitempty"`
+}
+
+// ActivityOptions configures PollActivity.
+type ActivityOptions struct {
+	Interval     time.Duration // Time between polls (default: 1h)
+	LookbackDays int           // Days of receipts and orders compared (default: 30)
+}
+
+// activityState is what the previous poll saw.
+type activityState struct {
+	receipts map[string]bool
+	orders   map[string]bool
+	statuses map[string]string // Order line key -> lineStatus
+}
+
+// PollActivity checks for new receipts, new online orders, and online order status
+// changes every Interval until ctx is done, calling emit for each change. The first
+// poll only records what exists, so nothing already there is reported. An error from
+// emit stops polling and is returned; a failed poll is logged and retried at the next
+// interval.
+//
+// Receipts follow the client's document filters; order statuses use the most specific
+// status available (carrier tracking event, shipment status, or line status).
+//
+// PollActivity blocks; it returns ctx.Err() when ctx is done.
+//
+// Example:
+//
+//	err := client.PollActivity(ctx, costco.ActivityOptions{Interval: time.Hour},
+//	    func(e costco.ActivityEvent) error {
+//	        fmt.Println(e.Message)
+//	        return nil
+//	    })
+func (c *Client) PollActivity(ctx context.Context, opts ActivityOptions, emit func(ActivityEvent) error) error {
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultActivityInterval
+	}
+	if opts.LookbackDays <= 0 {
+		opts.LookbackDays = DefaultActivityLookbackDays
+	}
+
+	var state *activityState
+	ticker := time.NewTicker(opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		next, events, err := c.pollActivity(ctx, state, opts.LookbackDays)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			c.getLogger().Warn("activity poll failed", slog.String("error", err.Error()))
+		} else {
+			state = next
+			c.getLogger().Debug("activity poll complete", slog.Int("events", len(events)))
+			for _, event := range events {
+				if err := emit(event); err != nil {
+					return err
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// pollActivity fetches the lookback window and compares it with the previous state,
+// which is nil on the first poll.
+func (c *Client) pollActivity(ctx context.Context, previous *activityState, lookbackDays int) (*activityState, []ActivityEvent, error) {
+	now := time.Now()
+	startDate := now.AddDate(0, 0, -lookbackDays).Format("2006-01-02")
+	endDate := now.Format("2006-01-02")
+
+	transactions, err := c.GetAllTransactionItems(ctx, startDate, endDate)
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting receipts: %w", err)
+	}
+	orders, err := c.getAllOnlineOrders(ctx, startDate, endDate)
+	if err != nil {
+		return nil, nil, err
+	}
+	state, events := diffActivity(previous, transactions, orders, now)
+	return state, events, nil
+}
+
+// diffActivity builds the state for transactions and orders and the events that
+// happened since previous.
+func diffActivity(previous *activityState, transactions []TransactionWithItems, orders []OnlineOrder, now time.Time) (*activityState, []ActivityEvent) {
+	state := &activityState{
+		receipts: make(map[string]bool, len(transactions)),
+		orders:   make(map[string]bool, len(orders)),
+		statuses: make(map[string]string),
+	}
+	var events []ActivityEvent
+
+	for i := range transactions {
+		tx := &transactions[i]
+		state.receipts[tx.Key()] = true
+		if previous != nil && !previous.receipts[tx.Key()] {
+			events = append(events, ActivityEvent{
+				Kind:     ActivityNewReceipt,
+				Detected: now,
+				Message: fmt.Sprintf("New receipt: %s #%d, %.2f on %s", tx.WarehouseName, tx.WarehouseNumber,
+					tx.Total, tx.TransactionDate.Format("2006-01-02")),
+				Receipt: tx,
+			})
+		}
+	}
+
+	for i := range orders {
+		order := &orders[i]
+		state.orders[order.OrderNumber] = true
+		isNew := previous != nil && !previous.orders[order.OrderNumber]
+		if isNew {
+			events = append(events, ActivityEvent{
+				Kind:     ActivityNewOrder,
+				Detected: now,
+				Message:  fmt.Sprintf("New online order #%s: %.2f, %d items", order.OrderNumber, order.OrderTotal, len(order.OrderLineItems)),
+				Order:    order,
+			})
+		}
+
+		for j := range order.OrderLineItems {
+			item := &order.OrderLineItems[j]
+			key := fmt.Sprintf("%s/%s/%d", order.OrderNumber, item.OrderLineItemID, item.LineNumber)
+			status := lineStatus(item)
+			state.statuses[key] = status
+			if previous == nil || isNew {
+				continue
+			}
+			if before, seen := previous.statuses[key]; seen && before != status {
+				events = append(events, ActivityEvent{
+					Kind:           ActivityStatusChange,
+					Detected:       now,
+					Message:        fmt.Sprintf("Order #%s %s: %s -> %s", order.OrderNumber, item.ItemDescription, before, status),
+					Order:          order,
+					Item:           item,
+					PreviousStatus: before,
+					Status:         status,
+				})
+			}
+		}
+	}
+	return state, events
+}
+
+// lineStatus is the most specific status known for an order line.
+func lineStatus(item *OrderLineItem) string {
+	if shipment := item.Shipment; shipment != nil {
+		if shipment.TrackingEvent != nil && shipment.TrackingEvent.Event != "" {
+			return shipment.TrackingEvent.Event
+		}
+		if shipment.Status != "" {
+			return shipment.Status
+		}
+	}
+	return item.Status
+}
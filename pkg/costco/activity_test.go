@@ -0,0 +1,87 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffActivity(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	receipt := func(barcode string) TransactionWithItems {
+		return TransactionWithItems{TransactionBarcode: barcode, WarehouseName: "LYNNWOOD", WarehouseNumber: 1, Total: 42}
+	}
+	order := func(number, status string, shipment *Shipment) OnlineOrder {
+		return OnlineOrder{OrderNumber: number, OrderTotal: 99, OrderLineItems: []OrderLineItem{
+			{OrderLineItemID: "L1", LineNumber: 1, ItemDescription: "TV", Status: status, Shipment: shipment},
+		}}
+	}
+
+	state, events := diffActivity(nil, []TransactionWithItems{receipt("R1")}, []OnlineOrder{order("O1", "Ordered", nil)}, now)
+	assert.Empty(t, events, "first poll is the baseline")
+
+	shipped := &Shipment{Status: "Shipped", TrackingEvent: &TrackingEvent{Event: "Out for Delivery"}}
+	_, events = diffActivity(state,
+		[]TransactionWithItems{receipt("R1"), receipt("R2")},
+		[]OnlineOrder{order("O1", "Shipped", shipped), order("O2", "Ordered", nil)}, now)
+	require.Len(t, events, 3)
+
+	assert.Equal(t, ActivityNewReceipt, events[0].Kind)
+	assert.Equal(t, "R2", events[0].Receipt.TransactionBarcode)
+	assert.Equal(t, now, events[0].Detected)
+
+	assert.Equal(t, ActivityStatusChange, events[1].Kind)
+	assert.Equal(t, "Ordered", events[1].PreviousStatus)
+	assert.Equal(t, "Out for Delivery", events[1].Status)
+	assert.Equal(t, "Order #O1 TV: Ordered -> Out for Delivery", events[1].Message)
+
+	assert.Equal(t, ActivityNewOrder, events[2].Kind)
+	assert.Equal(t, "O2", events[2].Order.OrderNumber)
+}
+
+func TestPollActivity(t *testing.T) {
+	var mu sync.Mutex
+	status := "Ordered"
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if req.Query == OnlineOrdersQuery {
+			mu.Lock()
+			orders := []map[string]interface{}{{
+				"orderNumber": "O1", "orderPlacedDate": "2025-01-20",
+				"orderLineItems": []map[string]interface{}{{"orderLineItemId": "L1", "itemDescription": "TV", "status": status}},
+			}}
+			status = "Delivered"
+			mu.Unlock()
+			writeGraphQLData(w, map[string]interface{}{
+				"getOnlineOrders": []interface{}{map[string]interface{}{
+					"pageNumber": 1, "totalNumberOfRecords": 1, "bcOrders": orders,
+				}},
+			})
+			return
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{}},
+		})
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var got []ActivityEvent
+	err := client.PollActivity(ctx, ActivityOptions{Interval: 10 * time.Millisecond}, func(e ActivityEvent) error {
+		got = append(got, e)
+		cancel()
+		return nil
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+	require.Len(t, got, 1)
+	assert.Equal(t, ActivityStatusChange, got[0].Kind)
+	assert.Equal(t, "Delivered", got[0].Status)
+}
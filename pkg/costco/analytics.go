@@ -9,25 +9,108 @@ import "time"
 type TransactionWithItems struct {
 	TransactionBarcode string
 	TransactionDate    time.Time
+	TransactionType    string // e.g. "Sales", "Refund"
 	WarehouseName      string
+	WarehouseNumber    int
+	WarehouseCity      string
 	Total              float64
+	Taxes              float64
+	InstantSavings     float64
+	CouponSavings      float64   // Savings from redeemed coupons, as a positive amount
+	TaxLines           []TaxLine // Tax breakdown from the receipt's SubTaxes
+	Tenders            []Tender  // How the receipt was paid
 	Items              []ReceiptItem
 	MembershipNumber   string
+	Currency           string            // Currency code from the configured locale ("" if unset)
+	Source             string            // TransactionSourceReceipt, TransactionSourceBusinessDelivery, ...
+	DocumentType       string            // Receipt detail type: DocumentTypeWarehouse, DocumentTypeFuel, or DocumentTypeCarWash
+	Cardholder         *Cardholder       // Who made the purchase; set by AssignCardholders
+	Tags               []string          `json:",omitempty"` // Local tags; see TagTransaction
+	Note               string            `json:",omitempty"` // Local note on the receipt; see SetNote
+	ItemNotes          map[string]string `json:",omitempty"` // Local notes on items, by item number; see SetNote
 }
 
+// TransactionTypeRefund marks a return receipt in TransactionType
+const TransactionTypeRefund = "Refund"
+
+// IsRefund reports whether the transaction is a return: a "Refund" receipt or one with a negative total.
+func (tx *TransactionWithItems) IsRefund() bool {
+	return tx.TransactionType == TransactionTypeRefund || tx.Total < 0
+}
+
+// Key returns the transaction's canonical identity, its barcode and date/time. The same
+// receipt fetched by overlapping date ranges or repeated syncs always has the same key.
+func (tx *TransactionWithItems) Key() string {
+	return tx.TransactionBarcode + "@" + tx.TransactionDate.Format("2006-01-02T15:04:05")
+}
+
+// DedupeTransactions drops repeats of the same transaction (by Key), keeping the first
+// occurrence. Use it when combining the results of overlapping date ranges.
+//
+// Example:
+//
+//	q1, _ := client.GetAllTransactionItems(ctx, "2025-01-01", "2025-03-31")
+//	march, _ := client.GetAllTransactionItems(ctx, "2025-03-01", "2025-04-30")
+//	all := costco.DedupeTransactions(append(q1, march...))
+func DedupeTransactions(transactions []TransactionWithItems) []TransactionWithItems {
+	seen := make(map[string]bool, len(transactions))
+	unique := make([]TransactionWithItems, 0, len(transactions))
+	for _, tx := range transactions {
+		key := tx.Key()
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		unique = append(unique, tx)
+	}
+	return unique
+}
+
+// Transaction sources for TransactionWithItems.Source
+const (
+	TransactionSourceReceipt          = "receipt"
+	TransactionSourceBusinessDelivery = "business_delivery"
+	TransactionSourcePhotoCenter      = "photo_center" // With Config.IncludeServiceOrders
+	TransactionSourceOptical          = "optical"      // With Config.IncludeServiceOrders
+	TransactionSourceOnline           = "online"       // Costco.com orders, outside GetAllTransactionItems
+)
+
 // ItemPurchase represents a single purchase instance of an item.
 // This is returned by GetItemHistory to show when and how an item was bought.
 type ItemPurchase struct {
-	Date     string  // Purchase date in YYYY-MM-DD format
-	Quantity int     // Number of units purchased
-	Price    float64 // Total price for this purchase
-	Barcode  string  // Receipt barcode for this transaction
+	Date      string   // Purchase date in YYYY-MM-DD format
+	Quantity  int      // Number of units purchased
+	Price     float64  // Total price for this purchase
+	Discount  float64  // Instant savings applied to this purchase (negative, 0 if none)
+	Barcode   string   // Receipt barcode for this transaction
+	Warehouse string   // Warehouse where the item was bought
+	Size      ItemSize // Pack size parsed from the description (zero if none)
+}
+
+// ItemPricePoint is the unit price paid for an item on one purchase.
+type ItemPricePoint struct {
+	Date      string    // Purchase date in YYYY-MM-DD format
+	UnitPrice float64   // (Price + Discount) / Quantity
+	Quantity  int       // Number of units purchased
+	Warehouse string    // Warehouse where this price was seen
+	Barcode   string    // Receipt barcode for this transaction
+	SizePrice SizePrice // UnitPrice per unit of pack size (zero if the description has no size)
+}
+
+// ItemPriceHistory represents the unit price of an item over time.
+// This is returned by GetItemPriceHistory.
+type ItemPriceHistory struct {
+	ItemNumber string
+	Points     []ItemPricePoint // Chronological
+	Min        float64          // Lowest unit price paid
+	Max        float64          // Highest unit price paid
+	Average    float64          // Average unit price, weighted by quantity
 }
 
 // SpendingByDepartment represents spending statistics for a single department.
 // This is returned by GetSpendingSummary, keyed by department number.
 type SpendingByDepartment struct {
-	Department string  // Department name (e.g., "Department 42")
+	Department string  // Department name from DepartmentName (e.g., "Produce")
 	Total      float64 // Total spending in this department
 	ItemCount  int     // Total number of items purchased in this department
 }
@@ -41,3 +124,32 @@ type FrequentItem struct {
 	TotalSpent      float64 // Total amount spent on this item
 	PurchaseCount   int     // Number of times this item was purchased
 }
+
+// BuyAgainItem represents an online-order item that Costco marks as eligible to re-order.
+// This is returned by GetBuyAgainItems; pass ItemID to AddToCart.
+type BuyAgainItem struct {
+	ItemID          string // Catalog item ID for AddToCart
+	ItemNumber      string // Costco item number
+	ItemDescription string // Item name/description
+	OrderCount      int    // Number of orders the item appeared on
+	LastOrderedDate string // Date of the most recent order containing the item
+	LastOrderNumber string // Most recent order containing the item
+}
+
+// PriceAdjustmentWindowDays is how long after purchase Costco honors a price drop.
+const PriceAdjustmentWindowDays = 30
+
+// PriceAdjustment is a recently purchased item that now sells for less than was paid.
+// This is returned by FindPriceAdjustmentOpportunities.
+type PriceAdjustment struct {
+	ItemNumber         string
+	ItemDescription    string
+	TransactionBarcode string // Receipt to bring to the membership counter
+	WarehouseName      string
+	PurchaseDate       time.Time
+	Quantity           int
+	PaidUnitPrice      float64   // Unit price paid, net of instant savings
+	CurrentPrice       float64   // Current price from GetItemPrice
+	Refund             float64   // Potential refund: price drop x quantity
+	Deadline           time.Time // Last day the adjustment can be claimed
+}
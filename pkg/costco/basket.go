@@ -0,0 +1,129 @@
+package costco
+
+import (
+	"context"
+	"sort"
+)
+
+// Market-basket co-purchase analysis
+
+// DefaultMinTogether is the minimum number of shared trips GetCoPurchases reports when none is given.
+const DefaultMinTogether = 2
+
+// CoPurchase is a pair of items bought on the same trip.
+// This is returned by GetCoPurchases.
+type CoPurchase struct {
+	ItemA        BasketItem
+	ItemB        BasketItem
+	Together     int     // Trips with both items
+	Support      float64 // Together / all trips
+	ConfidenceAB float64 // Share of trips with A that also had B
+	ConfidenceBA float64 // Share of trips with B that also had A
+	Lift         float64 // Support / (support of A x support of B); above 1 means linked
+}
+
+// BasketItem identifies an item in a CoPurchase, with how many trips included it.
+type BasketItem struct {
+	ItemNumber      string
+	ItemDescription string
+	Trips           int
+}
+
+// GetCoPurchases finds pairs of items that are frequently bought together on
+// warehouse trips, with support, confidence, and lift for each pair. Pairs bought
+// together on fewer than minTogether trips are dropped (zero or less uses
+// DefaultMinTogether). Results are sorted by Together, then Lift, then item numbers.
+//
+// The startDate and endDate should be in YYYY-MM-DD format.
+//
+// Example:
+//
+//	pairs, err := client.GetCoPurchases(ctx, "2025-01-01", "2025-12-31", 3)
+//	for _, p := range pairs[:min(10, len(pairs))] {
+//	    fmt.Printf("%s + %s: %d trips, %.0f%% of %s trips\n", p.ItemA.ItemDescription,
+//	        p.ItemB.ItemDescription, p.Together, p.ConfidenceAB*100, p.ItemA.ItemDescription)
+//	}
+func (c *Client) GetCoPurchases(ctx context.Context, startDate, endDate string, minTogether int) ([]CoPurchase, error) {
+	if minTogether <= 0 {
+		minTogether = DefaultMinTogether
+	}
+
+	transactions, err := c.GetAllTransactionItems(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make(map[string]*BasketItem)
+	pairs := make(map[[2]string]int)
+	trips := 0
+
+	for _, tx := range transactions {
+		if tx.IsRefund() || tx.DocumentType == DocumentTypeFuel || tx.DocumentType == DocumentTypeCarWash {
+			continue
+		}
+
+		// Distinct items on this trip, in a stable order for pair keys
+		var basket []string
+		seen := make(map[string]bool)
+		for _, item := range tx.Items {
+			if item.IsDiscount() || item.Unit <= 0 || item.ItemNumber == "" || seen[item.ItemNumber] {
+				continue
+			}
+			seen[item.ItemNumber] = true
+			basket = append(basket, item.ItemNumber)
+			if items[item.ItemNumber] == nil {
+				items[item.ItemNumber] = &BasketItem{
+					ItemNumber:      item.ItemNumber,
+					ItemDescription: c.itemName(item),
+				}
+			}
+			items[item.ItemNumber].Trips++
+		}
+		if len(basket) == 0 {
+			continue
+		}
+		trips++
+
+		sort.Strings(basket)
+		for i := range basket {
+			for j := i + 1; j < len(basket); j++ {
+				pairs[[2]string{basket[i], basket[j]}]++
+			}
+		}
+	}
+
+	var result []CoPurchase
+	for pair, together := range pairs {
+		if together < minTogether {
+			continue
+		}
+		a, b := items[pair[0]], items[pair[1]]
+		support := float64(together) / float64(trips)
+		supportA := float64(a.Trips) / float64(trips)
+		supportB := float64(b.Trips) / float64(trips)
+		result = append(result, CoPurchase{
+			ItemA:        *a,
+			ItemB:        *b,
+			Together:     together,
+			Support:      roundTo(support, 3),
+			ConfidenceAB: roundTo(float64(together)/float64(a.Trips), 3),
+			ConfidenceBA: roundTo(float64(together)/float64(b.Trips), 3),
+			Lift:         roundTo(support/(supportA*supportB), 2),
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Together != result[j].Together {
+			return result[i].Together > result[j].Together
+		}
+		if result[i].Lift != result[j].Lift {
+			return result[i].Lift > result[j].Lift
+		}
+		if result[i].ItemA.ItemNumber != result[j].ItemA.ItemNumber {
+			return result[i].ItemA.ItemNumber < result[j].ItemA.ItemNumber
+		}
+		return result[i].ItemB.ItemNumber < result[j].ItemB.ItemNumber
+	})
+
+	return result, nil
+}
@@ -0,0 +1,80 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newBasketTestClient serves one receipt per basket, R0..Rn, with the given item numbers.
+func newBasketTestClient(t *testing.T, baskets [][]string, names map[string]string) *Client {
+	return newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if barcode, ok := req.Variables["barcode"].(string); ok {
+			var index int
+			fmt.Sscanf(barcode, "R%d", &index)
+			var items []map[string]interface{}
+			for _, number := range baskets[index] {
+				items = append(items, map[string]interface{}{"itemNumber": number, "itemDescription01": names[number], "unit": 1, "amount": 5.00})
+			}
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{map[string]interface{}{
+					"transactionBarcode": barcode, "transactionDateTime": "2025-01-10T10:00:00", "itemArray": items,
+				}}},
+			})
+			return
+		}
+		var receipts []map[string]interface{}
+		for i := range baskets {
+			receipts = append(receipts, map[string]interface{}{"transactionBarcode": fmt.Sprintf("R%d", i)})
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{"receipts": receipts},
+		})
+	})
+}
+
+func TestGetCoPurchases(t *testing.T) {
+	baskets := [][]string{{"1", "2", "3"}, {"1", "2"}, {"1", "2", "4"}, {"3", "4"}, {"1"}}
+	names := map[string]string{"1": "CHIPS", "2": "SALSA", "3": "MILK", "4": "BREAD"}
+	client := newBasketTestClient(t, baskets, names)
+
+	pairs, err := client.GetCoPurchases(context.Background(), "2025-01-01", "2025-01-31", 0)
+	require.NoError(t, err)
+	require.Len(t, pairs, 1)
+
+	pair := pairs[0]
+	assert.Equal(t, "Chips", pair.ItemA.ItemDescription)
+	assert.Equal(t, 4, pair.ItemA.Trips)
+	assert.Equal(t, "Salsa", pair.ItemB.ItemDescription)
+	assert.Equal(t, 3, pair.Together)
+	assert.Equal(t, 0.6, pair.Support)
+	assert.Equal(t, 0.75, pair.ConfidenceAB)
+	assert.Equal(t, 1.0, pair.ConfidenceBA)
+	assert.Equal(t, 1.25, pair.Lift)
+
+	pairs, err = client.GetCoPurchases(context.Background(), "2025-01-01", "2025-01-31", 1)
+	require.NoError(t, err)
+	assert.Len(t, pairs, 6)
+}
+
+func TestGetCoPurchases_TieBreak(t *testing.T) {
+	// Both pairs tie on Together and Lift; "1"+"9" sorts after "10"+"2" as one string
+	baskets := [][]string{{"10", "2"}, {"1", "9"}}
+	names := map[string]string{"1": "CHIPS", "2": "SALSA", "9": "MILK", "10": "BREAD"}
+	client := newBasketTestClient(t, baskets, names)
+
+	pairs, err := client.GetCoPurchases(context.Background(), "2025-01-01", "2025-01-31", 1)
+	require.NoError(t, err)
+	require.Len(t, pairs, 2)
+	assert.Equal(t, "1", pairs[0].ItemA.ItemNumber)
+	assert.Equal(t, "9", pairs[0].ItemB.ItemNumber)
+	assert.Equal(t, "10", pairs[1].ItemA.ItemNumber)
+	assert.Equal(t, "2", pairs[1].ItemB.ItemNumber)
+}
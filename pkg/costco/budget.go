@@ -0,0 +1,144 @@
+package costco
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Spending budgets by department or group of departments
+
+// BudgetTotal is the budget category covering all spending.
+const BudgetTotal = "total"
+
+// budgetGroups are the built-in budget categories spanning several departments.
+var budgetGroups = map[string][]int{
+	"groceries": {11, 13, 17, 18, 53, 61, 63, 64, 65}, // Food and snacks, fresh and packaged
+	"household": {14, 20, 21, 25, 41},                 // Sundries, paper goods, pet supplies, housewares, and domestics
+}
+
+// BudgetStatus is spending against one budget over a period.
+// This is returned by GetBudgetStatus.
+type BudgetStatus struct {
+	Category  string  // Budget category, e.g. "groceries" or "produce"
+	Budget    float64 // Budgeted amount
+	Spent     float64 // Spending in the category's departments, net of refunds
+	Remaining float64 // Budget minus Spent; negative when over budget
+	Percent   float64 // Spent as a percentage of Budget
+	Over      bool    // Spent exceeds Budget
+}
+
+// BudgetCategory checks a budget category name and returns its canonical form.
+// A category is BudgetTotal, a built-in group ("groceries", "household"), or a
+// department name, matched ignoring case and punctuation: "beer wine spirits" is
+// "Beer, Wine & Spirits" and is returned as "beer, wine & spirits".
+func BudgetCategory(name string) (string, error) {
+	key := strings.ToLower(strings.TrimSpace(name))
+	if key == BudgetTotal {
+		return key, nil
+	}
+	if _, ok := budgetGroups[key]; ok {
+		return key, nil
+	}
+	for _, department := range departmentNumbers() {
+		departmentName := DepartmentName(department)
+		if budgetKey(departmentName) == budgetKey(key) {
+			return strings.ToLower(departmentName), nil
+		}
+	}
+	return "", fmt.Errorf("unknown budget category %q: use %s, %s, or a department name such as produce",
+		name, BudgetTotal, strings.Join(BudgetGroups(), ", "))
+}
+
+// BudgetGroups returns the names of the built-in budget groups, sorted.
+func BudgetGroups() []string {
+	groups := make([]string, 0, len(budgetGroups))
+	for group := range budgetGroups {
+		groups = append(groups, group)
+	}
+	sort.Strings(groups)
+	return groups
+}
+
+// budgetKey reduces a category or department name to its letters and digits.
+func budgetKey(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, name)
+}
+
+// inBudget reports whether a department's spending counts toward a canonical category.
+func inBudget(category string, department int) bool {
+	if category == BudgetTotal {
+		return true
+	}
+	if group, ok := budgetGroups[category]; ok {
+		for _, d := range group {
+			if d == department {
+				return true
+			}
+		}
+		return false
+	}
+	return budgetKey(DepartmentName(department)) == budgetKey(category)
+}
+
+// GetBudgetStatus compares spending between startDate and endDate (YYYY-MM-DD) with
+// each budget, keyed by category (see BudgetCategory). Spending comes from
+// GetSpendingSummary. The result is sorted by category, with BudgetTotal last.
+//
+// Example:
+//
+//	stored, _ := costco.LoadConfig()
+//	statuses, err := client.GetBudgetStatus(ctx, "2025-02-01", "2025-02-28", stored.Budgets)
+//	for _, status := range statuses {
+//	    fmt.Printf("%s: $%.2f of $%.2f (%.0f%%)\n", status.Category, status.Spent, status.Budget, status.Percent)
+//	}
+func (c *Client) GetBudgetStatus(ctx context.Context, startDate, endDate string, budgets map[string]float64) ([]BudgetStatus, error) {
+	statuses := make([]BudgetStatus, 0, len(budgets))
+	for name, amount := range budgets {
+		category, err := BudgetCategory(name)
+		if err != nil {
+			return nil, err
+		}
+		if amount <= 0 {
+			return nil, fmt.Errorf("budget for %s must be positive, got %.2f", category, amount)
+		}
+		statuses = append(statuses, BudgetStatus{Category: category, Budget: amount})
+	}
+	if len(statuses) == 0 {
+		return statuses, nil
+	}
+
+	summary, err := c.GetSpendingSummary(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range statuses {
+		status := &statuses[i]
+		for department, spending := range summary {
+			if inBudget(status.Category, department) {
+				status.Spent += spending.Total
+			}
+		}
+		status.Spent = roundTo(status.Spent, 2)
+		status.Remaining = roundTo(status.Budget-status.Spent, 2)
+		status.Percent = status.Spent / status.Budget * 100
+		status.Over = status.Spent > status.Budget
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		a, b := statuses[i].Category, statuses[j].Category
+		if (a == BudgetTotal) != (b == BudgetTotal) {
+			return b == BudgetTotal
+		}
+		return a < b
+	})
+	return statuses, nil
+}
@@ -0,0 +1,70 @@
+package costco
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudgetCategory(t *testing.T) {
+	for name, want := range map[string]string{
+		"Groceries":         "groceries",
+		" total ":           BudgetTotal,
+		"produce":           "produce",
+		"beer wine spirits": "beer, wine & spirits",
+		"photo center":      "photo center",
+	} {
+		got, err := BudgetCategory(name)
+		require.NoError(t, err, name)
+		assert.Equal(t, want, got, name)
+	}
+
+	_, err := BudgetCategory("vacations")
+	assert.ErrorContains(t, err, `unknown budget category "vacations"`)
+}
+
+func TestGetBudgetStatus(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusNotFound) // No photo or optical orders
+			return
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{"receipts": []map[string]interface{}{{
+				"transactionBarcode":  "R1",
+				"transactionDateTime": "2025-01-10T10:00:00",
+				"total":               45.00,
+				"itemArray": []map[string]interface{}{
+					{"itemNumber": "1", "itemDescription01": "EGGS", "itemDepartmentNumber": 17, "unit": 1, "amount": 20.00},
+					{"itemNumber": "2", "itemDescription01": "APPLES", "itemDepartmentNumber": 53, "unit": 1, "amount": 15.00},
+					{"itemNumber": "3", "itemDescription01": "TOWELS", "itemDepartmentNumber": 20, "unit": 1, "amount": 10.00},
+				},
+			}}},
+		})
+	})
+
+	statuses, err := client.GetBudgetStatus(context.Background(), "2025-01-01", "2025-01-31", map[string]float64{
+		"groceries": 30, "Frozen Foods": 50, "household": 20, "total": 100,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []BudgetStatus{
+		{Category: "frozen foods", Budget: 50, Spent: 20, Remaining: 30, Percent: 40},
+		{Category: "groceries", Budget: 30, Spent: 35, Remaining: -5, Percent: 35.0 / 30 * 100, Over: true},
+		{Category: "household", Budget: 20, Spent: 10, Remaining: 10, Percent: 50},
+		{Category: BudgetTotal, Budget: 100, Spent: 45, Remaining: 55, Percent: 45},
+	}, statuses)
+
+	_, err = client.GetBudgetStatus(context.Background(), "2025-01-01", "2025-01-31", map[string]float64{"groceries": -5})
+	assert.ErrorContains(t, err, "must be positive")
+}
+
+func TestStoredConfigValidate_Budgets(t *testing.T) {
+	config := &StoredConfig{Email: "a@example.com", WarehouseNumber: "847", Budgets: map[string]float64{"vacations": 100, "produce": 0}}
+	err := config.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown budget category "vacations"`)
+	assert.Contains(t, err.Error(), "produce: 0.00 must be positive")
+}
@@ -0,0 +1,95 @@
+package costco
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testBusinessOrdersJSON = `{"orders": [
+	{
+		"orderNumber": "BD-100",
+		"orderDate": "2025-01-10T09:30:00",
+		"status": "Delivered",
+		"businessCenterName": "Lynnwood Business Center",
+		"total": 212.40,
+		"membershipNumber": "111222333",
+		"items": [
+			{"itemNumber": "7950", "description": "Coffee Cups 1000ct", "departmentNumber": 14, "quantity": 2, "unitPrice": 56.20, "amount": 112.40},
+			{"itemNumber": "1234", "description": "Napkins", "departmentNumber": 14, "quantity": 1, "unitPrice": 100.00, "amount": 100.00}
+		]
+	}
+]}`
+
+func TestGetBusinessDeliveryOrders(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/ebusiness/business-delivery/v1/orders", r.URL.Path)
+		assert.Equal(t, "2025-01-01", r.URL.Query().Get("startDate"))
+		w.Write([]byte(testBusinessOrdersJSON))
+	})
+
+	orders, err := client.GetBusinessDeliveryOrders(context.Background(), "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+
+	tx := orders[0].Transaction()
+	assert.Equal(t, "BD-100", tx.TransactionBarcode)
+	assert.Equal(t, time.Date(2025, 1, 10, 9, 30, 0, 0, time.UTC), tx.TransactionDate)
+	assert.Equal(t, "Lynnwood Business Center", tx.WarehouseName)
+	assert.Equal(t, TransactionSourceBusinessDelivery, tx.Source)
+	require.Len(t, tx.Items, 2)
+	assert.Equal(t, "Coffee Cups 1000ct", tx.Items[0].ItemDescription01)
+	assert.Equal(t, 2, tx.Items[0].Unit)
+	assert.Equal(t, 14, tx.Items[0].ItemDepartmentNumber)
+}
+
+func TestGetAllTransactionItems_IncludesBusinessDelivery(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/ebusiness/business-delivery/v1/orders" {
+			w.Write([]byte(testBusinessOrdersJSON))
+			return
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{}},
+		})
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		client := newMockClient(t, Config{}, handler)
+		transactions, err := client.GetAllTransactionItems(context.Background(), "2025-01-01", "2025-01-31")
+		require.NoError(t, err)
+		assert.Empty(t, transactions)
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		client := newMockClient(t, Config{IncludeBusinessDelivery: true}, handler)
+		transactions, err := client.GetAllTransactionItems(context.Background(), "2025-01-01", "2025-01-31")
+		require.NoError(t, err)
+		require.Len(t, transactions, 1)
+		assert.Equal(t, TransactionSourceBusinessDelivery, transactions[0].Source)
+
+		summary, err := client.GetSpendingSummary(context.Background(), "2025-01-01", "2025-01-31")
+		require.NoError(t, err)
+		assert.Equal(t, 212.40, summary[14].Total)
+		assert.Equal(t, 3, summary[14].ItemCount)
+	})
+}
+
+func TestGetAllTransactionItems_BusinessDeliveryError(t *testing.T) {
+	client := newMockClient(t, Config{IncludeBusinessDelivery: true}, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/ebusiness/business-delivery/v1/orders" {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{}},
+		})
+	})
+
+	_, err := client.GetAllTransactionItems(context.Background(), "2025-01-01", "2025-01-31")
+	assert.ErrorContains(t, err, "business delivery")
+}
@@ -0,0 +1,132 @@
+package costco
+
+import (
+	"fmt"
+	"time"
+)
+
+// Fiscal calendars: custom month start days, biweekly periods, and fiscal years
+
+// defaultBiweeklyAnchor is the Monday biweekly periods are counted from when
+// FiscalCalendar.BiweeklyAnchor is unset.
+var defaultBiweeklyAnchor = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// FiscalCalendar sets where report periods begin. The zero value is the calendar:
+// months start on the 1st and years in January.
+//
+// Set it on Config.Calendar and every helper that takes a ReportPeriod honors it.
+//
+// Example: paid on the 15th, fiscal year starting in October, every other Friday payday
+//
+//	client := costco.NewClient(costco.Config{Calendar: costco.FiscalCalendar{
+//	    MonthStartDay:  15,
+//	    YearStartMonth: time.October,
+//	    BiweeklyAnchor: time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC),
+//	}})
+type FiscalCalendar struct {
+	MonthStartDay  int        // Day of the month months begin on, 1-28 (default: 1)
+	YearStartMonth time.Month // First month of the fiscal year; quarters follow it (default: January)
+	BiweeklyAnchor time.Time  // Any day a biweekly period begins on (default: Monday, 2024-01-01)
+}
+
+// Label returns the name of the period containing t. Months are named after the month
+// they begin in ("2025-03" runs from March 15 to April 14 with MonthStartDay 15). Fiscal
+// years that don't start in January are named after the year they end in, as
+// "FY2026" and "FY2026-Q1"; biweekly periods are named after their first day.
+func (cal FiscalCalendar) Label(p ReportPeriod, t time.Time) string {
+	switch p {
+	case ReportPeriodDay:
+		return t.Format("2006-01-02")
+	case ReportPeriodWeek:
+		year, week := t.ISOWeek()
+		return fmt.Sprintf("%d-W%02d", year, week)
+	case ReportPeriodBiweekly:
+		return cal.Start(p, t).Format("2006-01-02")
+	case ReportPeriodQuarter:
+		year, offset := cal.fiscalMonth(t)
+		return fmt.Sprintf("%s-Q%d", cal.yearLabel(year), offset/3+1)
+	case ReportPeriodYear:
+		year, _ := cal.fiscalMonth(t)
+		return cal.yearLabel(year)
+	default:
+		return cal.monthStart(t).Format("2006-01")
+	}
+}
+
+// Start returns the first day of the period containing t, at midnight in t's location.
+func (cal FiscalCalendar) Start(p ReportPeriod, t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	switch p {
+	case ReportPeriodDay:
+		return day
+	case ReportPeriodWeek:
+		// Weekday is 0 on Sunday; ISO weeks start on Monday
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	case ReportPeriodBiweekly:
+		anchor := cal.BiweeklyAnchor
+		if anchor.IsZero() {
+			anchor = defaultBiweeklyAnchor
+		}
+		// Count whole days in UTC so daylight saving changes don't shift the boundary
+		days := int(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Sub(
+			time.Date(anchor.Year(), anchor.Month(), anchor.Day(), 0, 0, 0, 0, time.UTC)).Hours() / 24)
+		offset := ((days % 14) + 14) % 14
+		return day.AddDate(0, 0, -offset)
+	case ReportPeriodQuarter:
+		_, offset := cal.fiscalMonth(t)
+		return cal.monthStart(t).AddDate(0, -(offset % 3), 0)
+	case ReportPeriodYear:
+		_, offset := cal.fiscalMonth(t)
+		return cal.monthStart(t).AddDate(0, -offset, 0)
+	default:
+		return cal.monthStart(t)
+	}
+}
+
+// next returns the start of the period after the one starting at start.
+func (cal FiscalCalendar) next(p ReportPeriod, start time.Time) time.Time {
+	switch p {
+	case ReportPeriodDay:
+		return start.AddDate(0, 0, 1)
+	case ReportPeriodWeek:
+		return start.AddDate(0, 0, 7)
+	case ReportPeriodBiweekly:
+		return start.AddDate(0, 0, 14)
+	case ReportPeriodQuarter:
+		return start.AddDate(0, 3, 0)
+	case ReportPeriodYear:
+		return start.AddDate(1, 0, 0)
+	default:
+		return start.AddDate(0, 1, 0)
+	}
+}
+
+// monthStart returns the first day of the fiscal month containing t.
+func (cal FiscalCalendar) monthStart(t time.Time) time.Time {
+	startDay := max(cal.MonthStartDay, 1)
+	start := time.Date(t.Year(), t.Month(), startDay, 0, 0, 0, 0, t.Location())
+	if t.Day() < startDay {
+		start = start.AddDate(0, -1, 0)
+	}
+	return start
+}
+
+// fiscalMonth returns the calendar year the fiscal year containing t starts in, and
+// how many months into that fiscal year t falls (0-11).
+func (cal FiscalCalendar) fiscalMonth(t time.Time) (int, int) {
+	yearStart := cal.YearStartMonth
+	if yearStart == 0 {
+		yearStart = time.January
+	}
+	month := cal.monthStart(t)
+	offset := (int(month.Month()) - int(yearStart) + 12) % 12
+	return month.AddDate(0, -offset, 0).Year(), offset
+}
+
+// yearLabel names the fiscal year that starts in startYear.
+func (cal FiscalCalendar) yearLabel(startYear int) string {
+	if cal.YearStartMonth > time.January {
+		return fmt.Sprintf("FY%d", startYear+1)
+	}
+	return fmt.Sprintf("%d", startYear)
+}
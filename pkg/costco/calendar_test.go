@@ -0,0 +1,84 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFiscalCalendar(t *testing.T) {
+	day := func(year int, month time.Month, d int) time.Time {
+		return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+	}
+	cal := FiscalCalendar{MonthStartDay: 15, YearStartMonth: time.October, BiweeklyAnchor: day(2025, 1, 3)}
+
+	tests := []struct {
+		period    ReportPeriod
+		date      time.Time
+		wantLabel string
+		wantStart time.Time
+	}{
+		{ReportPeriodMonth, day(2025, 3, 20), "2025-03", day(2025, 3, 15)},
+		{ReportPeriodMonth, day(2025, 3, 14), "2025-02", day(2025, 2, 15)},
+		{ReportPeriodMonth, day(2025, 1, 2), "2024-12", day(2024, 12, 15)},
+		{ReportPeriodQuarter, day(2025, 10, 20), "FY2026-Q1", day(2025, 10, 15)},
+		{ReportPeriodQuarter, day(2025, 10, 10), "FY2025-Q4", day(2025, 7, 15)},
+		{ReportPeriodQuarter, day(2026, 2, 1), "FY2026-Q2", day(2026, 1, 15)},
+		{ReportPeriodYear, day(2026, 3, 1), "FY2026", day(2025, 10, 15)},
+		{ReportPeriodBiweekly, day(2025, 1, 16), "2025-01-03", day(2025, 1, 3)},
+		{ReportPeriodBiweekly, day(2025, 1, 17), "2025-01-17", day(2025, 1, 17)},
+		{ReportPeriodBiweekly, day(2024, 12, 25), "2024-12-20", day(2024, 12, 20)},
+		{ReportPeriodWeek, day(2025, 3, 20), "2025-W12", day(2025, 3, 17)},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.period)+" "+tt.date.Format("2006-01-02"), func(t *testing.T) {
+			assert.Equal(t, tt.wantLabel, cal.Label(tt.period, tt.date))
+			assert.Equal(t, tt.wantStart, cal.Start(tt.period, tt.date))
+		})
+	}
+
+	// The zero calendar matches ReportPeriod's calendar labels
+	assert.Equal(t, "2025-Q1", FiscalCalendar{}.Label(ReportPeriodQuarter, day(2025, 3, 31)))
+	assert.Equal(t, "2025", FiscalCalendar{}.Label(ReportPeriodYear, day(2025, 3, 31)))
+	assert.Equal(t, "2024-01-15", FiscalCalendar{}.Label(ReportPeriodBiweekly, day(2024, 1, 20)))
+}
+
+func TestGetSpendingReport_FiscalCalendar(t *testing.T) {
+	details := map[string]map[string]interface{}{
+		"A": {"transactionBarcode": "A", "transactionDateTime": "2025-03-10T10:00:00", "total": 50.00},
+		"B": {"transactionBarcode": "B", "transactionDateTime": "2025-03-20T10:00:00", "total": 70.00},
+	}
+	client := newMockClient(t, Config{Calendar: FiscalCalendar{MonthStartDay: 15}}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if barcode, ok := req.Variables["barcode"].(string); ok {
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{details[barcode]}},
+			})
+			return
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{
+				"receipts": []map[string]interface{}{{"transactionBarcode": "A"}, {"transactionBarcode": "B"}},
+			},
+		})
+	})
+
+	report, err := client.GetSpendingReport(context.Background(), "2025-03-01", "2025-03-31", ReportPeriodMonth)
+	require.NoError(t, err)
+	require.Len(t, report.Periods, 2, "the 15th splits March in two")
+	assert.Equal(t, "2025-02", report.Periods[0].Label)
+	assert.Equal(t, 50.00, report.Periods[0].Total)
+	assert.Equal(t, "2025-03", report.Periods[1].Label)
+	assert.Equal(t, 70.00, report.Periods[1].Total)
+
+	series, err := client.GetSpendSeries(context.Background(), "2025-03-01", "2025-03-31", ReportPeriodMonth)
+	require.NoError(t, err)
+	require.Len(t, series.Points, 2)
+	assert.Equal(t, time.Date(2025, 2, 15, 0, 0, 0, 0, time.UTC), series.Points[0].Start)
+}
@@ -0,0 +1,175 @@
+package costco
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+)
+
+// Online order cancellation
+
+// Cancellation error codes returned by the API
+const (
+	CancelCodeWindowClosed = "CANCEL_WINDOW_CLOSED"
+	CancelCodeNotAllowed   = "CANCEL_NOT_ALLOWED"
+)
+
+// ErrCancelWindowClosed is matched by a *CancelError whose cancellation window has closed
+// (the order or item has already been released to the warehouse or shipped).
+var ErrCancelWindowClosed = errors.New("cancellation window has closed")
+
+// CancelError is returned when Costco rejects a cancellation.
+//
+// Example:
+//
+//	_, err := client.CancelOrder(ctx, "1234567890")
+//	if errors.Is(err, costco.ErrCancelWindowClosed) {
+//	    fmt.Println("too late to cancel; start a return instead")
+//	}
+type CancelError struct {
+	OrderNumber     string
+	OrderLineItemID string // Empty when cancelling the whole order
+	Code            string // e.g. CancelCodeWindowClosed
+	Message         string
+}
+
+func (e *CancelError) Error() string {
+	target := "order " + e.OrderNumber
+	if e.OrderLineItemID != "" {
+		target += " line item " + e.OrderLineItemID
+	}
+	return fmt.Sprintf("cancel %s rejected (%s): %s", target, e.Code, e.Message)
+}
+
+// Unwrap returns ErrCancelWindowClosed when the window has closed, so errors.Is works.
+func (e *CancelError) Unwrap() error {
+	if e.Code == CancelCodeWindowClosed {
+		return ErrCancelWindowClosed
+	}
+	return nil
+}
+
+// CancelResult represents a successful cancellation
+type CancelResult struct {
+	OrderNumber     string `json:"orderNumber"`
+	OrderLineItemID string `json:"orderLineItemId,omitempty"`
+	Status          string `json:"status"` // e.g. "Cancelled", "Cancel Requested"
+	Message         string `json:"message"`
+}
+
+// cancelPayload is the raw mutation response shared by both cancel mutations
+type cancelPayload struct {
+	Success   bool   `json:"success"`
+	ErrorCode string `json:"errorCode"`
+	CancelResult
+}
+
+// CancelOrder cancels an entire online order.
+// Only orders with OrderCancelAllowed set can be cancelled. A rejected cancellation
+// returns a *CancelError; use errors.Is(err, ErrCancelWindowClosed) to detect a closed window.
+//
+// Example:
+//
+//	if order.OrderCancelAllowed {
+//	    result, err := client.CancelOrder(ctx, order.OrderNumber)
+//	    if err != nil {
+//	        log.Fatal(err)
+//	    }
+//	    fmt.Println(result.Status)
+//	}
+func (c *Client) CancelOrder(ctx context.Context, orderNumber string) (*CancelResult, error) {
+	if orderNumber == "" {
+		return nil, fmt.Errorf("order number is required")
+	}
+
+	c.getLogger().Info("cancelling order", slog.String("order_number", orderNumber))
+
+	variables := map[string]interface{}{
+		"orderNumber": orderNumber,
+	}
+
+	c.getLogger().Debug("executing graphql mutation", slog.String("operation", "cancelOrder"))
+
+	var result struct {
+		CancelOrder cancelPayload `json:"cancelOrder"`
+	}
+
+	if err := c.executeGraphQL(ctx, CancelOrderMutation, variables, &result); err != nil {
+		return nil, err
+	}
+
+	return c.cancelResult(result.CancelOrder, orderNumber, "")
+}
+
+// CancelOrderLineItem cancels a single line item of an online order.
+// Only items with OrderLineItemCancelAllowed set can be cancelled. A rejected cancellation
+// returns a *CancelError; use errors.Is(err, ErrCancelWindowClosed) to detect a closed window.
+//
+// Example:
+//
+//	for _, item := range order.OrderLineItems {
+//	    if item.OrderLineItemCancelAllowed && item.ItemNumber == "1529345" {
+//	        _, err := client.CancelOrderLineItem(ctx, order.OrderNumber, item.OrderLineItemID)
+//	        // ...
+//	    }
+//	}
+func (c *Client) CancelOrderLineItem(ctx context.Context, orderNumber, orderLineItemID string) (*CancelResult, error) {
+	if orderNumber == "" || orderLineItemID == "" {
+		return nil, fmt.Errorf("order number and line item ID are required")
+	}
+
+	c.getLogger().Info("cancelling order line item",
+		slog.String("order_number", orderNumber),
+		slog.String("order_line_item_id", orderLineItemID))
+
+	variables := map[string]interface{}{
+		"orderNumber":     orderNumber,
+		"orderLineItemId": orderLineItemID,
+	}
+
+	c.getLogger().Debug("executing graphql mutation", slog.String("operation", "cancelOrderLineItem"))
+
+	var result struct {
+		CancelOrderLineItem cancelPayload `json:"cancelOrderLineItem"`
+	}
+
+	if err := c.executeGraphQL(ctx, CancelOrderLineItemMutation, variables, &result); err != nil {
+		return nil, err
+	}
+
+	return c.cancelResult(result.CancelOrderLineItem, orderNumber, orderLineItemID)
+}
+
+// cancelResult converts a mutation payload into a result or a *CancelError.
+func (c *Client) cancelResult(payload cancelPayload, orderNumber, orderLineItemID string) (*CancelResult, error) {
+	if !payload.Success {
+		code := payload.ErrorCode
+		if code == "" {
+			code = CancelCodeNotAllowed
+		}
+		c.getLogger().Warn("cancellation rejected",
+			slog.String("order_number", orderNumber),
+			slog.String("error_code", code))
+		return nil, &CancelError{
+			OrderNumber:     orderNumber,
+			OrderLineItemID: orderLineItemID,
+			Code:            code,
+			Message:         payload.Message,
+		}
+	}
+
+	result := payload.CancelResult
+	if result.OrderNumber == "" {
+		result.OrderNumber = orderNumber
+	}
+	if result.OrderLineItemID == "" {
+		result.OrderLineItemID = orderLineItemID
+	}
+
+	c.getLogger().Info("cancelled",
+		slog.String("order_number", orderNumber),
+		slog.String("status", result.Status))
+
+	return &result, nil
+}
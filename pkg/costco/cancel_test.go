@@ -0,0 +1,101 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelOrder(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Contains(t, req.Query, "mutation cancelOrder")
+		assert.Equal(t, "1234567890", req.Variables["orderNumber"])
+
+		writeGraphQLData(w, map[string]interface{}{
+			"cancelOrder": map[string]interface{}{
+				"success":     true,
+				"orderNumber": "1234567890",
+				"status":      "Cancelled",
+			},
+		})
+	})
+
+	result, err := client.CancelOrder(context.Background(), "1234567890")
+	require.NoError(t, err)
+	assert.Equal(t, "1234567890", result.OrderNumber)
+	assert.Equal(t, "Cancelled", result.Status)
+}
+
+func TestCancelOrderLineItem(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "line-1", req.Variables["orderLineItemId"])
+
+		writeGraphQLData(w, map[string]interface{}{
+			"cancelOrderLineItem": map[string]interface{}{
+				"success": true,
+				"status":  "Cancel Requested",
+			},
+		})
+	})
+
+	result, err := client.CancelOrderLineItem(context.Background(), "1234567890", "line-1")
+	require.NoError(t, err)
+	assert.Equal(t, "1234567890", result.OrderNumber)
+	assert.Equal(t, "line-1", result.OrderLineItemID)
+	assert.Equal(t, "Cancel Requested", result.Status)
+}
+
+func TestCancelOrder_WindowClosed(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		writeGraphQLData(w, map[string]interface{}{
+			"cancelOrderLineItem": map[string]interface{}{
+				"success":   false,
+				"errorCode": CancelCodeWindowClosed,
+				"message":   "Item has shipped",
+			},
+		})
+	})
+
+	_, err := client.CancelOrderLineItem(context.Background(), "1234567890", "line-1")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrCancelWindowClosed))
+
+	var cancelErr *CancelError
+	require.True(t, errors.As(err, &cancelErr))
+	assert.Equal(t, "line-1", cancelErr.OrderLineItemID)
+	assert.Equal(t, "Item has shipped", cancelErr.Message)
+}
+
+func TestCancelOrder_Rejected(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		writeGraphQLData(w, map[string]interface{}{
+			"cancelOrder": map[string]interface{}{"success": false},
+		})
+	})
+
+	_, err := client.CancelOrder(context.Background(), "1234567890")
+	var cancelErr *CancelError
+	require.True(t, errors.As(err, &cancelErr))
+	assert.Equal(t, CancelCodeNotAllowed, cancelErr.Code)
+	assert.False(t, errors.Is(err, ErrCancelWindowClosed))
+}
+
+func TestCancelOrder_RequiresIDs(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("no request expected")
+	})
+
+	_, err := client.CancelOrder(context.Background(), "")
+	assert.Error(t, err)
+	_, err = client.CancelOrderLineItem(context.Background(), "1234567890", "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,124 @@
+package costco
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+// Shopping cart operations against the Costco.com cart service
+
+// Cart represents the signed-in member's Costco.com shopping cart
+type Cart struct {
+	CartID    string     `json:"cartId"`
+	Items     []CartItem `json:"items"`
+	ItemCount int        `json:"itemCount"`
+	Subtotal  float64    `json:"subtotal"`
+}
+
+// CartItem represents a single line in the shopping cart
+type CartItem struct {
+	CartItemID  string  `json:"cartItemId"` // Identifies the line for RemoveFromCart
+	ItemID      string  `json:"itemId"`     // Catalog item ID (same as OrderLineItem.ItemID)
+	ItemNumber  string  `json:"itemNumber"`
+	Description string  `json:"description"`
+	Quantity    int     `json:"quantity"`
+	UnitPrice   float64 `json:"unitPrice"`
+	Total       float64 `json:"total"`
+}
+
+// FindItem returns the cart line for a catalog item ID, or nil if it isn't in the cart.
+func (c *Cart) FindItem(itemID string) *CartItem {
+	for i := range c.Items {
+		if c.Items[i].ItemID == itemID {
+			return &c.Items[i]
+		}
+	}
+	return nil
+}
+
+// GetCart retrieves the current shopping cart.
+//
+// Example:
+//
+//	cart, err := client.GetCart(ctx)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, item := range cart.Items {
+//	    fmt.Printf("%d x %s: $%.2f\n", item.Quantity, item.Description, item.Total)
+//	}
+func (c *Client) GetCart(ctx context.Context) (*Cart, error) {
+	c.getLogger().Info("fetching cart")
+
+	var cart Cart
+	if err := c.executeREST(ctx, http.MethodGet, CartEndpoint, nil, nil, true, &cart); err != nil {
+		return nil, err
+	}
+
+	c.getLogger().Info("fetched cart", slog.Int("item_count", cart.ItemCount))
+
+	return &cart, nil
+}
+
+// AddToCart adds quantity units of a catalog item to the cart and returns the updated cart.
+// Use the ItemID from a past order line item (OrderLineItem.ItemID) to re-order it.
+//
+// Example:
+//
+//	// Re-add monthly staples
+//	for _, itemID := range staples {
+//	    if _, err := client.AddToCart(ctx, itemID, 1); err != nil {
+//	        log.Printf("could not add %s: %v", itemID, err)
+//	    }
+//	}
+func (c *Client) AddToCart(ctx context.Context, itemID string, quantity int) (*Cart, error) {
+	if itemID == "" {
+		return nil, fmt.Errorf("item ID is required")
+	}
+	if quantity <= 0 {
+		return nil, fmt.Errorf("quantity must be positive, got %d", quantity)
+	}
+
+	c.getLogger().Info("adding item to cart",
+		slog.String("item_id", itemID),
+		slog.Int("quantity", quantity))
+
+	body := map[string]interface{}{
+		"itemId":   itemID,
+		"quantity": quantity,
+	}
+
+	var cart Cart
+	if err := c.executeREST(ctx, http.MethodPost, CartEndpoint+"/items", nil, body, true, &cart); err != nil {
+		return nil, err
+	}
+
+	return &cart, nil
+}
+
+// RemoveFromCart removes a cart line (CartItem.CartItemID) and returns the updated cart.
+//
+// Example:
+//
+//	cart, _ := client.GetCart(ctx)
+//	if item := cart.FindItem("100123456"); item != nil {
+//	    cart, err = client.RemoveFromCart(ctx, item.CartItemID)
+//	}
+func (c *Client) RemoveFromCart(ctx context.Context, cartItemID string) (*Cart, error) {
+	if cartItemID == "" {
+		return nil, fmt.Errorf("cart item ID is required")
+	}
+
+	c.getLogger().Info("removing item from cart", slog.String("cart_item_id", cartItemID))
+
+	var cart Cart
+	endpoint := CartEndpoint + "/items/" + url.PathEscape(cartItemID)
+	if err := c.executeREST(ctx, http.MethodDelete, endpoint, nil, nil, true, &cart); err != nil {
+		return nil, err
+	}
+
+	return &cart, nil
+}
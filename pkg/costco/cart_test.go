@@ -0,0 +1,100 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cartServer is an in-memory cart service for tests
+func cartServer(t *testing.T) http.HandlerFunc {
+	cart := Cart{CartID: "cart-1"}
+	return func(w http.ResponseWriter, r *http.Request) {
+		assert.True(t, strings.HasPrefix(r.Header.Get(HeaderAuthorization), "Bearer "), "cart requires auth")
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/ebusiness/cart/v1/cart":
+		case r.Method == http.MethodPost && r.URL.Path == "/ebusiness/cart/v1/cart/items":
+			var body struct {
+				ItemID   string `json:"itemId"`
+				Quantity int    `json:"quantity"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			cart.Items = append(cart.Items, CartItem{
+				CartItemID: "line-" + body.ItemID,
+				ItemID:     body.ItemID,
+				Quantity:   body.Quantity,
+				UnitPrice:  10,
+				Total:      10 * float64(body.Quantity),
+			})
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/ebusiness/cart/v1/cart/items/"):
+			id := strings.TrimPrefix(r.URL.Path, "/ebusiness/cart/v1/cart/items/")
+			for i, item := range cart.Items {
+				if item.CartItemID == id {
+					cart.Items = append(cart.Items[:i], cart.Items[i+1:]...)
+					break
+				}
+			}
+		default:
+			http.NotFound(w, r)
+			return
+		}
+
+		cart.ItemCount, cart.Subtotal = 0, 0
+		for _, item := range cart.Items {
+			cart.ItemCount += item.Quantity
+			cart.Subtotal += item.Total
+		}
+		json.NewEncoder(w).Encode(cart)
+	}
+}
+
+func TestCart_AddGetRemove(t *testing.T) {
+	client := newMockClient(t, Config{}, cartServer(t))
+	ctx := context.Background()
+
+	cart, err := client.GetCart(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, cart.Items)
+
+	cart, err = client.AddToCart(ctx, "100123456", 2)
+	require.NoError(t, err)
+	require.Len(t, cart.Items, 1)
+	assert.Equal(t, 2, cart.ItemCount)
+	assert.Equal(t, 20.0, cart.Subtotal)
+
+	item := cart.FindItem("100123456")
+	require.NotNil(t, item)
+	assert.Nil(t, cart.FindItem("missing"))
+
+	cart, err = client.RemoveFromCart(ctx, item.CartItemID)
+	require.NoError(t, err)
+	assert.Empty(t, cart.Items)
+}
+
+func TestAddToCart_InvalidInput(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("no request expected")
+	})
+
+	_, err := client.AddToCart(context.Background(), "", 1)
+	assert.Error(t, err)
+	_, err = client.AddToCart(context.Background(), "100123456", 0)
+	assert.ErrorContains(t, err, "quantity must be positive")
+	_, err = client.RemoveFromCart(context.Background(), "")
+	assert.Error(t, err)
+}
+
+func TestGetCart_HTTPError(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	_, err := client.GetCart(context.Background())
+	assert.ErrorContains(t, err, "401")
+}
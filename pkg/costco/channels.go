@@ -0,0 +1,184 @@
+package costco
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Online vs. in-warehouse spending
+
+// Sales channels used by GetSpendingByChannel
+const (
+	ChannelWarehouse        = "warehouse"         // In-warehouse receipts
+	ChannelGas              = "gas"               // Gas station and car wash receipts
+	ChannelOnline           = "online"            // Costco.com orders, shipped or picked up
+	ChannelSameDay          = "same_day"          // Same-day delivery orders
+	ChannelBusinessDelivery = "business_delivery" // Business Delivery orders (with Config.IncludeBusinessDelivery)
+)
+
+// ChannelSummary merges receipts and online orders into one spend view by channel.
+// This is returned by GetSpendingByChannel.
+type ChannelSummary struct {
+	Channels []ChannelSpending // Largest total first
+	Total    float64
+	Pickups  []PickupMatch // Receipts dropped because they repeat an online order picked up in-warehouse
+}
+
+// ChannelSpending is the spending through one channel.
+type ChannelSpending struct {
+	Channel string  // ChannelWarehouse, ChannelGas, ChannelOnline, ChannelSameDay, or ChannelBusinessDelivery
+	Total   float64 // Amount spent, net of refunds
+	Count   int     // Number of receipts or orders
+	Share   float64 // Percent of the overall total
+}
+
+// PickupMatch pairs an online order with the warehouse receipt printed when it was picked up.
+type PickupMatch struct {
+	OrderNumber        string
+	TransactionBarcode string
+	Amount             float64
+}
+
+// IsSameDay reports whether the line ships by same-day delivery.
+func (l *OrderLineItem) IsSameDay() bool {
+	for _, field := range []string{l.ShippingType, l.ProgramTypeID} {
+		normalized := strings.Map(func(r rune) rune {
+			if r == ' ' || r == '_' || r == '-' {
+				return -1
+			}
+			return r
+		}, strings.ToUpper(field))
+		if strings.Contains(normalized, "SAMEDAY") {
+			return true
+		}
+	}
+	return false
+}
+
+// IsWarehousePickup reports whether the line is collected at a warehouse.
+func (l *OrderLineItem) IsWarehousePickup() bool {
+	return l.IsShipToWarehouse || (l.Shipment != nil && l.Shipment.PickUpCompletedDate != "")
+}
+
+// GetSpendingByChannel combines warehouse receipts and online orders into one
+// spend view attributed to warehouse, gas, online, and same-day channels.
+// Online orders picked up in-warehouse sometimes also print a receipt; a receipt
+// at the pickup warehouse on the pickup day for the order's exact total is
+// treated as the same purchase, counted once under the online channel, and
+// listed in Pickups. Cancelled orders are skipped.
+//
+// The startDate and endDate should be in YYYY-MM-DD format.
+//
+// Example:
+//
+//	channels, err := client.GetSpendingByChannel(ctx, "2025-01-01", "2025-12-31")
+//	for _, ch := range channels.Channels {
+//	    fmt.Printf("%-10s $%9.2f  %5.1f%%  (%d)\n", ch.Channel, ch.Total, ch.Share, ch.Count)
+//	}
+func (c *Client) GetSpendingByChannel(ctx context.Context, startDate, endDate string) (*ChannelSummary, error) {
+	transactions, err := c.GetAllTransactionItems(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	orders, err := c.getAllOnlineOrders(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &ChannelSummary{}
+	channels := make(map[string]*ChannelSpending)
+	add := func(channel string, amount float64) {
+		spending, exists := channels[channel]
+		if !exists {
+			spending = &ChannelSpending{Channel: channel}
+			channels[channel] = spending
+		}
+		spending.Total += amount
+		spending.Count++
+	}
+
+	// Receipts are matched to pickups by warehouse, day, and total
+	used := make(map[int]bool)
+	findPickupReceipt := func(warehouse, day string, total float64) int {
+		for i, tx := range transactions {
+			if used[i] || tx.Source != TransactionSourceReceipt || tx.DocumentType != DocumentTypeWarehouse {
+				continue
+			}
+			if strconv.Itoa(tx.WarehouseNumber) == strings.TrimLeft(warehouse, "0") &&
+				tx.TransactionDate.Format("2006-01-02") == day &&
+				math.Abs(tx.Total-total) < 0.005 {
+				return i
+			}
+		}
+		return -1
+	}
+
+	for _, order := range orders {
+		if strings.Contains(strings.ToUpper(order.Status), "CANCEL") {
+			continue
+		}
+
+		channel := ChannelOnline
+		matched := false
+		for i := range order.OrderLineItems {
+			line := &order.OrderLineItems[i]
+			if line.IsSameDay() {
+				channel = ChannelSameDay
+			}
+			if matched || !line.IsWarehousePickup() || line.Shipment == nil || len(line.Shipment.PickUpCompletedDate) < 10 {
+				continue
+			}
+			warehouse := line.WarehouseNumber
+			if warehouse == "" {
+				warehouse = order.WarehouseNumber
+			}
+			if r := findPickupReceipt(warehouse, line.Shipment.PickUpCompletedDate[:10], order.OrderTotal); r >= 0 {
+				used[r] = true
+				matched = true
+				summary.Pickups = append(summary.Pickups, PickupMatch{
+					OrderNumber:        order.OrderNumber,
+					TransactionBarcode: transactions[r].TransactionBarcode,
+					Amount:             order.OrderTotal,
+				})
+			}
+		}
+		add(channel, order.OrderTotal)
+	}
+
+	for i, tx := range transactions {
+		if used[i] {
+			continue
+		}
+		switch {
+		case tx.Source == TransactionSourceBusinessDelivery:
+			add(ChannelBusinessDelivery, tx.Total)
+		case tx.DocumentType == DocumentTypeFuel || tx.DocumentType == DocumentTypeCarWash:
+			add(ChannelGas, tx.Total)
+		default:
+			add(ChannelWarehouse, tx.Total)
+		}
+	}
+
+	for _, spending := range channels {
+		summary.Total += spending.Total
+	}
+	summary.Total = roundTo(summary.Total, 2)
+	for _, spending := range channels {
+		spending.Total = roundTo(spending.Total, 2)
+		if summary.Total != 0 {
+			spending.Share = roundTo(spending.Total/summary.Total*100, 1)
+		}
+		summary.Channels = append(summary.Channels, *spending)
+	}
+	sort.Slice(summary.Channels, func(i, j int) bool {
+		if summary.Channels[i].Total != summary.Channels[j].Total {
+			return summary.Channels[i].Total > summary.Channels[j].Total
+		}
+		return summary.Channels[i].Channel < summary.Channels[j].Channel
+	})
+
+	return summary, nil
+}
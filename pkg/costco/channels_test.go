@@ -0,0 +1,81 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderLineItemIsSameDay(t *testing.T) {
+	assert.True(t, (&OrderLineItem{ShippingType: "Same-Day"}).IsSameDay())
+	assert.True(t, (&OrderLineItem{ProgramTypeID: "SAME_DAY"}).IsSameDay())
+	assert.False(t, (&OrderLineItem{ShippingType: "Standard"}).IsSameDay())
+}
+
+func TestGetSpendingByChannel(t *testing.T) {
+	details := map[string]map[string]interface{}{
+		"W1": {"transactionBarcode": "W1", "transactionDateTime": "2025-01-10T10:00:00", "total": 300.00, "warehouseNumber": 847},
+		"W2": {"transactionBarcode": "W2", "transactionDateTime": "2025-01-15T10:00:00", "total": 75.00, "warehouseNumber": 847},
+		"G1": {"transactionBarcode": "G1", "transactionDateTime": "2025-01-12T10:00:00", "total": 50.00, "warehouseNumber": 847},
+	}
+
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		switch {
+		case req.Query == OnlineOrdersQuery:
+			writeGraphQLData(w, map[string]interface{}{
+				"getOnlineOrders": []interface{}{map[string]interface{}{
+					"pageNumber": 1, "totalNumberOfRecords": 4,
+					"bcOrders": []map[string]interface{}{
+						{"orderNumber": "O1", "orderTotal": 100.00, "orderLineItems": []map[string]interface{}{{"shippingType": "Standard"}}},
+						{"orderNumber": "O2", "orderTotal": 60.00, "orderLineItems": []map[string]interface{}{{"shippingType": "Same Day"}}},
+						{"orderNumber": "O3", "orderTotal": 75.00, "warehouseNumber": "0847", "orderLineItems": []map[string]interface{}{{
+							"isShipToWarehouse": true,
+							"shipment":          map[string]interface{}{"pickUpCompletedDate": "2025-01-15T16:00:00"},
+						}}},
+						{"orderNumber": "O4", "orderTotal": 999.00, "status": "Cancelled"},
+					},
+				}},
+			})
+		case req.Variables["barcode"] != nil:
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{details[req.Variables["barcode"].(string)]}},
+			})
+		default:
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{
+					"receipts": []map[string]interface{}{
+						{"transactionBarcode": "W1"}, {"transactionBarcode": "W2"},
+						{"transactionBarcode": "G1", "receiptType": "Gas Station"},
+					},
+				},
+			})
+		}
+	})
+
+	summary, err := client.GetSpendingByChannel(context.Background(), "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+
+	assert.Equal(t, 585.00, summary.Total, "pickup receipt counted once, cancelled order skipped")
+	require.Len(t, summary.Pickups, 1)
+	assert.Equal(t, PickupMatch{OrderNumber: "O3", TransactionBarcode: "W2", Amount: 75.00}, summary.Pickups[0])
+
+	totals := map[string]float64{}
+	counts := map[string]int{}
+	for _, ch := range summary.Channels {
+		totals[ch.Channel] = ch.Total
+		counts[ch.Channel] = ch.Count
+	}
+	assert.Equal(t, 300.00, totals[ChannelWarehouse])
+	assert.Equal(t, 175.00, totals[ChannelOnline])
+	assert.Equal(t, 2, counts[ChannelOnline])
+	assert.Equal(t, 60.00, totals[ChannelSameDay])
+	assert.Equal(t, 50.00, totals[ChannelGas])
+	assert.Equal(t, ChannelWarehouse, summary.Channels[0].Channel)
+	assert.Equal(t, 51.3, summary.Channels[0].Share)
+}
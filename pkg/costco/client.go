@@ -25,6 +25,7 @@ type Client struct {
 	tokenExpiry time.Time
 	mu          sync.RWMutex
 	logger      *slog.Logger
+	configErr   error // Validation error from NewClient, returned by API calls
 }
 
 // getLogger returns the client's logger or a no-op logger if none is set
@@ -39,19 +40,23 @@ func (c *Client) getLogger() *slog.Logger {
 // NewClient creates a new Costco API client with the given configuration.
 // The client handles authentication, token management, and all API operations.
 // If tokens exist in ~/.costco/tokens.json, they will be automatically loaded and used.
+// The config is checked with Config.Validate; if it is invalid, every API call returns the validation error.
 //
 // Configuration options:
 //   - Email: Costco account email (required)
-//   - Password: Costco account password (required)
 //   - WarehouseNumber: Default warehouse (default: "847")
+//   - DocumentType/DocumentSubType: Receipt filters used by analytics helpers (default: "all")
+//   - SecretBackend: Where sensitive fields live; keychain pointers in Email are resolved (default: "file")
 //   - TokenRefreshBuffer: How early to refresh tokens before expiry (default: 5 minutes)
+//   - StaleTokenMaxAge: Remove token files whose refresh token expired this long ago (default: 7 days)
+//   - ReadOnly: Never write to ~/.costco; refreshed tokens are kept in memory only
+//   - Tokens: Initial tokens to use instead of ~/.costco/tokens.json
 //   - Logger: Optional slog.Logger for debugging (default: silent mode)
 //
 // Example:
 //
 //	config := costco.Config{
 //	    Email:              "user@example.com",
-//	    Password:           "password",
 //	    WarehouseNumber:    "847",
 //	    TokenRefreshBuffer: 5 * time.Minute,
 //	    Logger:             slog.Default(),
@@ -61,6 +66,18 @@ func NewClient(config Config) *Client {
 	if config.TokenRefreshBuffer == 0 {
 		config.TokenRefreshBuffer = 5 * time.Minute
 	}
+	if config.StaleTokenMaxAge == 0 {
+		config.StaleTokenMaxAge = DefaultStaleTokenMaxAge
+	}
+	if config.WarehouseNumber == "" {
+		config.WarehouseNumber = DefaultWarehouse
+	}
+	if config.DocumentType == "" {
+		config.DocumentType = DefaultDocumentType
+	}
+	if config.DocumentSubType == "" {
+		config.DocumentSubType = DefaultDocumentSubType
+	}
 
 	// Use provided logger or no-op logger if none provided
 	// Note: Caller is responsible for adding any scoping attributes (e.g., system="costco")
@@ -70,6 +87,13 @@ func NewClient(config Config) *Client {
 		logger = slog.New(slog.NewTextHandler(io.Discard, nil))
 	}
 
+	// Resolve keychain pointers for sensitive fields
+	if email, err := resolveSecret(config.SecretBackend, config.Email); err != nil {
+		logger.Warn("failed to resolve email secret", slog.String("error", err.Error()))
+	} else {
+		config.Email = email
+	}
+
 	client := &Client{
 		httpClient: &http.Client{
 			Timeout: 30 * time.Second,
@@ -78,6 +102,38 @@ func NewClient(config Config) *Client {
 		logger: logger,
 	}
 
+	if err := config.Validate(); err != nil {
+		logger.Warn("invalid client config", slog.String("error", err.Error()))
+		client.configErr = err
+	}
+
+	if err := loadDefaultDepartmentOverrides(); err != nil {
+		logger.Warn("failed to load department name overrides", slog.String("error", err.Error()))
+	}
+	if err := loadDefaultAbbreviationOverrides(); err != nil {
+		logger.Warn("failed to load abbreviation overrides", slog.String("error", err.Error()))
+	}
+
+	// Use tokens supplied in memory, skipping the token file entirely
+	if config.Tokens != nil {
+		client.token = &TokenResponse{
+			IDToken:      config.Tokens.IDToken,
+			RefreshToken: config.Tokens.RefreshToken,
+		}
+		client.tokenExpiry = config.Tokens.TokenExpiry
+		logger.Info("token initialized from config", slog.Time("token_expiry", client.tokenExpiry))
+		return client
+	}
+
+	// Clean up token files that can no longer be refreshed
+	if !config.ReadOnly {
+		if removed, err := RemoveStaleTokens(config.StaleTokenMaxAge); err != nil {
+			logger.Warn("failed to remove stale tokens", slog.String("error", err.Error()))
+		} else if removed {
+			logger.Info("removed stale token file")
+		}
+	}
+
 	// Try to load existing tokens
 	if tokens, err := LoadTokens(); err == nil && tokens != nil {
 		client.token = &TokenResponse{
@@ -91,6 +147,52 @@ func NewClient(config Config) *Client {
 	return client
 }
 
+// ClearSession discards the client's tokens, both in memory and on disk.
+// Use it to recover from a broken session; the next API call will fail until
+// tokens are imported again. In read-only mode only the in-memory tokens are cleared.
+//
+// Example:
+//
+//	if err := client.ClearSession(); err != nil {
+//	    log.Printf("Failed to clear session: %v", err)
+//	}
+func (c *Client) ClearSession() error {
+	c.mu.Lock()
+	c.token = nil
+	c.tokenExpiry = time.Time{}
+	c.mu.Unlock()
+
+	c.getLogger().Info("session cleared")
+
+	if c.config.ReadOnly {
+		return nil
+	}
+	if err := ClearTokens(); err != nil {
+		c.getLogger().Error("failed to clear persisted tokens", slog.String("error", err.Error()))
+		return fmt.Errorf("clearing persisted tokens: %w", err)
+	}
+	return nil
+}
+
+// RefreshSession exchanges the refresh token for new tokens now instead of when the
+// ID token expires, and saves them to ~/.costco/tokens.json unless ReadOnly. It
+// fails when the client has no refresh token; import a new token response then.
+//
+// Example:
+//
+//	if err := client.RefreshSession(); err != nil {
+//	    log.Fatalf("Session can't be refreshed: %v", err)
+//	}
+func (c *Client) RefreshSession() error {
+	c.mu.RLock()
+	hasRefreshToken := c.token != nil && c.token.RefreshToken != ""
+	c.mu.RUnlock()
+
+	if !hasRefreshToken {
+		return fmt.Errorf("no refresh token available. Run 'costco-cli login' to sign in")
+	}
+	return c.refreshToken()
+}
 
 func (c *Client) calculateTokenExpiry(tokenString string) time.Time {
 	token, _, err := new(jwt.Parser).ParseUnverified(tokenString, jwt.MapClaims{})
@@ -129,7 +231,7 @@ func (c *Client) refreshTokenIfNeeded() error {
 		return c.refreshToken()
 	}
 
-	return fmt.Errorf("no valid tokens available. Run 'costco-cli -cmd import-token' to import tokens from your browser")
+	return fmt.Errorf("no valid tokens available. Run 'costco-cli login' to sign in")
 }
 
 func (c *Client) refreshToken() error {
@@ -179,7 +281,7 @@ func (c *Client) refreshToken() error {
 	if resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
 		c.getLogger().Error("token refresh failed", slog.Int("status_code", resp.StatusCode), slog.String("body", string(body)))
-		return fmt.Errorf("token refresh failed with status %d: %s. Run 'costco-cli -cmd import-token' to re-import tokens", resp.StatusCode, string(body))
+		return fmt.Errorf("token refresh failed with status %d: %s. Run 'costco-cli login' to sign in again", resp.StatusCode, string(body))
 	}
 
 	var tokenResp TokenResponse
@@ -195,6 +297,11 @@ func (c *Client) refreshToken() error {
 
 	c.getLogger().Info("token refreshed", slog.Time("token_expiry", c.tokenExpiry))
 
+	if c.config.ReadOnly {
+		c.getLogger().Debug("read-only mode, keeping refreshed tokens in memory")
+		return nil
+	}
+
 	// Save refreshed tokens to disk
 	storedTokens := &StoredTokens{
 		IDToken:               tokenResp.IDToken,
@@ -213,6 +320,10 @@ func (c *Client) refreshToken() error {
 }
 
 func (c *Client) executeGraphQL(ctx context.Context, query string, variables map[string]interface{}, result interface{}) error {
+	if c.configErr != nil {
+		return c.configErr
+	}
+
 	if err := c.refreshTokenIfNeeded(); err != nil {
 		return fmt.Errorf("token refresh failed: %w", err)
 	}
@@ -239,7 +350,7 @@ func (c *Client) executeGraphQL(ctx context.Context, query string, variables map
 	c.mu.RUnlock()
 
 	req.Header.Set("Accept", "*/*")
-	req.Header.Set("Accept-Language", "en-US,en;q=0.9")
+	req.Header.Set("Accept-Language", c.acceptLanguage())
 	req.Header.Set("Cache-Control", "no-cache")
 	req.Header.Set("Connection", "keep-alive")
 	req.Header.Set("Content-Type", "application/json-patch+json")
@@ -344,6 +455,11 @@ func (c *Client) GetOnlineOrders(ctx context.Context, startDate, endDate string,
 		return nil, fmt.Errorf("no order data returned")
 	}
 
+	currency := c.currency()
+	for i := range result.GetOnlineOrders[0].BCOrders {
+		result.GetOnlineOrders[0].BCOrders[i].Currency = currency
+	}
+
 	orderCount := len(result.GetOnlineOrders[0].BCOrders)
 	c.getLogger().Info("fetched online orders",
 		slog.Int("order_count", orderCount),
@@ -357,19 +473,20 @@ func (c *Client) GetOnlineOrders(ctx context.Context, startDate, endDate string,
 //
 // Parameters:
 //   - ctx: Context for cancellation and timeouts
-//   - startDate: Start date in M/DD/YYYY format (e.g., "1/01/2025")
-//   - endDate: End date in M/DD/YYYY format (e.g., "1/31/2025")
-//   - documentType: Type of receipts to retrieve ("all", "warehouse", "fuel")
-//   - documentSubType: Sub-type filter (usually "all")
+//   - startDate: Start date in YYYY-MM-DD format (e.g., "2025-01-01")
+//   - endDate: End date in YYYY-MM-DD format (e.g., "2025-01-31")
+//   - documentType: Type of receipts to retrieve (DocumentTypeAll, DocumentTypeWarehouse, DocumentTypeFuel)
+//   - documentSubType: Sub-type filter (usually DocumentSubTypeAll; see GetGasReceipts and GetCarWashReceipts)
 //
 // Returns:
 //   - ReceiptsWithCountsResponse containing receipts and counts by type
 //
-// Note: The date format for receipts differs from online orders (M/DD/YYYY vs YYYY-MM-DD).
+// Note: The receipts API takes M/DD/YYYY dates; GetReceipts converts them, and dates
+// already in that format are passed through.
 //
 // Example:
 //
-//	receipts, err := client.GetReceipts(ctx, "1/01/2025", "1/31/2025", "all", "all")
+//	receipts, err := client.GetReceipts(ctx, "2025-01-01", "2025-01-31", costco.DocumentTypeAll, costco.DocumentSubTypeAll)
 //	if err != nil {
 //	    log.Fatal(err)
 //	}
@@ -379,14 +496,20 @@ func (c *Client) GetOnlineOrders(ctx context.Context, startDate, endDate string,
 //	        receipt.TransactionDateTime, receipt.Total)
 //	}
 func (c *Client) GetReceipts(ctx context.Context, startDate, endDate, documentType, documentSubType string) (*ReceiptsWithCountsResponse, error) {
+	var v validator
+	v.documentFilters(documentType, documentSubType)
+	if len(v.errs) > 0 {
+		return nil, v.errs[0]
+	}
+
 	c.getLogger().Info("fetching receipts",
 		slog.String("start_date", startDate),
 		slog.String("end_date", endDate),
 		slog.String("document_type", documentType))
 
 	variables := map[string]interface{}{
-		"startDate":       startDate,
-		"endDate":         endDate,
+		"startDate":       receiptsAPIDate(startDate),
+		"endDate":         receiptsAPIDate(endDate),
 		"documentType":    documentType,
 		"documentSubType": documentSubType,
 	}
@@ -417,6 +540,7 @@ func (c *Client) GetReceipts(ctx context.Context, startDate, endDate, documentTy
 			return nil, fmt.Errorf("no receipt data returned")
 		}
 
+		c.tagReceipts(resultArray.ReceiptsWithCounts[0].Receipts)
 		receiptCount := len(resultArray.ReceiptsWithCounts[0].Receipts)
 		c.getLogger().Warn("✅✅✅ ARRAY FALLBACK SUCCEEDED! Array format worked! (DO NOT DELETE THIS CODE) ✅✅✅",
 			slog.Int("receipt_count", receiptCount),
@@ -424,6 +548,7 @@ func (c *Client) GetReceipts(ctx context.Context, startDate, endDate, documentTy
 		return &resultArray.ReceiptsWithCounts[0], nil
 	}
 
+	c.tagReceipts(resultObject.ReceiptsWithCounts.Receipts)
 	receiptCount := len(resultObject.ReceiptsWithCounts.Receipts)
 	c.getLogger().Info("fetched receipts",
 		slog.Int("receipt_count", receiptCount),
@@ -432,6 +557,39 @@ func (c *Client) GetReceipts(ctx context.Context, startDate, endDate, documentTy
 	return &resultObject.ReceiptsWithCounts, nil
 }
 
+// receiptsAPIDate converts a YYYY-MM-DD date to the M/DD/YYYY format the receipts API
+// takes. Anything else is returned unchanged.
+func receiptsAPIDate(date string) string {
+	t, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return date
+	}
+	return t.Format("1/02/2006")
+}
+
+// GetGasReceipts retrieves gas station receipts within the specified date range.
+//
+// Example:
+//
+//	receipts, err := client.GetGasReceipts(ctx, "2025-01-01", "2025-01-31")
+//	fmt.Printf("%d fill-ups\n", len(receipts.Receipts))
+func (c *Client) GetGasReceipts(ctx context.Context, startDate, endDate string) (*ReceiptsWithCountsResponse, error) {
+	return c.GetReceipts(ctx, startDate, endDate, DocumentTypeFuel, DocumentSubTypeGas)
+}
+
+// GetCarWashReceipts retrieves car wash receipts within the specified date range.
+func (c *Client) GetCarWashReceipts(ctx context.Context, startDate, endDate string) (*ReceiptsWithCountsResponse, error) {
+	return c.GetReceipts(ctx, startDate, endDate, DocumentTypeFuel, DocumentSubTypeCarWash)
+}
+
+// tagReceipts sets the configured currency on each receipt.
+func (c *Client) tagReceipts(receipts []Receipt) {
+	currency := c.currency()
+	for i := range receipts {
+		receipts[i].Currency = currency
+	}
+}
+
 func generateUUID() string {
 	b := make([]byte, 16)
 	if _, err := rand.Read(b); err != nil {
@@ -452,7 +610,7 @@ func generateUUID() string {
 // Parameters:
 //   - ctx: Context for cancellation and timeouts
 //   - barcode: Receipt barcode/transaction ID (e.g., "21134300501862509051323")
-//   - documentType: Type of receipt ("warehouse" or "fuel")
+//   - documentType: Type of receipt ("warehouse", "fuel", or "carwash"; see Receipt.DetailDocumentType)
 //
 // Returns:
 //   - Receipt containing full transaction details and all line items
@@ -495,6 +653,11 @@ func (c *Client) GetReceiptDetail(ctx context.Context, barcode, documentType str
 	}
 
 	receipt := &result.ReceiptsWithCounts.Receipts[0]
+	receipt.Currency = c.currency()
+	if receipt.Currency == "" {
+		// No locale configured: fall back to the country the receipt was issued in
+		receipt.Currency = receipt.Region().Currency()
+	}
 	c.getLogger().Info("fetched receipt detail",
 		slog.String("barcode", barcode),
 		slog.String("document_type", documentType),
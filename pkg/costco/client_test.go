@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -19,6 +20,9 @@ import (
 )
 
 func TestNewClient(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
 	config := Config{
 		Email:           "test@example.com",
 		WarehouseNumber: "847",
@@ -32,6 +36,55 @@ func TestNewClient(t *testing.T) {
 	assert.Equal(t, 5*time.Minute, client.config.TokenRefreshBuffer)
 }
 
+func TestClientClearSession(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
+	require.NoError(t, SaveTokens(&StoredTokens{
+		IDToken:      generateTestJWT(time.Now().Add(1 * time.Hour).Unix()),
+		RefreshToken: "test-refresh-token",
+		TokenExpiry:  time.Now().Add(1 * time.Hour),
+	}))
+
+	client := NewClient(Config{Email: "test@example.com"})
+	require.NotNil(t, client.token, "tokens should be loaded from disk")
+
+	require.NoError(t, client.ClearSession())
+	assert.Nil(t, client.token)
+	assert.True(t, client.tokenExpiry.IsZero())
+
+	tokens, err := LoadTokens()
+	require.NoError(t, err)
+	assert.Nil(t, tokens, "persisted tokens should be removed")
+
+	// Subsequent calls fail until tokens are re-imported
+	_, err = client.GetOnlineOrders(context.Background(), "2025-01-01", "2025-01-31", 1, 10)
+	assert.ErrorContains(t, err, "no valid tokens available")
+}
+
+func TestNewClientRemovesStaleTokens(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
+	configPath, err := getConfigPath()
+	require.NoError(t, err)
+	data, err := json.Marshal(&StoredTokens{
+		IDToken:               "stale-id",
+		RefreshToken:          "stale-refresh",
+		RefreshTokenExpiresAt: time.Now().Add(-30 * 24 * time.Hour),
+		UpdatedAt:             time.Now().Add(-120 * 24 * time.Hour),
+	})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(configPath, tokenFile), data, 0600))
+
+	client := NewClient(Config{Email: "test@example.com"})
+	assert.Nil(t, client.token, "stale tokens should not be loaded")
+
+	tokens, err := LoadTokens()
+	require.NoError(t, err)
+	assert.Nil(t, tokens, "stale token file should be removed")
+}
+
 func TestRefreshToken(t *testing.T) {
 	cleanup := SetupTestConfig(t)
 	defer cleanup()
@@ -82,6 +135,35 @@ func TestRefreshToken(t *testing.T) {
 	assert.True(t, client.tokenExpiry.After(time.Now()))
 }
 
+func TestRefreshSession(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
+	refreshes := 0
+	client := newMockClient(t, Config{TokenRefreshBuffer: 5 * time.Minute}, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "test-refresh-token", r.Form.Get("refresh_token"))
+		refreshes++
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(TokenResponse{
+			IDToken:               generateTestJWT(time.Now().Add(2 * time.Hour).Unix()),
+			RefreshToken:          "new-refresh-token",
+			RefreshTokenExpiresIn: 7776000,
+		})
+	})
+
+	// The current token is still valid; the refresh happens anyway
+	require.NoError(t, client.RefreshSession())
+	assert.Equal(t, 1, refreshes)
+
+	tokens, err := LoadTokens()
+	require.NoError(t, err)
+	assert.Equal(t, "new-refresh-token", tokens.RefreshToken)
+
+	client.token = nil
+	assert.ErrorContains(t, client.RefreshSession(), "no refresh token available")
+}
+
 func TestGetOnlineOrders(t *testing.T) {
 	cleanup := SetupTestConfig(t)
 	defer cleanup()
@@ -109,6 +191,7 @@ func TestGetOnlineOrders(t *testing.T) {
 			require.NoError(t, err)
 
 			assert.Contains(t, req.Query, "getOnlineOrders")
+			assert.Contains(t, req.Query, "paymentSummary")
 			assert.Equal(t, "2025-01-01", req.Variables["startDate"])
 			assert.Equal(t, "2025-01-31", req.Variables["endDate"])
 
@@ -131,7 +214,11 @@ func TestGetOnlineOrders(t *testing.T) {
 									"orderCancelAllowed": false,
 									"orderPaymentFailed": false,
 									"orderReturnAllowed": true,
-									"orderLineItems":     []interface{}{},
+									"paymentSummary": []map[string]interface{}{
+										{"tenderType": "CREDIT_CARD", "cardType": "Visa", "lastFourDigits": "4242", "amount": 79.99},
+										{"tenderType": "SHOP_CARD", "cardType": "Costco Shop Card", "lastFourDigits": "0001", "amount": 20.00},
+									},
+									"orderLineItems": []interface{}{},
 								},
 							},
 						},
@@ -156,6 +243,11 @@ func TestGetOnlineOrders(t *testing.T) {
 			WarehouseNumber:    "847",
 			TokenRefreshBuffer: 5 * time.Minute,
 		},
+		token: &TokenResponse{
+			IDToken:      generateTestJWT(time.Now().Add(1 * time.Hour).Unix()),
+			RefreshToken: "test-refresh-token",
+		},
+		tokenExpiry: time.Now().Add(1 * time.Hour),
 	}
 
 	orders, err := client.GetOnlineOrders(context.Background(), "2025-01-01", "2025-01-31", 1, 10)
@@ -169,6 +261,10 @@ func TestGetOnlineOrders(t *testing.T) {
 	assert.Equal(t, "12345", orders.BCOrders[0].OrderHeaderID)
 	assert.Equal(t, "ORD-001", orders.BCOrders[0].OrderNumber)
 	assert.Equal(t, 99.99, orders.BCOrders[0].OrderTotal)
+	require.Len(t, orders.BCOrders[0].Payments, 2)
+	assert.Equal(t, "Visa", orders.BCOrders[0].Payments[0].CardType)
+	assert.Equal(t, 79.99, orders.BCOrders[0].PaidWith("4242"))
+	assert.Equal(t, 0.0, orders.BCOrders[0].PaidWith("9999"))
 }
 
 func TestGetReceiptDetail(t *testing.T) {
@@ -252,6 +348,11 @@ func TestGetReceiptDetail(t *testing.T) {
 			WarehouseNumber:    "847",
 			TokenRefreshBuffer: 5 * time.Minute,
 		},
+		token: &TokenResponse{
+			IDToken:      generateTestJWT(time.Now().Add(1 * time.Hour).Unix()),
+			RefreshToken: "test-refresh-token",
+		},
+		tokenExpiry: time.Now().Add(1 * time.Hour),
 	}
 
 	receipt, err := client.GetReceiptDetail(context.Background(), "21134300501862509051323", "warehouse")
@@ -296,6 +397,9 @@ func (t *testTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	default:
 		testURL += req.URL.Path
 	}
+	if req.URL.RawQuery != "" {
+		testURL += "?" + req.URL.RawQuery
+	}
 
 	newReq, err := http.NewRequest(req.Method, testURL, req.Body)
 	if err != nil {
@@ -306,6 +410,42 @@ func (t *testTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	return http.DefaultTransport.RoundTrip(newReq)
 }
 
+// newMockClient returns a client with a valid token whose requests are served by handler.
+// Unset config fields are left empty, like a struct-literal client.
+func newMockClient(t *testing.T, config Config, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return &Client{
+		httpClient: &http.Client{
+			Transport: &testTransport{
+				baseURL: server.URL,
+			},
+		},
+		config: config,
+		token: &TokenResponse{
+			IDToken:      generateTestJWT(time.Now().Add(1 * time.Hour).Unix()),
+			RefreshToken: "test-refresh-token",
+		},
+		tokenExpiry: time.Now().Add(1 * time.Hour),
+	}
+}
+
+// writeGraphQLData encodes data as a successful GraphQL response.
+func writeGraphQLData(w http.ResponseWriter, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]interface{}{"data": data})
+}
+
+// receiptsRequestDate reads a M/DD/YYYY date variable of a receipts request as YYYY-MM-DD.
+func receiptsRequestDate(t *testing.T, req GraphQLRequest, name string) string {
+	t.Helper()
+	date, err := time.Parse("1/02/2006", req.Variables[name].(string))
+	require.NoError(t, err)
+	return date.Format("2006-01-02")
+}
+
 func TestClientWithLogger(t *testing.T) {
 	cleanup := SetupTestConfig(t)
 	defer cleanup()
@@ -411,6 +551,13 @@ func TestClientWithoutLogger(t *testing.T) {
 	}))
 	defer server.Close()
 
+	// Seed tokens on disk so NewClient picks them up
+	require.NoError(t, SaveTokens(&StoredTokens{
+		IDToken:      generateTestJWT(time.Now().Add(1 * time.Hour).Unix()),
+		RefreshToken: "test-refresh-token",
+		TokenExpiry:  time.Now().Add(1 * time.Hour),
+	}))
+
 	// Capture stdout/stderr to ensure nothing is printed
 	oldStdout := os.Stdout
 	oldStderr := os.Stderr
@@ -529,3 +676,41 @@ func TestClientLoggerWithJSON(t *testing.T) {
 		assert.Contains(t, logEntry, "level", "Log entry should contain 'level' field")
 	}
 }
+
+func TestGetGasReceipts(t *testing.T) {
+	var gotStart, gotEnd, gotType, gotSubType interface{}
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		gotStart, gotEnd = req.Variables["startDate"], req.Variables["endDate"]
+		gotType = req.Variables["documentType"]
+		gotSubType = req.Variables["documentSubType"]
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{"gasStation": 2, "receipts": []interface{}{}},
+		})
+	})
+
+	receipts, err := client.GetGasReceipts(context.Background(), "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+	assert.Equal(t, 2, receipts.GasStation)
+	assert.Equal(t, "1/01/2025", gotStart, "receipts API takes M/DD/YYYY")
+	assert.Equal(t, "1/31/2025", gotEnd)
+	assert.Equal(t, DocumentTypeFuel, gotType)
+	assert.Equal(t, DocumentSubTypeGas, gotSubType)
+
+	_, err = client.GetCarWashReceipts(context.Background(), "2/01/2025", "2/28/2025")
+	require.NoError(t, err)
+	assert.Equal(t, DocumentSubTypeCarWash, gotSubType)
+	assert.Equal(t, "2/01/2025", gotStart, "M/DD/YYYY passes through")
+}
+
+func TestGetReceipts_RejectsUnknownDocumentType(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("no request expected for invalid filters")
+	})
+
+	_, err := client.GetReceipts(context.Background(), "1/01/2025", "1/31/2025", "groceries", DocumentSubTypeAll)
+	var fe *FieldError
+	require.ErrorAs(t, err, &fe)
+	assert.Equal(t, "document_type", fe.Field)
+}
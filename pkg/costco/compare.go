@@ -0,0 +1,170 @@
+package costco
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Period-over-period comparison
+
+// DateRange is an inclusive range of dates in YYYY-MM-DD format.
+type DateRange struct {
+	StartDate string
+	EndDate   string
+}
+
+// PeriodComparison holds the changes from a baseline period A to period B.
+// This is returned by ComparePeriods.
+type PeriodComparison struct {
+	A, B        DateRange
+	Total       Delta             // Amount spent, net of refunds
+	Trips       Delta             // Receipts, excluding returns
+	Departments []DepartmentDelta // Largest absolute change first
+	Items       []ItemPriceChange // Items bought in both periods, largest absolute price change first
+}
+
+// Delta compares one value across the two periods.
+type Delta struct {
+	A       float64
+	B       float64
+	Change  float64 // B - A
+	Percent float64 // Change as a percent of A (0 when A is 0)
+}
+
+// DepartmentDelta is the spending change in one department.
+type DepartmentDelta struct {
+	Department int
+	Name       string
+	Spend      Delta
+}
+
+// ItemPriceChange is the change in average unit price for an item bought in both periods.
+type ItemPriceChange struct {
+	ItemNumber      string
+	ItemDescription string
+	UnitPrice       Delta
+}
+
+func newDelta(a, b float64) Delta {
+	d := Delta{A: roundTo(a, 2), B: roundTo(b, 2), Change: roundTo(b-a, 2)}
+	if a != 0 {
+		d.Percent = roundTo((b-a)/math.Abs(a)*100, 1)
+	}
+	return d
+}
+
+// periodStats are the totals ComparePeriods needs from one period.
+type periodStats struct {
+	total       float64
+	trips       int
+	departments map[int]float64
+	itemSpend   map[string]float64
+	itemUnits   map[string]int
+	itemNames   map[string]string
+}
+
+// ComparePeriods compares spending in period B against baseline period A: total
+// spend, trip count, spend per department, and the average unit price of every
+// item bought in both periods. Use it for year-over-year comparisons by passing
+// the same range one year apart. Amounts are net of discounts unless
+// Config.GrossPrices is set.
+//
+// Example:
+//
+//	lastYear := costco.DateRange{StartDate: "2024-01-01", EndDate: "2024-12-31"}
+//	thisYear := costco.DateRange{StartDate: "2025-01-01", EndDate: "2025-12-31"}
+//	cmp, err := client.ComparePeriods(ctx, lastYear, thisYear)
+//	fmt.Printf("Spend %+.2f (%+.1f%%), trips %+.0f\n", cmp.Total.Change, cmp.Total.Percent, cmp.Trips.Change)
+func (c *Client) ComparePeriods(ctx context.Context, periodA, periodB DateRange) (*PeriodComparison, error) {
+	a, err := c.periodStats(ctx, periodA)
+	if err != nil {
+		return nil, fmt.Errorf("period A: %w", err)
+	}
+	b, err := c.periodStats(ctx, periodB)
+	if err != nil {
+		return nil, fmt.Errorf("period B: %w", err)
+	}
+
+	cmp := &PeriodComparison{
+		A:     periodA,
+		B:     periodB,
+		Total: newDelta(a.total, b.total),
+		Trips: newDelta(float64(a.trips), float64(b.trips)),
+	}
+
+	departments := make(map[int]bool)
+	for dept := range a.departments {
+		departments[dept] = true
+	}
+	for dept := range b.departments {
+		departments[dept] = true
+	}
+	for dept := range departments {
+		cmp.Departments = append(cmp.Departments, DepartmentDelta{
+			Department: dept,
+			Name:       DepartmentName(dept),
+			Spend:      newDelta(a.departments[dept], b.departments[dept]),
+		})
+	}
+	sort.Slice(cmp.Departments, func(i, j int) bool {
+		ci, cj := math.Abs(cmp.Departments[i].Spend.Change), math.Abs(cmp.Departments[j].Spend.Change)
+		if ci != cj {
+			return ci > cj
+		}
+		return cmp.Departments[i].Department < cmp.Departments[j].Department
+	})
+
+	for itemNumber, unitsA := range a.itemUnits {
+		unitsB := b.itemUnits[itemNumber]
+		if unitsA <= 0 || unitsB <= 0 {
+			continue
+		}
+		cmp.Items = append(cmp.Items, ItemPriceChange{
+			ItemNumber:      itemNumber,
+			ItemDescription: b.itemNames[itemNumber],
+			UnitPrice:       newDelta(a.itemSpend[itemNumber]/float64(unitsA), b.itemSpend[itemNumber]/float64(unitsB)),
+		})
+	}
+	sort.Slice(cmp.Items, func(i, j int) bool {
+		pi, pj := math.Abs(cmp.Items[i].UnitPrice.Percent), math.Abs(cmp.Items[j].UnitPrice.Percent)
+		if pi != pj {
+			return pi > pj
+		}
+		return cmp.Items[i].ItemNumber < cmp.Items[j].ItemNumber
+	})
+
+	return cmp, nil
+}
+
+// periodStats totals the transactions in one period for ComparePeriods.
+func (c *Client) periodStats(ctx context.Context, period DateRange) (*periodStats, error) {
+	transactions, err := c.GetAllTransactionItems(ctx, period.StartDate, period.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &periodStats{
+		departments: make(map[int]float64),
+		itemSpend:   make(map[string]float64),
+		itemUnits:   make(map[string]int),
+		itemNames:   make(map[string]string),
+	}
+	for _, tx := range transactions {
+		stats.total += tx.Total
+		if !tx.IsRefund() {
+			stats.trips++
+		}
+		for _, item := range c.analyticsItems(tx.Items) {
+			stats.departments[item.ItemDepartmentNumber] += item.Amount
+			if item.IsDiscount() || item.Unit <= 0 || item.Amount <= 0 || item.ItemNumber == "" {
+				continue
+			}
+			stats.itemSpend[item.ItemNumber] += item.Amount
+			stats.itemUnits[item.ItemNumber] += item.Unit
+			stats.itemNames[item.ItemNumber] = c.itemName(item)
+		}
+	}
+	return stats, nil
+}
@@ -0,0 +1,68 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestComparePeriods(t *testing.T) {
+	details := map[string]map[string]interface{}{
+		"A1": {"transactionBarcode": "A1", "transactionDateTime": "2024-03-01T10:00:00", "total": 100.00,
+			"itemArray": []map[string]interface{}{
+				{"itemNumber": "1", "itemDescription01": "COFFEE", "unit": 2, "amount": 40.00, "itemDepartmentNumber": 17},
+				{"itemNumber": "2", "itemDescription01": "TOWELS", "unit": 1, "amount": 60.00, "itemDepartmentNumber": 14},
+			}},
+		"B1": {"transactionBarcode": "B1", "transactionDateTime": "2025-03-01T10:00:00", "total": 66.00,
+			"itemArray": []map[string]interface{}{
+				{"itemNumber": "1", "itemDescription01": "COFFEE", "unit": 1, "amount": 22.00, "itemDepartmentNumber": 17},
+				{"itemNumber": "3", "itemDescription01": "EGGS", "unit": 1, "amount": 44.00, "itemDepartmentNumber": 17},
+			}},
+		"B2": {"transactionBarcode": "B2", "transactionDateTime": "2025-04-01T10:00:00", "total": 84.00,
+			"itemArray": []map[string]interface{}{
+				{"itemNumber": "2", "itemDescription01": "TOWELS", "unit": 1, "amount": 54.00, "itemDepartmentNumber": 14},
+				{"itemNumber": "4", "itemDescription01": "SOAP", "unit": 1, "amount": 30.00, "itemDepartmentNumber": 14},
+			}},
+	}
+
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if barcode, ok := req.Variables["barcode"].(string); ok {
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{details[barcode]}},
+			})
+			return
+		}
+		receipts := []map[string]interface{}{{"transactionBarcode": "A1"}}
+		if strings.HasPrefix(receiptsRequestDate(t, req, "startDate"), "2025") {
+			receipts = []map[string]interface{}{{"transactionBarcode": "B1"}, {"transactionBarcode": "B2"}}
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{"receipts": receipts},
+		})
+	})
+
+	cmp, err := client.ComparePeriods(context.Background(),
+		DateRange{StartDate: "2024-01-01", EndDate: "2024-12-31"},
+		DateRange{StartDate: "2025-01-01", EndDate: "2025-12-31"})
+	require.NoError(t, err)
+
+	assert.Equal(t, Delta{A: 100, B: 150, Change: 50, Percent: 50}, cmp.Total)
+	assert.Equal(t, Delta{A: 1, B: 2, Change: 1, Percent: 100}, cmp.Trips)
+
+	require.Len(t, cmp.Departments, 2)
+	assert.Equal(t, 17, cmp.Departments[0].Department)
+	assert.Equal(t, Delta{A: 40, B: 66, Change: 26, Percent: 65}, cmp.Departments[0].Spend)
+	assert.Equal(t, Delta{A: 60, B: 84, Change: 24, Percent: 40}, cmp.Departments[1].Spend)
+
+	require.Len(t, cmp.Items, 2, "only items bought in both periods")
+	assert.Equal(t, "Coffee", cmp.Items[0].ItemDescription)
+	assert.Equal(t, Delta{A: 20, B: 22, Change: 2, Percent: 10}, cmp.Items[0].UnitPrice)
+	assert.Equal(t, Delta{A: 60, B: 54, Change: -6, Percent: -10}, cmp.Items[1].UnitPrice)
+}
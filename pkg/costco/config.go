@@ -16,7 +16,20 @@ const (
 	tokenFile  = "tokens.json"
 )
 
+// getConfigPath returns the directory of the active profile (see UseProfile).
 func getConfigPath() (string, error) {
+	base, err := getBaseConfigPath()
+	if err != nil {
+		return "", err
+	}
+	if activeProfile != "" {
+		return filepath.Join(base, profilesDir, activeProfile), nil
+	}
+	return base, nil
+}
+
+// getBaseConfigPath returns ~/.costco, which holds the default profile.
+func getBaseConfigPath() (string, error) {
 	// Allow overriding config path for testing
 	if testPath := os.Getenv("COSTCO_TEST_CONFIG_PATH"); testPath != "" {
 		return testPath, nil
@@ -40,6 +53,8 @@ func ensureConfigDir() error {
 // SaveConfig persists user configuration to disk at ~/.costco/config.json.
 // The config file stores non-sensitive settings like email and warehouse number.
 // The file is created with 0600 permissions (user read/write only).
+// When config.SecretBackend is SecretBackendKeychain, the email is written to the
+// OS keychain and only a pointer is stored in the file.
 //
 // Example:
 //
@@ -58,7 +73,14 @@ func SaveConfig(config *StoredConfig) error {
 		return err
 	}
 
-	data, err := json.MarshalIndent(config, "", "  ")
+	// Move sensitive fields into the secret backend before writing
+	persisted := *config
+	persisted.Email, err = storeSecret(config.SecretBackend, profileSecretKey("email"), config.Email)
+	if err != nil {
+		return fmt.Errorf("storing email secret: %w", err)
+	}
+
+	data, err := json.MarshalIndent(&persisted, "", "  ")
 	if err != nil {
 		return err
 	}
@@ -70,6 +92,7 @@ func SaveConfig(config *StoredConfig) error {
 // LoadConfig loads user configuration from ~/.costco/config.json.
 // Returns nil if the config file doesn't exist (not an error).
 // Returns an error only if the file exists but cannot be read or parsed.
+// Keychain pointers are resolved, so the returned config holds the real values.
 //
 // Example:
 //
@@ -100,6 +123,11 @@ func LoadConfig() (*StoredConfig, error) {
 		return nil, err
 	}
 
+	config.Email, err = resolveSecret(config.SecretBackend, config.Email)
+	if err != nil {
+		return nil, fmt.Errorf("resolving email secret: %w", err)
+	}
+
 	return &config, nil
 }
 
@@ -198,6 +226,41 @@ func ClearTokens() error {
 	return nil
 }
 
+// RemoveStaleTokens deletes ~/.costco/tokens.json when its refresh token has expired
+// and the file hasn't been updated for longer than maxAge. Such a file can never be
+// used again, so removing it lets the next import or login start from a clean slate.
+// Returns true if the file was removed. A non-positive maxAge disables cleanup.
+//
+// Example:
+//
+//	removed, err := costco.RemoveStaleTokens(7 * 24 * time.Hour)
+//	if removed {
+//	    fmt.Println("Removed expired tokens; run 'costco-cli login'")
+//	}
+func RemoveStaleTokens(maxAge time.Duration) (bool, error) {
+	if maxAge <= 0 {
+		return false, nil
+	}
+
+	tokens, err := LoadTokens()
+	if err != nil || tokens == nil {
+		return false, err
+	}
+
+	now := time.Now()
+	if tokens.RefreshTokenExpiresAt.IsZero() || now.Before(tokens.RefreshTokenExpiresAt) {
+		return false, nil
+	}
+	if now.Sub(tokens.UpdatedAt) < maxAge {
+		return false, nil
+	}
+
+	if err := ClearTokens(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // GetConfigInfo returns a human-readable summary of the current configuration state.
 // This includes the config directory path, whether config and token files exist,
 // token expiry status, and last update time. Useful for debugging and status checks.
@@ -207,6 +270,7 @@ func ClearTokens() error {
 //	info := costco.GetConfigInfo()
 //	fmt.Println(info)
 //	// Output:
+//	// Profile: default
 //	// Config directory: /Users/username/.costco
 //	// Config file: /Users/username/.costco/config.json (exists)
 //	// Token file: /Users/username/.costco/tokens.json (exists)
@@ -218,7 +282,8 @@ func GetConfigInfo() string {
 		return fmt.Sprintf("Error getting config path: %v", err)
 	}
 
-	info := fmt.Sprintf("Config directory: %s\n", configPath)
+	info := fmt.Sprintf("Profile: %s\n", ActiveProfile())
+	info += fmt.Sprintf("Config directory: %s\n", configPath)
 
 	// Check if config exists
 	configFile := filepath.Join(configPath, configFile)
@@ -1,6 +1,7 @@
 package costco
 
 import (
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"testing"
@@ -39,6 +40,27 @@ func TestSaveAndLoadConfig(t *testing.T) {
 	assert.Equal(t, "847", loadedConfig.WarehouseNumber)
 }
 
+func TestSaveAndLoadConfig_QueryDefaults(t *testing.T) {
+	tempDir := t.TempDir()
+	os.Setenv("COSTCO_TEST_CONFIG_PATH", tempDir)
+	defer os.Unsetenv("COSTCO_TEST_CONFIG_PATH")
+
+	config := &StoredConfig{
+		Email:                "test@example.com",
+		WarehouseNumber:      "1234",
+		DefaultDateRangeDays: 30,
+		OutputFormat:         OutputFormatJSON,
+		DocumentType:         "fuel",
+		DocumentSubType:      "all",
+	}
+	require.NoError(t, SaveConfig(config))
+
+	loadedConfig, err := LoadConfig()
+	require.NoError(t, err)
+	require.NotNil(t, loadedConfig)
+	assert.Equal(t, *config, *loadedConfig)
+}
+
 func TestLoadConfig_NotExists(t *testing.T) {
 	// Use a temporary test directory
 	tempDir := t.TempDir()
@@ -136,6 +158,88 @@ func TestClearTokens(t *testing.T) {
 	require.NoError(t, err)
 }
 
+// writeRawTokens writes tokens to disk without SaveTokens resetting UpdatedAt.
+func writeRawTokens(t *testing.T, dir string, tokens *StoredTokens) {
+	t.Helper()
+	data, err := json.Marshal(tokens)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, tokenFile), data, 0600))
+}
+
+func TestRemoveStaleTokens(t *testing.T) {
+	tests := []struct {
+		name          string
+		refreshExpiry time.Time
+		updatedAt     time.Time
+		maxAge        time.Duration
+		wantRemoved   bool
+	}{
+		{
+			name:          "expired and old",
+			refreshExpiry: time.Now().Add(-30 * 24 * time.Hour),
+			updatedAt:     time.Now().Add(-100 * 24 * time.Hour),
+			maxAge:        7 * 24 * time.Hour,
+			wantRemoved:   true,
+		},
+		{
+			name:          "expired but recently updated",
+			refreshExpiry: time.Now().Add(-1 * time.Hour),
+			updatedAt:     time.Now().Add(-1 * time.Hour),
+			maxAge:        7 * 24 * time.Hour,
+			wantRemoved:   false,
+		},
+		{
+			name:          "refresh token still valid",
+			refreshExpiry: time.Now().Add(24 * time.Hour),
+			updatedAt:     time.Now().Add(-100 * 24 * time.Hour),
+			maxAge:        7 * 24 * time.Hour,
+			wantRemoved:   false,
+		},
+		{
+			name:        "unknown refresh expiry",
+			updatedAt:   time.Now().Add(-100 * 24 * time.Hour),
+			maxAge:      7 * 24 * time.Hour,
+			wantRemoved: false,
+		},
+		{
+			name:          "cleanup disabled",
+			refreshExpiry: time.Now().Add(-30 * 24 * time.Hour),
+			updatedAt:     time.Now().Add(-100 * 24 * time.Hour),
+			maxAge:        -1,
+			wantRemoved:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tempDir := t.TempDir()
+			t.Setenv("COSTCO_TEST_CONFIG_PATH", tempDir)
+
+			writeRawTokens(t, tempDir, &StoredTokens{
+				IDToken:               "id",
+				RefreshToken:          "refresh",
+				RefreshTokenExpiresAt: tt.refreshExpiry,
+				UpdatedAt:             tt.updatedAt,
+			})
+
+			removed, err := RemoveStaleTokens(tt.maxAge)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRemoved, removed)
+
+			_, err = os.Stat(filepath.Join(tempDir, tokenFile))
+			assert.Equal(t, tt.wantRemoved, os.IsNotExist(err))
+		})
+	}
+}
+
+func TestRemoveStaleTokens_NoFile(t *testing.T) {
+	t.Setenv("COSTCO_TEST_CONFIG_PATH", t.TempDir())
+
+	removed, err := RemoveStaleTokens(time.Hour)
+	require.NoError(t, err)
+	assert.False(t, removed)
+}
+
 func TestGetConfigInfo(t *testing.T) {
 	// Use a temporary test directory
 	tempDir := t.TempDir()
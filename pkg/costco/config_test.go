@@ -73,3 +73,43 @@ func TestConfigPathDefault(t *testing.T) {
 	expectedPath := filepath.Join(home, configDir)
 	assert.Equal(t, expectedPath, configPath)
 }
+
+func TestStoredConfigDefaults(t *testing.T) {
+	// Empty profile falls back to library defaults
+	empty := &StoredConfig{}
+	assert.Equal(t, DefaultDateRangeDays, empty.DateRangeDays())
+	assert.Equal(t, OutputFormatText, empty.Format())
+
+	// Profile values take precedence
+	custom := &StoredConfig{
+		DefaultDateRangeDays: 30,
+		OutputFormat:         OutputFormatJSON,
+	}
+	assert.Equal(t, 30, custom.DateRangeDays())
+	assert.Equal(t, OutputFormatJSON, custom.Format())
+}
+
+func TestStoredConfigClientConfig(t *testing.T) {
+	stored := &StoredConfig{
+		Email:           "test@example.com",
+		WarehouseNumber: "1234",
+		DocumentType:    "fuel",
+		DocumentSubType: "all",
+	}
+
+	config := stored.ClientConfig()
+	assert.Equal(t, "test@example.com", config.Email)
+	assert.Equal(t, "1234", config.WarehouseNumber)
+	assert.Equal(t, "fuel", config.DocumentType)
+	assert.Equal(t, "all", config.DocumentSubType)
+}
+
+func TestNewClientAppliesConfigDefaults(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
+	client := NewClient(Config{Email: "test@example.com"})
+	assert.Equal(t, DefaultWarehouse, client.config.WarehouseNumber)
+	assert.Equal(t, DefaultDocumentType, client.config.DocumentType)
+	assert.Equal(t, DefaultDocumentSubType, client.config.DocumentSubType)
+}
@@ -1,14 +1,43 @@
 package costco
 
+import "time"
+
 // Library Version
 const (
-	Version = "0.3.11"
+	Version = "0.106.1"
 )
 
 // API Endpoints
 const (
-	TokenEndpoint   = "https://signin.costco.com/e0714dd4-784d-46d6-a278-3e29553483eb/b2c_1a_sso_wcs_signup_signin_209/oauth2/v2.0/token"
-	GraphQLEndpoint = "https://ecom-api.costco.com/ebusiness/order/v1/orders/graphql"
+	TokenEndpoint            = "https://signin.costco.com/e0714dd4-784d-46d6-a278-3e29553483eb/b2c_1a_sso_wcs_signup_signin_209/oauth2/v2.0/token"
+	GraphQLEndpoint          = "https://ecom-api.costco.com/ebusiness/order/v1/orders/graphql"
+	CartEndpoint             = "https://ecom-api.costco.com/ebusiness/cart/v1/cart"
+	ListsEndpoint            = "https://ecom-api.costco.com/ebusiness/lists/v1/lists"
+	PhotoEndpoint            = "https://ecom-api.costco.com/ebusiness/photo/v1/orders"
+	TireCenterEndpoint       = "https://ecom-api.costco.com/ebusiness/tire/v1"
+	OpticalEndpoint          = "https://ecom-api.costco.com/ebusiness/optical/v1/orders"
+	ShopCardHistoryEndpoint  = "https://ecom-api.costco.com/ebusiness/shopcard/v1/history"
+	BusinessDeliveryEndpoint = "https://ecom-api.costco.com/ebusiness/business-delivery/v1/orders"
+	SpecialOrderEndpoint     = "https://ecom-api.costco.com/ebusiness/special-order/v1/orders"
+	OrderInvoiceEndpoint     = "https://ecom-api.costco.com/ebusiness/order/v1/invoices"
+
+	SearchEndpoint = "https://search.costco.com/api/apps/www_costco_com/query/www_costco_com_search"
+
+	WarehouseLocatorEndpoint = "https://www.costco.com/AjaxWarehouseBrowseLookupView"
+	WarehouseDetailEndpoint  = "https://www.costco.com/AjaxWarehouseDetailView"
+	GasPricesEndpoint        = "https://www.costco.com/AjaxGetGasPrices"
+	InventoryEndpoint        = "https://www.costco.com/AjaxGetInventoryDetail"
+	ItemPriceEndpoint        = "https://www.costco.com/AjaxGetContractPrice"
+	ShopCardBalanceEndpoint  = "https://www.costco.com/AjaxCheckShopCardBalance"
+	ReviewsEndpoint          = "https://www.costco.com/AjaxGetItemReviews"
+	OffersEndpoint           = "https://www.costco.com/AjaxGetWarehouseSavings"
+)
+
+// costco.com pages, for links to what the API can't do. Canadian members use the
+// same paths on costco.ca.
+const (
+	OrderDetailsPage       = "https://www.costco.com/OrderStatusDetailsView"                                 // ?orderId=<order number>
+	OrdersAndPurchasesPage = "https://www.costco.com/myaccount/#/app/" + WCSClientID + "/ordersandpurchases" // Online orders and warehouse receipts
 )
 
 // OAuth2/OIDC Configuration
@@ -50,9 +79,49 @@ const (
 	CostcoService     = "restOrders"
 )
 
+// Output Formats
+const (
+	OutputFormatText  = "text"
+	OutputFormatJSON  = "json"
+	OutputFormatJSONL = "jsonl" // One JSON object per line
+)
+
+// Receipt document types accepted by GetReceipts
+const (
+	DocumentTypeAll       = "all"
+	DocumentTypeWarehouse = "warehouse"
+	DocumentTypeFuel      = "fuel"
+)
+
+// DocumentTypeCarWash is the GetReceiptDetail document type for car wash receipts.
+// List them with GetCarWashReceipts; GetReceipts does not accept it as a filter.
+const DocumentTypeCarWash = "carwash"
+
+// Receipt types reported in Receipt.ReceiptType
+const (
+	ReceiptTypeWarehouse  = "In-Warehouse"
+	ReceiptTypeGasStation = "Gas Station"
+	ReceiptTypeCarWash    = "Car Wash"
+)
+
+// Receipt document sub-types accepted by GetReceipts; the gas and car wash
+// sub-types narrow DocumentTypeFuel results
+const (
+	DocumentSubTypeAll           = "all"
+	DocumentSubTypeGas           = "gas"
+	DocumentSubTypeCarWash       = "carwash"
+	DocumentSubTypeGasAndCarWash = "gasAndCarWash"
+)
+
 // Default Values
 const (
-	DefaultWarehouse = "847"
-	DefaultPageSize  = 10
-	DefaultTimeout   = 30 // seconds
+	DefaultWarehouse        = "847"
+	DefaultPageSize         = 10
+	DefaultTimeout          = 30 // seconds
+	DefaultDateRangeDays    = 90
+	DefaultOutputFormat     = OutputFormatText
+	DefaultDocumentType     = DocumentTypeAll
+	DefaultDocumentSubType  = DocumentSubTypeAll
+	DefaultStaleTokenMaxAge = 7 * 24 * time.Hour
+	DefaultDetailWorkers    = 4 // Concurrent receipt detail fetches in GetAllTransactionItems
 )
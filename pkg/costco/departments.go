@@ -0,0 +1,138 @@
+package costco
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// Department number to name mapping
+
+const departmentsFile = "departments.json"
+
+//go:embed departments.json
+var embeddedDepartments []byte
+
+var (
+	departmentsOnce     sync.Once
+	departmentNames     map[int]string
+	departmentMu        sync.RWMutex
+	departmentOverrides map[int]string
+
+	defaultDepartmentsOnce sync.Once
+)
+
+// DepartmentName returns the human-readable name of a warehouse department number,
+// e.g. 53 → "Produce". Overrides loaded by LoadDepartmentOverrides take precedence
+// over the built-in mapping; unknown numbers are returned as "Department N".
+//
+// Example:
+//
+//	for dept, stats := range summary {
+//	    fmt.Printf("%s: $%.2f\n", costco.DepartmentName(dept), stats.Total)
+//	}
+func DepartmentName(department int) string {
+	departmentMu.RLock()
+	name, ok := departmentOverrides[department]
+	departmentMu.RUnlock()
+	if ok {
+		return name
+	}
+
+	switch department {
+	case DepartmentPhotoCenter:
+		return "Photo Center"
+	case DepartmentOptical:
+		return "Optical"
+	}
+
+	departmentsOnce.Do(func() {
+		departmentNames, _ = parseDepartmentNames(embeddedDepartments)
+	})
+	if name, ok := departmentNames[department]; ok {
+		return name
+	}
+	return fmt.Sprintf("Department %d", department)
+}
+
+// departmentNumbers returns every named department: the built-in mapping, overrides,
+// and the Photo Center and Optical pseudo-departments.
+func departmentNumbers() []int {
+	departmentsOnce.Do(func() {
+		departmentNames, _ = parseDepartmentNames(embeddedDepartments)
+	})
+	numbers := []int{DepartmentPhotoCenter, DepartmentOptical}
+	for number := range departmentNames {
+		numbers = append(numbers, number)
+	}
+	departmentMu.RLock()
+	for number := range departmentOverrides {
+		if _, builtIn := departmentNames[number]; !builtIn {
+			numbers = append(numbers, number)
+		}
+	}
+	departmentMu.RUnlock()
+	sort.Ints(numbers)
+	return numbers
+}
+
+// LoadDepartmentOverrides reads corrections to the built-in department names from a
+// JSON file mapping department numbers to names, e.g. {"42": "Furniture & Mattresses"}.
+// An empty path means ~/.costco/departments.json, which the first NewClient loads
+// automatically. A missing file is not an error and keeps the current overrides.
+func LoadDepartmentOverrides(path string) error {
+	if path == "" {
+		configPath, err := getConfigPath()
+		if err != nil {
+			return err
+		}
+		path = filepath.Join(configPath, departmentsFile)
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	overrides, err := parseDepartmentNames(data)
+	if err != nil {
+		return fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	departmentMu.Lock()
+	departmentOverrides = overrides
+	departmentMu.Unlock()
+
+	return nil
+}
+
+// loadDefaultDepartmentOverrides loads ~/.costco/departments.json once per process,
+// so creating another client never replaces overrides a caller loaded from elsewhere.
+func loadDefaultDepartmentOverrides() (err error) {
+	defaultDepartmentsOnce.Do(func() { err = LoadDepartmentOverrides("") })
+	return err
+}
+
+// parseDepartmentNames decodes a {"number": "name"} JSON object.
+func parseDepartmentNames(data []byte) (map[int]string, error) {
+	var raw map[string]string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	names := make(map[int]string, len(raw))
+	for key, name := range raw {
+		number, err := strconv.Atoi(key)
+		if err != nil {
+			return nil, fmt.Errorf("department %q is not a number", key)
+		}
+		names[number] = name
+	}
+	return names, nil
+}
@@ -0,0 +1,47 @@
+package costco
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepartmentName(t *testing.T) {
+	assert.Equal(t, "Produce", DepartmentName(53))
+	assert.Equal(t, "Photo Center", DepartmentName(DepartmentPhotoCenter))
+	assert.Equal(t, "Optical", DepartmentName(DepartmentOptical))
+	assert.Equal(t, "Department 999", DepartmentName(999))
+}
+
+func TestLoadDepartmentOverrides(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("COSTCO_TEST_CONFIG_PATH", dir)
+	t.Cleanup(func() {
+		departmentMu.Lock()
+		departmentOverrides = nil
+		departmentMu.Unlock()
+	})
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, departmentsFile), []byte(`{"53": "Fresh Produce", "999": "Gift Cards"}`), 0600))
+	require.NoError(t, LoadDepartmentOverrides(""))
+	assert.Equal(t, "Fresh Produce", DepartmentName(53))
+	assert.Equal(t, "Gift Cards", DepartmentName(999))
+	assert.Equal(t, "Meat", DepartmentName(63), "other departments keep their built-in names")
+
+	bad := filepath.Join(dir, "bad.json")
+	require.NoError(t, os.WriteFile(bad, []byte(`{"produce": "Produce"}`), 0600))
+	assert.ErrorContains(t, LoadDepartmentOverrides(bad), "not a number")
+
+	require.NoError(t, LoadDepartmentOverrides(filepath.Join(dir, "missing.json")))
+	assert.Equal(t, "Fresh Produce", DepartmentName(53), "a missing file keeps overrides")
+
+	NewClient(Config{Email: "test@example.com"})
+	custom := filepath.Join(dir, "custom.json")
+	require.NoError(t, os.WriteFile(custom, []byte(`{"53": "Veg"}`), 0600))
+	require.NoError(t, LoadDepartmentOverrides(custom))
+	NewClient(Config{Email: "test@example.com"})
+	assert.Equal(t, "Veg", DepartmentName(53), "new clients keep overrides loaded from elsewhere")
+}
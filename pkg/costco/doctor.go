@@ -0,0 +1,293 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Self-diagnostics
+
+// CheckStatus is the outcome of one diagnostic check.
+type CheckStatus string
+
+const (
+	CheckPass CheckStatus = "pass"
+	CheckWarn CheckStatus = "warn" // Works now, but needs attention
+	CheckFail CheckStatus = "fail"
+	CheckSkip CheckStatus = "skip" // Not run because an earlier check failed
+)
+
+// Diagnostic thresholds
+const (
+	// maxClockSkew is the clock difference from Costco's servers beyond which token
+	// expiry checks go wrong.
+	maxClockSkew = 2 * time.Minute
+	// refreshTokenWarning is how soon before the refresh token expires Diagnose warns.
+	refreshTokenWarning = 7 * 24 * time.Hour
+)
+
+// DiagnosticCheck is the result of one check run by Diagnose.
+type DiagnosticCheck struct {
+	Name   string      `json:"name"`
+	Status CheckStatus `json:"status"`
+	Detail string      `json:"detail"`
+	Hint   string      `json:"hint,omitempty"` // How to fix a warning or failure
+}
+
+// Diagnose checks everything the client depends on and reports each check in order:
+// the config, the stored tokens, whether Costco's endpoints can be reached, the
+// local clock against the server's, the local transaction store, and the shape of
+// the receipts and orders responses, which Costco has changed before. It never
+// returns an error; failures are reported as checks with a hint on how to fix them.
+//
+// Example:
+//
+//	for _, check := range client.Diagnose(ctx) {
+//	    fmt.Printf("[%s] %s: %s\n", check.Status, check.Name, check.Detail)
+//	    if check.Hint != "" {
+//	        fmt.Println("  ", check.Hint)
+//	    }
+//	}
+func (c *Client) Diagnose(ctx context.Context) []DiagnosticCheck {
+	checks := []DiagnosticCheck{c.checkConfig()}
+
+	tokens := c.checkTokens(time.Now())
+	checks = append(checks, tokens)
+
+	reach, serverTime := c.checkReachability(ctx)
+	checks = append(checks, reach, checkClock(serverTime, time.Now()), checkStore())
+
+	if tokens.Status == CheckFail || reach.Status == CheckFail {
+		checks = append(checks, DiagnosticCheck{Name: "schema", Status: CheckSkip, Detail: "needs valid tokens and a reachable API"})
+	} else {
+		checks = append(checks, c.checkSchema(ctx))
+	}
+	return checks
+}
+
+func (c *Client) checkConfig() DiagnosticCheck {
+	check := DiagnosticCheck{Name: "config"}
+	if c.configErr != nil {
+		check.Status, check.Detail = CheckFail, c.configErr.Error()
+		check.Hint = "Fix the listed fields in ~/.costco/config.json or run 'costco-cli setup'"
+		return check
+	}
+	check.Status = CheckPass
+	check.Detail = fmt.Sprintf("warehouse %s, receipts %s/%s", c.config.WarehouseNumber, c.config.DocumentType, c.config.DocumentSubType)
+	return check
+}
+
+func (c *Client) checkTokens(now time.Time) DiagnosticCheck {
+	check := DiagnosticCheck{Name: "tokens"}
+	c.mu.RLock()
+	signedIn := c.token != nil && c.token.RefreshToken != ""
+	idExpiry := c.tokenExpiry
+	c.mu.RUnlock()
+	if !signedIn {
+		check.Status, check.Detail = CheckFail, "not signed in"
+		check.Hint = "Sign in with 'costco-cli login'"
+		return check
+	}
+
+	var refreshExpiry time.Time
+	if c.config.Tokens != nil {
+		refreshExpiry = c.config.Tokens.RefreshTokenExpiresAt
+	} else if stored, err := LoadTokens(); err == nil && stored != nil {
+		refreshExpiry = stored.RefreshTokenExpiresAt
+	}
+
+	switch {
+	case !refreshExpiry.IsZero() && now.After(refreshExpiry):
+		check.Status = CheckFail
+		check.Detail = fmt.Sprintf("refresh token expired %s", refreshExpiry.Format("2006-01-02"))
+		check.Hint = "Sign in again with 'costco-cli login'"
+	case !refreshExpiry.IsZero() && refreshExpiry.Sub(now) < refreshTokenWarning:
+		check.Status = CheckWarn
+		check.Detail = fmt.Sprintf("refresh token expires %s", refreshExpiry.Format("2006-01-02 15:04"))
+		check.Hint = "Sign in again with 'costco-cli login' before it expires"
+	default:
+		check.Status = CheckPass
+		if now.Before(idExpiry) {
+			check.Detail = fmt.Sprintf("ID token valid until %s", idExpiry.Format("15:04"))
+		} else {
+			check.Detail = "ID token expired; it is refreshed on the next request"
+		}
+		if !refreshExpiry.IsZero() {
+			check.Detail += fmt.Sprintf(", refresh token until %s", refreshExpiry.Format("2006-01-02"))
+		}
+	}
+	return check
+}
+
+// checkReachability makes a HEAD request to the sign-in and order endpoints. Any HTTP
+// response counts as reachable. It returns the server time from the first response
+// with a Date header, or the zero time.
+func (c *Client) checkReachability(ctx context.Context) (DiagnosticCheck, time.Time) {
+	check := DiagnosticCheck{Name: "reachability", Status: CheckPass}
+	var serverTime time.Time
+	var reached []string
+	for _, endpoint := range []string{TokenEndpoint, GraphQLEndpoint} {
+		req, err := http.NewRequestWithContext(ctx, http.MethodHead, endpoint, nil)
+		if err != nil {
+			return DiagnosticCheck{Name: check.Name, Status: CheckFail, Detail: err.Error()}, serverTime
+		}
+		host := req.URL.Host
+		start := time.Now()
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			check.Status = CheckFail
+			var urlErr *url.Error
+			if errors.As(err, &urlErr) {
+				err = urlErr.Err // Drop the long endpoint URL
+			}
+			check.Detail = fmt.Sprintf("%s unreachable: %v", host, err)
+			check.Hint = "Check your internet connection, proxy, and DNS"
+			return check, serverTime
+		}
+		resp.Body.Close()
+		reached = append(reached, fmt.Sprintf("%s (%d ms)", host, time.Since(start).Milliseconds()))
+		if date, err := http.ParseTime(resp.Header.Get("Date")); err == nil && serverTime.IsZero() {
+			serverTime = date
+		}
+	}
+	check.Detail = strings.Join(reached, ", ")
+	return check, serverTime
+}
+
+// checkClock compares the local clock with the server's; token expiry is checked
+// against the local clock.
+func checkClock(serverTime, now time.Time) DiagnosticCheck {
+	check := DiagnosticCheck{Name: "clock"}
+	if serverTime.IsZero() {
+		check.Status, check.Detail = CheckSkip, "no server time to compare with"
+		return check
+	}
+	skew := now.Sub(serverTime).Round(time.Second)
+	if skew < 0 {
+		skew = -skew
+	}
+	if skew > maxClockSkew {
+		check.Status = CheckFail
+		check.Detail = fmt.Sprintf("local clock is %s off from Costco's servers", skew)
+		check.Hint = "Sync the system clock (enable NTP); tokens look expired or valid at the wrong times"
+		return check
+	}
+	check.Status, check.Detail = CheckPass, fmt.Sprintf("within %s of Costco's servers", max(skew, time.Second))
+	return check
+}
+
+// checkStore reads the local transaction store and checks each synced range.
+func checkStore() DiagnosticCheck {
+	check := DiagnosticCheck{Name: "local store"}
+	hint := "Delete transactions.json in the config directory and run 'costco-cli sync -full' to rebuild it"
+	if configPath, err := getConfigPath(); err == nil {
+		hint = fmt.Sprintf("Delete %s and run 'costco-cli sync -full' to rebuild it", filepath.Join(configPath, transactionsFile))
+	}
+	store, err := loadTransactionStore()
+	if err != nil {
+		check.Status, check.Detail, check.Hint = CheckFail, fmt.Sprintf("unreadable: %v", err), hint
+		return check
+	}
+	if len(store.Segments) == 0 && store.Orders == nil {
+		check.Status, check.Detail = CheckPass, "none (run 'costco-cli sync' to create one)"
+		return check
+	}
+
+	var problems []string
+	receipts := 0
+	for filter, segment := range store.Segments {
+		start, startErr := time.Parse(storeDateLayout, segment.Start)
+		watermark, watermarkErr := time.Parse(storeDateLayout, segment.Watermark)
+		if startErr != nil || watermarkErr != nil || watermark.Before(start) {
+			problems = append(problems, fmt.Sprintf("%s has an invalid range %q to %q", filter, segment.Start, segment.Watermark))
+			continue
+		}
+		seen := make(map[string]bool)
+		for _, tx := range segment.Transactions {
+			if seen[tx.Key()] {
+				problems = append(problems, fmt.Sprintf("%s has duplicate receipt %s", filter, tx.TransactionBarcode))
+				break
+			}
+			seen[tx.Key()] = true
+		}
+		receipts += len(segment.Transactions)
+	}
+	if len(problems) > 0 {
+		check.Status, check.Detail, check.Hint = CheckFail, strings.Join(problems, "; "), hint
+		return check
+	}
+	check.Status = CheckPass
+	check.Detail = fmt.Sprintf("%d receipts in %d synced ranges", receipts, len(store.Segments))
+	return check
+}
+
+// checkSchema fetches a week of receipts and online orders and checks that each
+// response has the shape the client expects. Costco has switched receipts between an
+// object and an array before; GetReceipts falls back to the array form.
+func (c *Client) checkSchema(ctx context.Context) DiagnosticCheck {
+	check := DiagnosticCheck{Name: "schema"}
+	now := time.Now()
+	start, end := now.AddDate(0, 0, -7).Format(storeDateLayout), now.Format(storeDateLayout)
+
+	var receipts struct {
+		ReceiptsWithCounts json.RawMessage `json:"receiptsWithCounts"`
+	}
+	err := c.executeGraphQL(ctx, ReceiptsQuery, map[string]interface{}{
+		"startDate": receiptsAPIDate(start), "endDate": receiptsAPIDate(end),
+		"documentType": DocumentTypeAll, "documentSubType": DocumentSubTypeAll,
+	}, &receipts)
+	if err != nil {
+		check.Status, check.Detail = CheckFail, fmt.Sprintf("receipts query: %v", err)
+		check.Hint = "Sign in again with 'costco-cli login'; if that doesn't help, the API may have changed"
+		return check
+	}
+
+	var orders struct {
+		GetOnlineOrders json.RawMessage `json:"getOnlineOrders"`
+	}
+	err = c.executeGraphQL(ctx, OnlineOrdersQuery, map[string]interface{}{
+		"startDate": start, "endDate": end, "pageNumber": 1, "pageSize": 1,
+		"warehouseNumber": c.config.WarehouseNumber,
+	}, &orders)
+	if err != nil {
+		check.Status, check.Detail = CheckFail, fmt.Sprintf("online orders query: %v", err)
+		check.Hint = "Sign in again with 'costco-cli login'; if that doesn't help, the API may have changed"
+		return check
+	}
+
+	receiptsShape, ordersShape := jsonShape(receipts.ReceiptsWithCounts), jsonShape(orders.GetOnlineOrders)
+	switch {
+	case receiptsShape == "object" && ordersShape == "array":
+		check.Status, check.Detail = CheckPass, "receipts and online orders have the expected shape"
+	case receiptsShape == "array" && ordersShape == "array":
+		check.Status = CheckWarn
+		check.Detail = "receipts came back as an array instead of an object"
+		check.Hint = "The array fallback handles this; please report it so the fallback can stay"
+	default:
+		check.Status = CheckFail
+		check.Detail = fmt.Sprintf("unexpected shapes: receipts %s (want object), online orders %s (want array)", receiptsShape, ordersShape)
+		check.Hint = "The API has changed; update costco-go or report an issue"
+	}
+	return check
+}
+
+// jsonShape names the JSON type of a raw value: object, array, null, or value.
+func jsonShape(raw json.RawMessage) string {
+	switch s := strings.TrimSpace(string(raw)); {
+	case strings.HasPrefix(s, "{"):
+		return "object"
+	case strings.HasPrefix(s, "["):
+		return "array"
+	case s == "" || s == "null":
+		return "null"
+	default:
+		return "value"
+	}
+}
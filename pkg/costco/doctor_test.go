@@ -0,0 +1,89 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func diagnosticStatuses(checks []DiagnosticCheck) map[string]CheckStatus {
+	statuses := make(map[string]CheckStatus)
+	for _, check := range checks {
+		statuses[check.Name] = check.Status
+	}
+	return statuses
+}
+
+func TestDiagnose(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
+	receipts := interface{}(map[string]interface{}{"receipts": []interface{}{}})
+	client := newMockClient(t, Config{WarehouseNumber: "847"}, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Date", time.Now().UTC().Format(http.TimeFormat))
+		if r.Method == http.MethodHead {
+			return
+		}
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if req.Query == OnlineOrdersQuery {
+			writeGraphQLData(w, map[string]interface{}{"getOnlineOrders": []interface{}{}})
+			return
+		}
+		writeGraphQLData(w, map[string]interface{}{"receiptsWithCounts": receipts})
+	})
+
+	checks := client.Diagnose(context.Background())
+	assert.Equal(t, map[string]CheckStatus{
+		"config": CheckPass, "tokens": CheckPass, "reachability": CheckPass,
+		"clock": CheckPass, "local store": CheckPass, "schema": CheckPass,
+	}, diagnosticStatuses(checks))
+
+	receipts = []interface{}{}
+	configPath, err := getConfigPath()
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(configPath, transactionsFile), []byte("{not json"), 0600))
+
+	checks = client.Diagnose(context.Background())
+	statuses := diagnosticStatuses(checks)
+	assert.Equal(t, CheckWarn, statuses["schema"], "receipts as an array")
+	assert.Equal(t, CheckFail, statuses["local store"])
+	for _, check := range checks {
+		if check.Status != CheckPass {
+			assert.NotEmpty(t, check.Hint, check.Name)
+		}
+	}
+}
+
+func TestDiagnose_NotSignedIn(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected %s request", r.Method)
+	})
+	client.token = nil
+	client.httpClient.Transport = &testTransport{baseURL: "http://127.0.0.1:1"} // Nothing listens on port 1
+
+	statuses := diagnosticStatuses(client.Diagnose(context.Background()))
+	assert.Equal(t, CheckFail, statuses["tokens"])
+	assert.Equal(t, CheckFail, statuses["reachability"])
+	assert.Equal(t, CheckSkip, statuses["clock"])
+	assert.Equal(t, CheckSkip, statuses["schema"])
+}
+
+func TestCheckClock(t *testing.T) {
+	server := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, CheckPass, checkClock(server, server.Add(30*time.Second)).Status)
+	check := checkClock(server, server.Add(-10*time.Minute))
+	assert.Equal(t, CheckFail, check.Status)
+	assert.Contains(t, check.Detail, "10m0s off")
+	assert.Equal(t, CheckSkip, checkClock(time.Time{}, server).Status)
+}
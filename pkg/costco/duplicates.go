@@ -0,0 +1,144 @@
+package costco
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Duplicate and overlapping purchases
+
+// DefaultRepeatWindowDays is the repeat-purchase window used when none is given.
+const DefaultRepeatWindowDays = 14
+
+// DuplicateReport flags purchases that may be accidental.
+// This is returned by FindDuplicatePurchases.
+type DuplicateReport struct {
+	Repeats []RepeatPurchase  // Items bought again within the window, chronological
+	Charges []DuplicateCharge // Transactions with identical totals at identical times
+}
+
+// PurchaseRef identifies one purchase of an item.
+type PurchaseRef struct {
+	TransactionBarcode string
+	TransactionDate    time.Time
+	WarehouseName      string
+	Units              int
+	Amount             float64
+}
+
+// RepeatPurchase is an item bought twice within the repeat window. Two lines for
+// the same item on one receipt (DaysApart 0, same barcode) may be a double scan.
+type RepeatPurchase struct {
+	ItemNumber      string
+	ItemDescription string
+	First           PurchaseRef
+	Second          PurchaseRef
+	DaysApart       int
+}
+
+// SameReceipt reports whether both purchases are on the same receipt.
+func (r RepeatPurchase) SameReceipt() bool {
+	return r.First.TransactionBarcode == r.Second.TransactionBarcode
+}
+
+// DuplicateCharge is a set of transactions with the same total at the same timestamp.
+type DuplicateCharge struct {
+	TransactionDate     time.Time
+	Total               float64
+	TransactionBarcodes []string
+}
+
+// FindDuplicatePurchases flags items purchased again within windowDays of a previous
+// purchase (e.g. vitamins bought twice in 10 days) and transactions that look like
+// duplicate charges: identical totals at identical timestamps. A windowDays of zero
+// or less uses DefaultRepeatWindowDays. Returns are ignored.
+//
+// The startDate and endDate should be in YYYY-MM-DD format.
+//
+// Example:
+//
+//	report, err := client.FindDuplicatePurchases(ctx, "2025-01-01", "2025-12-31", 10)
+//	for _, r := range report.Repeats {
+//	    fmt.Printf("%s bought %s and %s\n", r.ItemDescription,
+//	        r.First.TransactionDate.Format("Jan 2"), r.Second.TransactionDate.Format("Jan 2"))
+//	}
+func (c *Client) FindDuplicatePurchases(ctx context.Context, startDate, endDate string, windowDays int) (*DuplicateReport, error) {
+	if windowDays <= 0 {
+		windowDays = DefaultRepeatWindowDays
+	}
+
+	transactions, err := c.GetAllTransactionItems(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(transactions, func(i, j int) bool {
+		return transactions[i].TransactionDate.Before(transactions[j].TransactionDate)
+	})
+
+	report := &DuplicateReport{}
+	last := make(map[string]PurchaseRef)
+	charges := make(map[string]*DuplicateCharge)
+	var chargeKeys []string
+
+	for _, tx := range transactions {
+		if tx.IsRefund() {
+			continue
+		}
+
+		if tx.Total != 0 {
+			key := fmt.Sprintf("%d|%.2f", tx.TransactionDate.Unix(), tx.Total)
+			charge, exists := charges[key]
+			if !exists {
+				charge = &DuplicateCharge{TransactionDate: tx.TransactionDate, Total: tx.Total}
+				charges[key] = charge
+				chargeKeys = append(chargeKeys, key)
+			}
+			charge.TransactionBarcodes = append(charge.TransactionBarcodes, tx.TransactionBarcode)
+		}
+
+		for _, item := range c.analyticsItems(tx.Items) {
+			if item.IsDiscount() || item.Unit <= 0 || item.ItemNumber == "" {
+				continue
+			}
+			purchase := PurchaseRef{
+				TransactionBarcode: tx.TransactionBarcode,
+				TransactionDate:    tx.TransactionDate,
+				WarehouseName:      tx.WarehouseName,
+				Units:              item.Unit,
+				Amount:             item.Amount,
+			}
+			if previous, seen := last[item.ItemNumber]; seen {
+				days := daysBetween(previous.TransactionDate, tx.TransactionDate)
+				if days <= windowDays {
+					report.Repeats = append(report.Repeats, RepeatPurchase{
+						ItemNumber:      item.ItemNumber,
+						ItemDescription: c.itemName(item),
+						First:           previous,
+						Second:          purchase,
+						DaysApart:       days,
+					})
+				}
+			}
+			last[item.ItemNumber] = purchase
+		}
+	}
+
+	for _, key := range chargeKeys {
+		if charge := charges[key]; len(charge.TransactionBarcodes) > 1 {
+			sort.Strings(charge.TransactionBarcodes)
+			report.Charges = append(report.Charges, *charge)
+		}
+	}
+
+	return report, nil
+}
+
+// daysBetween returns the number of calendar days from a to b.
+func daysBetween(a, b time.Time) int {
+	a = time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	b = time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int(b.Sub(a).Hours() / 24)
+}
@@ -0,0 +1,80 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindDuplicatePurchases(t *testing.T) {
+	details := map[string]map[string]interface{}{
+		"A": {
+			"transactionBarcode": "A", "transactionDateTime": "2025-03-01T10:00:00", "total": 40.00,
+			"itemArray": []map[string]interface{}{
+				{"itemNumber": "1", "itemDescription01": "VITAMINS", "unit": 1, "amount": 20.00},
+				{"itemNumber": "2", "itemDescription01": "EGGS", "unit": 1, "amount": 10.00},
+				{"itemNumber": "2", "itemDescription01": "EGGS", "unit": 1, "amount": 10.00},
+			},
+		},
+		"B": {
+			"transactionBarcode": "B", "transactionDateTime": "2025-03-08T12:30:00", "total": 20.00,
+			"itemArray": []map[string]interface{}{
+				{"itemNumber": "1", "itemDescription01": "VITAMINS", "unit": 1, "amount": 20.00},
+			},
+		},
+		"C": {
+			"transactionBarcode": "C", "transactionDateTime": "2025-03-08T12:30:00", "total": 20.00,
+			"itemArray": []map[string]interface{}{
+				{"itemNumber": "3", "itemDescription01": "BREAD", "unit": 1, "amount": 20.00},
+			},
+		},
+		"D": {
+			"transactionBarcode": "D", "transactionDateTime": "2025-04-20T10:00:00", "total": 20.00,
+			"itemArray": []map[string]interface{}{
+				{"itemNumber": "1", "itemDescription01": "VITAMINS", "unit": 1, "amount": 20.00},
+			},
+		},
+	}
+
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if barcode, ok := req.Variables["barcode"].(string); ok {
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{details[barcode]}},
+			})
+			return
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{
+				"receipts": []map[string]interface{}{
+					{"transactionBarcode": "D"}, {"transactionBarcode": "C"},
+					{"transactionBarcode": "B"}, {"transactionBarcode": "A"},
+				},
+			},
+		})
+	})
+
+	report, err := client.FindDuplicatePurchases(context.Background(), "2025-01-01", "2025-12-31", 10)
+	require.NoError(t, err)
+
+	require.Len(t, report.Repeats, 2)
+	assert.Equal(t, "Eggs", report.Repeats[0].ItemDescription)
+	assert.True(t, report.Repeats[0].SameReceipt(), "possible double scan")
+	assert.Equal(t, 0, report.Repeats[0].DaysApart)
+
+	vitamins := report.Repeats[1]
+	assert.Equal(t, "1", vitamins.ItemNumber)
+	assert.Equal(t, "A", vitamins.First.TransactionBarcode)
+	assert.Equal(t, "B", vitamins.Second.TransactionBarcode)
+	assert.Equal(t, 7, vitamins.DaysApart)
+	assert.False(t, vitamins.SameReceipt())
+
+	require.Len(t, report.Charges, 1)
+	assert.Equal(t, 20.00, report.Charges[0].Total)
+	assert.Equal(t, []string{"B", "C"}, report.Charges[0].TransactionBarcodes)
+}
@@ -0,0 +1,269 @@
+package costco
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Exporting transactions and line items as CSV, JSON, or JSON Lines
+
+// Export formats for ExportOptions.Format
+const (
+	ExportFormatCSV   = "csv"
+	ExportFormatJSON  = "json"
+	ExportFormatJSONL = "jsonl" // One object per line, for streaming into jq or a database
+)
+
+// Export levels for ExportOptions.Level
+const (
+	ExportLevelTransaction = "transaction" // One row per receipt
+	ExportLevelItem        = "item"        // One row per receipt line, discounts included
+)
+
+// ExportOptions controls WriteExport.
+type ExportOptions struct {
+	Format  string   // ExportFormatCSV, ExportFormatJSON, or ExportFormatJSONL (default: CSV)
+	Level   string   // ExportLevelTransaction or ExportLevelItem (default: transaction)
+	Columns []string // Columns to write, in order (default: ExportColumns(Level))
+}
+
+// exportColumn is one exportable field. Transaction-level columns ignore item.
+type exportColumn struct {
+	name  string
+	value func(tx *TransactionWithItems, item *ReceiptItem) interface{}
+}
+
+var transactionExportColumns = []exportColumn{
+	{"date", func(tx *TransactionWithItems, _ *ReceiptItem) interface{} {
+		return tx.TransactionDate.Format("2006-01-02T15:04:05")
+	}},
+	{"barcode", func(tx *TransactionWithItems, _ *ReceiptItem) interface{} { return tx.TransactionBarcode }},
+	{"transaction_type", func(tx *TransactionWithItems, _ *ReceiptItem) interface{} { return tx.TransactionType }},
+	{"document_type", func(tx *TransactionWithItems, _ *ReceiptItem) interface{} { return tx.DocumentType }},
+	{"source", func(tx *TransactionWithItems, _ *ReceiptItem) interface{} { return tx.Source }},
+	{"warehouse_number", func(tx *TransactionWithItems, _ *ReceiptItem) interface{} { return tx.WarehouseNumber }},
+	{"warehouse_name", func(tx *TransactionWithItems, _ *ReceiptItem) interface{} { return tx.WarehouseName }},
+	{"warehouse_city", func(tx *TransactionWithItems, _ *ReceiptItem) interface{} { return tx.WarehouseCity }},
+	{"items", func(tx *TransactionWithItems, _ *ReceiptItem) interface{} {
+		count := 0
+		for _, item := range tx.Items {
+			if !item.IsDiscount() {
+				count++
+			}
+		}
+		return count
+	}},
+	{"taxes", func(tx *TransactionWithItems, _ *ReceiptItem) interface{} { return tx.Taxes }},
+	{"instant_savings", func(tx *TransactionWithItems, _ *ReceiptItem) interface{} { return tx.InstantSavings }},
+	{"coupon_savings", func(tx *TransactionWithItems, _ *ReceiptItem) interface{} { return tx.CouponSavings }},
+	{"total", func(tx *TransactionWithItems, _ *ReceiptItem) interface{} { return tx.Total }},
+	{"currency", func(tx *TransactionWithItems, _ *ReceiptItem) interface{} { return tx.Currency }},
+	{"tags", func(tx *TransactionWithItems, _ *ReceiptItem) interface{} { return strings.Join(tx.Tags, ";") }},
+	{"note", func(tx *TransactionWithItems, _ *ReceiptItem) interface{} { return tx.Note }},
+}
+
+var itemExportColumns = []exportColumn{
+	transactionExportColumns[0], // date
+	transactionExportColumns[1], // barcode
+	transactionExportColumns[5], // warehouse_number
+	transactionExportColumns[6], // warehouse_name
+	{"item_number", func(_ *TransactionWithItems, item *ReceiptItem) interface{} { return item.ItemNumber }},
+	{"description", func(_ *TransactionWithItems, item *ReceiptItem) interface{} { return item.ItemDescription01 }},
+	{"friendly_name", func(_ *TransactionWithItems, item *ReceiptItem) interface{} { return item.FriendlyName() }},
+	{"description2", func(_ *TransactionWithItems, item *ReceiptItem) interface{} { return item.ItemDescription02 }},
+	{"department_number", func(_ *TransactionWithItems, item *ReceiptItem) interface{} { return item.ItemDepartmentNumber }},
+	{"department", func(_ *TransactionWithItems, item *ReceiptItem) interface{} {
+		return DepartmentName(item.ItemDepartmentNumber)
+	}},
+	{"quantity", func(_ *TransactionWithItems, item *ReceiptItem) interface{} { return item.Unit }},
+	{"unit_price", func(_ *TransactionWithItems, item *ReceiptItem) interface{} { return item.ItemUnitPriceAmount }},
+	{"amount", func(_ *TransactionWithItems, item *ReceiptItem) interface{} { return item.Amount }},
+	{"discount", func(_ *TransactionWithItems, item *ReceiptItem) interface{} { return item.IsDiscount() }},
+	{"tax_flag", func(_ *TransactionWithItems, item *ReceiptItem) interface{} { return item.TaxFlag }},
+	{"fuel_quantity", func(_ *TransactionWithItems, item *ReceiptItem) interface{} { return item.FuelUnitQuantity }},
+	{"fuel_grade", func(_ *TransactionWithItems, item *ReceiptItem) interface{} { return item.FuelGrade() }},
+	transactionExportColumns[13], // currency
+	transactionExportColumns[14], // tags
+	{"note", func(tx *TransactionWithItems, item *ReceiptItem) interface{} { return tx.ItemNotes[item.ItemNumber] }},
+	{"receipt_note", func(tx *TransactionWithItems, _ *ReceiptItem) interface{} { return tx.Note }},
+}
+
+// ExportColumns returns the column names available at an export level, in their
+// default order.
+func ExportColumns(level string) ([]string, error) {
+	columns, err := exportColumnsFor(level)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		names[i] = column.name
+	}
+	return names, nil
+}
+
+func exportColumnsFor(level string) ([]exportColumn, error) {
+	switch level {
+	case "", ExportLevelTransaction:
+		return transactionExportColumns, nil
+	case ExportLevelItem:
+		return itemExportColumns, nil
+	default:
+		return nil, fmt.Errorf("unknown export level %q: must be %s or %s", level, ExportLevelTransaction, ExportLevelItem)
+	}
+}
+
+// WriteExport writes transactions to w as CSV (with a header row) or as a JSON array
+// of objects, one row per transaction or per line item. Unknown columns are an error
+// and nothing is written.
+//
+// Example: item-level CSV with a few columns
+//
+//	transactions, _ := client.GetAllTransactionItems(ctx, "2025-01-01", "2025-12-31")
+//	err := costco.WriteExport(os.Stdout, transactions, costco.ExportOptions{
+//	    Level:   costco.ExportLevelItem,
+//	    Columns: []string{"date", "item_number", "friendly_name", "amount"},
+//	})
+func WriteExport(w io.Writer, transactions []TransactionWithItems, opts ExportOptions) error {
+	available, err := exportColumnsFor(opts.Level)
+	if err != nil {
+		return err
+	}
+	columns := available
+	if len(opts.Columns) > 0 {
+		byName := make(map[string]exportColumn, len(available))
+		for _, column := range available {
+			byName[column.name] = column
+		}
+		columns = make([]exportColumn, 0, len(opts.Columns))
+		for _, name := range opts.Columns {
+			column, ok := byName[name]
+			if !ok {
+				names, _ := ExportColumns(opts.Level)
+				return fmt.Errorf("unknown export column %q: must be one of %s", name, strings.Join(names, ", "))
+			}
+			columns = append(columns, column)
+		}
+	}
+
+	var rows [][]interface{}
+	for i := range transactions {
+		tx := &transactions[i]
+		if opts.Level == ExportLevelItem {
+			for j := range tx.Items {
+				rows = append(rows, exportRow(columns, tx, &tx.Items[j]))
+			}
+		} else {
+			rows = append(rows, exportRow(columns, tx, nil))
+		}
+	}
+
+	switch opts.Format {
+	case "", ExportFormatCSV:
+		return writeExportCSV(w, columns, rows)
+	case ExportFormatJSON:
+		return writeExportJSON(w, columns, rows)
+	case ExportFormatJSONL:
+		return writeExportJSONL(w, columns, rows)
+	default:
+		return fmt.Errorf("unsupported export format %q: must be %s, %s, or %s", opts.Format, ExportFormatCSV, ExportFormatJSON, ExportFormatJSONL)
+	}
+}
+
+func exportRow(columns []exportColumn, tx *TransactionWithItems, item *ReceiptItem) []interface{} {
+	row := make([]interface{}, len(columns))
+	for i, column := range columns {
+		row[i] = column.value(tx, item)
+	}
+	return row
+}
+
+func writeExportCSV(w io.Writer, columns []exportColumn, rows [][]interface{}) error {
+	writer := csv.NewWriter(w)
+	header := make([]string, len(columns))
+	for i, column := range columns {
+		header[i] = column.name
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	record := make([]string, len(columns))
+	for _, row := range rows {
+		for i, value := range row {
+			switch v := value.(type) {
+			case float64:
+				record[i] = strconv.FormatFloat(v, 'f', -1, 64)
+			default:
+				record[i] = fmt.Sprint(v)
+			}
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// writeExportJSON writes rows as objects whose keys follow the column order.
+func writeExportJSON(w io.Writer, columns []exportColumn, rows [][]interface{}) error {
+	var b strings.Builder
+	b.WriteString("[")
+	for r, row := range rows {
+		if r > 0 {
+			b.WriteString(",")
+		}
+		b.WriteString("\n  ")
+		if err := writeExportObject(&b, columns, row, false); err != nil {
+			return err
+		}
+	}
+	if len(rows) > 0 {
+		b.WriteString("\n")
+	}
+	b.WriteString("]\n")
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// writeExportJSONL writes each row as a compact object on its own line, as it goes.
+func writeExportJSONL(w io.Writer, columns []exportColumn, rows [][]interface{}) error {
+	bw := bufio.NewWriter(w)
+	for _, row := range rows {
+		if err := writeExportObject(bw, columns, row, true); err != nil {
+			return err
+		}
+		bw.WriteString("\n")
+	}
+	return bw.Flush()
+}
+
+// writeExportObject writes one row as an object whose keys follow the column order,
+// without spaces when compact.
+func writeExportObject(w io.StringWriter, columns []exportColumn, row []interface{}, compact bool) error {
+	fieldSeparator, keySeparator := ", ", ": "
+	if compact {
+		fieldSeparator, keySeparator = ",", ":"
+	}
+	w.WriteString("{")
+	for i, value := range row {
+		if i > 0 {
+			w.WriteString(fieldSeparator)
+		}
+		key, _ := json.Marshal(columns[i].name)
+		data, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		w.WriteString(string(key) + keySeparator + string(data))
+	}
+	w.WriteString("}")
+	return nil
+}
@@ -0,0 +1,89 @@
+package costco
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func exportTestTransactions() []TransactionWithItems {
+	return []TransactionWithItems{{
+		TransactionBarcode: "R1",
+		TransactionDate:    time.Date(2025, 3, 1, 14, 30, 0, 0, time.UTC),
+		WarehouseName:      "Issaquah",
+		WarehouseNumber:    1,
+		Total:              27.5,
+		Taxes:              1.5,
+		InstantSavings:     2,
+		Items: []ReceiptItem{
+			{ItemNumber: "100", ItemDescription01: "KS EGGS", ItemDepartmentNumber: 17, Unit: 2, ItemUnitPriceAmount: 14, Amount: 28},
+			{ItemNumber: "333", ItemDescription01: "/100", Unit: -1, Amount: -2},
+		},
+	}}
+}
+
+func TestWriteExport_TransactionCSV(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteExport(&buf, exportTestTransactions(), ExportOptions{
+		Columns: []string{"date", "barcode", "items", "total"},
+	}))
+	assert.Equal(t, "date,barcode,items,total\n2025-03-01T14:30:00,R1,1,27.5\n", buf.String())
+}
+
+func TestWriteExport_ItemJSON(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteExport(&buf, exportTestTransactions(), ExportOptions{
+		Format:  ExportFormatJSON,
+		Level:   ExportLevelItem,
+		Columns: []string{"barcode", "friendly_name", "quantity", "amount", "discount"},
+	}))
+	assert.Contains(t, buf.String(), `{"barcode": "R1", "friendly_name": "Kirkland Signature Eggs", "quantity": 2, "amount": 28, "discount": false}`)
+
+	var rows []map[string]interface{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &rows))
+	require.Len(t, rows, 2)
+	assert.Equal(t, true, rows[1]["discount"])
+}
+
+func TestWriteExport_ItemJSONL(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteExport(&buf, exportTestTransactions(), ExportOptions{
+		Format:  ExportFormatJSONL,
+		Level:   ExportLevelItem,
+		Columns: []string{"barcode", "quantity", "discount"},
+	}))
+	assert.Equal(t, `{"barcode":"R1","quantity":2,"discount":false}`+"\n"+
+		`{"barcode":"R1","quantity":-1,"discount":true}`+"\n", buf.String())
+
+	buf.Reset()
+	require.NoError(t, WriteExport(&buf, nil, ExportOptions{Format: ExportFormatJSONL}))
+	assert.Empty(t, buf.String())
+}
+
+func TestWriteExport_DefaultColumns(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteExport(&buf, exportTestTransactions(), ExportOptions{Level: ExportLevelItem}))
+
+	columns, err := ExportColumns(ExportLevelItem)
+	require.NoError(t, err)
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 3)
+	assert.Equal(t, len(columns), len(bytes.Split(lines[0], []byte(","))))
+	assert.Contains(t, string(lines[1]), "Frozen Foods")
+
+	buf.Reset()
+	require.NoError(t, WriteExport(&buf, nil, ExportOptions{Format: ExportFormatJSON}))
+	assert.Equal(t, "[]\n", buf.String())
+}
+
+func TestWriteExport_Errors(t *testing.T) {
+	var buf bytes.Buffer
+	assert.ErrorContains(t, WriteExport(&buf, nil, ExportOptions{Format: "parquet"}), "unsupported export format")
+	assert.ErrorContains(t, WriteExport(&buf, nil, ExportOptions{Level: "order"}), "unknown export level")
+	assert.ErrorContains(t, WriteExport(&buf, nil, ExportOptions{Columns: []string{"amount"}}), `unknown export column "amount"`)
+	assert.Empty(t, buf.String())
+}
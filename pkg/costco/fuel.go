@@ -0,0 +1,381 @@
+package costco
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Fuel analytics from gas station receipts
+
+// OdometerReading is a vehicle odometer reading supplied to GetFuelSummary for MPG.
+type OdometerReading struct {
+	Date  time.Time
+	Miles float64
+}
+
+// FuelSummary reports fuel purchases over a date range.
+// This is returned by GetFuelSummary. Volumes are in the receipts' unit of
+// measure: gallons in the US, liters in Canada.
+type FuelSummary struct {
+	Volume        float64            // Total fuel purchased
+	UnitOfMeasure string             // e.g. "GAL", "L"
+	Spent         float64            // Total spent on fuel
+	AveragePrice  float64            // Spent / Volume
+	FillUps       int                // Number of gas station receipts
+	DaysBetween   float64            // Average days between fill-ups (0 with fewer than two)
+	ByGrade       []FuelGradeSummary // Sorted by grade
+	ByMonth       []FuelMonthSummary // Chronological
+	MPG           float64            // Miles per unit of volume; 0 unless two or more odometer readings are given
+}
+
+// FuelGradeSummary is the fuel bought in one grade.
+type FuelGradeSummary struct {
+	Grade        string // FuelGradeRegular, FuelGradePremium, or FuelGradeDiesel
+	Volume       float64
+	Spent        float64
+	AveragePrice float64 // Spent / Volume
+}
+
+// FuelMonthSummary is the fuel bought in one month.
+type FuelMonthSummary struct {
+	Month        string // YYYY-MM
+	Volume       float64
+	Spent        float64
+	AveragePrice float64 // Spent / Volume
+	FillUps      int
+}
+
+// fuelPurchase is one fuel line item with its receipt and gas station.
+type fuelPurchase struct {
+	barcode         string
+	date            time.Time
+	item            ReceiptItem
+	warehouseNumber int
+	warehouseName   string
+}
+
+// GetFuelSummary reports the fuel bought at Costco gas stations in a date range: total
+// volume, average price per unit by grade, spend and price per month, and fill-up cadence.
+// Pass two or more odometer readings to also compute MPG from the fuel bought after
+// the first reading up to the last one. Receipt details are fetched concurrently
+// (Config.DetailWorkers at a time).
+//
+// The startDate and endDate should be in YYYY-MM-DD format.
+//
+// Example:
+//
+//	summary, err := client.GetFuelSummary(ctx, "2025-01-01", "2025-12-31",
+//	    costco.OdometerReading{Date: jan1, Miles: 41200},
+//	    costco.OdometerReading{Date: dec31, Miles: 52900},
+//	)
+//	fmt.Printf("%.1f gal at $%.3f/gal, %.1f MPG\n", summary.Volume, summary.AveragePrice, summary.MPG)
+func (c *Client) GetFuelSummary(ctx context.Context, startDate, endDate string, odometer ...OdometerReading) (*FuelSummary, error) {
+	purchases, err := c.fuelPurchases(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return summarizeFuel(purchases, odometer), nil
+}
+
+// fuelPurchases fetches the fuel line items from the gas station receipts in a date range.
+func (c *Client) fuelPurchases(ctx context.Context, startDate, endDate string) ([]fuelPurchase, error) {
+	receipts, err := c.GetReceipts(ctx, startDate, endDate, DocumentTypeFuel, DocumentSubTypeAll)
+	if err != nil {
+		return nil, fmt.Errorf("getting fuel receipts: %w", err)
+	}
+
+	var pending []Receipt
+	for _, receipt := range receipts.Receipts {
+		if receipt.TransactionBarcode == "" || receipt.IsCarWash() {
+			continue
+		}
+		// Listed under the fuel filter, so fetch the details as a fuel receipt
+		receipt.DocumentType = DocumentTypeFuel
+		pending = append(pending, receipt)
+	}
+
+	details := make([]*TransactionWithItems, len(pending))
+	c.fetchDetails(ctx, pending, func(i int, tx TransactionWithItems) {
+		details[i] = &tx
+	})
+
+	var purchases []fuelPurchase
+	for _, tx := range details {
+		if tx == nil {
+			continue
+		}
+		if tx.TransactionDate.IsZero() {
+			c.getLogger().Warn("skipping fuel receipt with an invalid date",
+				slog.String("barcode", tx.TransactionBarcode))
+			continue
+		}
+		for _, item := range tx.Items {
+			if item.FuelUnitQuantity > 0 {
+				purchases = append(purchases, fuelPurchase{
+					barcode:         tx.TransactionBarcode,
+					date:            tx.TransactionDate,
+					item:            item,
+					warehouseNumber: tx.WarehouseNumber,
+					warehouseName:   tx.WarehouseName,
+				})
+			}
+		}
+	}
+
+	return purchases, nil
+}
+
+// summarizeFuel builds a FuelSummary from fuel line items.
+func summarizeFuel(purchases []fuelPurchase, odometer []OdometerReading) *FuelSummary {
+	sort.Slice(purchases, func(i, j int) bool {
+		return purchases[i].date.Before(purchases[j].date)
+	})
+
+	summary := &FuelSummary{}
+	grades := make(map[string]*FuelGradeSummary)
+	months := make(map[string]*FuelMonthSummary)
+
+	// A receipt can have several fuel lines; each receipt is one fill-up
+	receipts := make(map[string]bool)
+	var first, last time.Time
+
+	for _, p := range purchases {
+		summary.Volume += p.item.FuelUnitQuantity
+		summary.Spent += p.item.Amount
+		newFill := !receipts[p.barcode]
+		if newFill {
+			receipts[p.barcode] = true
+			summary.FillUps++
+			if first.IsZero() {
+				first = p.date
+			}
+			last = p.date
+		}
+		if summary.UnitOfMeasure == "" {
+			summary.UnitOfMeasure = p.item.FuelUomCode
+		}
+
+		grade := p.item.FuelGrade()
+		g, exists := grades[grade]
+		if !exists {
+			g = &FuelGradeSummary{Grade: grade}
+			grades[grade] = g
+		}
+		g.Volume += p.item.FuelUnitQuantity
+		g.Spent += p.item.Amount
+
+		month := p.date.Format("2006-01")
+		m, exists := months[month]
+		if !exists {
+			m = &FuelMonthSummary{Month: month}
+			months[month] = m
+		}
+		m.Volume += p.item.FuelUnitQuantity
+		m.Spent += p.item.Amount
+		if newFill {
+			m.FillUps++
+		}
+	}
+
+	if summary.Volume > 0 {
+		summary.AveragePrice = roundTo(summary.Spent/summary.Volume, 3)
+	}
+	if summary.FillUps > 1 {
+		span := last.Sub(first).Hours() / 24
+		summary.DaysBetween = roundTo(span/float64(summary.FillUps-1), 1)
+	}
+	summary.Volume = roundTo(summary.Volume, 3)
+	summary.Spent = roundTo(summary.Spent, 2)
+
+	for _, g := range grades {
+		if g.Volume > 0 {
+			g.AveragePrice = roundTo(g.Spent/g.Volume, 3)
+		}
+		g.Volume = roundTo(g.Volume, 3)
+		g.Spent = roundTo(g.Spent, 2)
+		summary.ByGrade = append(summary.ByGrade, *g)
+	}
+	sort.Slice(summary.ByGrade, func(i, j int) bool {
+		return summary.ByGrade[i].Grade < summary.ByGrade[j].Grade
+	})
+
+	for _, m := range months {
+		if m.Volume > 0 {
+			m.AveragePrice = roundTo(m.Spent/m.Volume, 3)
+		}
+		m.Volume = roundTo(m.Volume, 3)
+		m.Spent = roundTo(m.Spent, 2)
+		summary.ByMonth = append(summary.ByMonth, *m)
+	}
+	sort.Slice(summary.ByMonth, func(i, j int) bool {
+		return summary.ByMonth[i].Month < summary.ByMonth[j].Month
+	})
+
+	summary.MPG = fuelEconomy(purchases, odometer)
+
+	return summary
+}
+
+// fuelEconomy returns miles driven between the first and last odometer reading divided
+// by the fuel bought after the first reading and up to the last, or 0 if it can't be computed.
+func fuelEconomy(purchases []fuelPurchase, odometer []OdometerReading) float64 {
+	if len(odometer) < 2 {
+		return 0
+	}
+	readings := append([]OdometerReading(nil), odometer...)
+	sort.Slice(readings, func(i, j int) bool {
+		return readings[i].Date.Before(readings[j].Date)
+	})
+	first, last := readings[0], readings[len(readings)-1]
+
+	var volume float64
+	for _, p := range purchases {
+		if p.date.After(first.Date) && !p.date.After(last.Date) {
+			volume += p.item.FuelUnitQuantity
+		}
+	}
+	miles := last.Miles - first.Miles
+	if volume == 0 || miles <= 0 {
+		return 0
+	}
+	return roundTo(miles/volume, 1)
+}
+
+// FuelPriceComparison compares the price paid per unit of fuel with the prices posted
+// at the same gas stations. This is returned by CompareFuelPrices.
+type FuelPriceComparison struct {
+	Fills    []FuelFill         // Chronological
+	Stations []FuelStationPrice // Sorted by grade, then cheapest average paid price first
+}
+
+// FuelFill is one fuel purchase alongside the station's posted price for the grade.
+type FuelFill struct {
+	Date            time.Time
+	WarehouseNumber int
+	WarehouseName   string
+	Grade           string  // FuelGradeRegular, FuelGradePremium, or FuelGradeDiesel
+	Volume          float64 // Gallons in the US, liters in Canada
+	PaidPrice       float64 // Price paid per unit of volume
+	PostedPrice     float64 // Price posted now at the station (0 if unavailable)
+	Difference      float64 // PaidPrice - PostedPrice (0 if PostedPrice is unavailable)
+}
+
+// FuelStationPrice is what was paid for one grade at one gas station.
+type FuelStationPrice struct {
+	WarehouseNumber int
+	WarehouseName   string
+	Grade           string
+	FillUps         int
+	Volume          float64
+	AveragePaid     float64 // Spent / Volume
+	PostedPrice     float64 // Price posted now at the station (0 if unavailable)
+}
+
+// CompareFuelPrices lines up every fuel purchase in a date range with the price posted
+// at the same station, and ranks stations and grades by the average price paid, so the
+// cheapest places to fill up come first.
+//
+// Costco only publishes the currently posted prices, so each fill is compared with
+// today's price at that station: a negative Difference means the fill was cheaper
+// than the station is now. Stations whose prices can't be fetched report 0.
+//
+// The startDate and endDate should be in YYYY-MM-DD format.
+//
+// Example:
+//
+//	comparison, err := client.CompareFuelPrices(ctx, "2025-01-01", "2025-12-31")
+//	for _, s := range comparison.Stations {
+//	    fmt.Printf("%s %s: paid $%.3f avg over %d fills (posted now $%.3f)\n",
+//	        s.WarehouseName, s.Grade, s.AveragePaid, s.FillUps, s.PostedPrice)
+//	}
+func (c *Client) CompareFuelPrices(ctx context.Context, startDate, endDate string) (*FuelPriceComparison, error) {
+	purchases, err := c.fuelPurchases(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(purchases, func(i, j int) bool {
+		return purchases[i].date.Before(purchases[j].date)
+	})
+
+	// Fetch each station's posted prices once
+	posted := make(map[int]*GasPrices)
+	for _, p := range purchases {
+		if _, seen := posted[p.warehouseNumber]; seen {
+			continue
+		}
+		prices, err := c.GetGasPrices(ctx, strconv.Itoa(p.warehouseNumber))
+		if err != nil {
+			c.getLogger().Warn("no posted gas prices for warehouse",
+				slog.Int("warehouse_number", p.warehouseNumber),
+				slog.String("error", err.Error()))
+		}
+		posted[p.warehouseNumber] = prices
+	}
+
+	comparison := &FuelPriceComparison{}
+	stations := make(map[string]*FuelStationPrice)
+	spent := make(map[string]float64)
+	fills := make(map[string]bool) // Station and grade keys with the receipt barcode
+
+	for _, p := range purchases {
+		grade := p.item.FuelGrade()
+		paid := p.item.ItemUnitPriceAmount
+		if paid == 0 {
+			paid = p.item.Amount / p.item.FuelUnitQuantity
+		}
+		fill := FuelFill{
+			Date:            p.date,
+			WarehouseNumber: p.warehouseNumber,
+			WarehouseName:   p.warehouseName,
+			Grade:           grade,
+			Volume:          p.item.FuelUnitQuantity,
+			PaidPrice:       roundTo(paid, 3),
+		}
+		if prices := posted[p.warehouseNumber]; prices != nil && prices.Price(grade) > 0 {
+			fill.PostedPrice = prices.Price(grade)
+			fill.Difference = roundTo(fill.PaidPrice-fill.PostedPrice, 3)
+		}
+		comparison.Fills = append(comparison.Fills, fill)
+
+		key := fmt.Sprintf("%d|%s", p.warehouseNumber, grade)
+		station, exists := stations[key]
+		if !exists {
+			station = &FuelStationPrice{
+				WarehouseNumber: p.warehouseNumber,
+				WarehouseName:   p.warehouseName,
+				Grade:           grade,
+				PostedPrice:     fill.PostedPrice,
+			}
+			stations[key] = station
+		}
+		if !fills[key+"|"+p.barcode] {
+			fills[key+"|"+p.barcode] = true
+			station.FillUps++
+		}
+		station.Volume += p.item.FuelUnitQuantity
+		spent[key] += p.item.Amount
+	}
+
+	for key, station := range stations {
+		station.AveragePaid = roundTo(spent[key]/station.Volume, 3)
+		station.Volume = roundTo(station.Volume, 3)
+		comparison.Stations = append(comparison.Stations, *station)
+	}
+	sort.Slice(comparison.Stations, func(i, j int) bool {
+		a, b := comparison.Stations[i], comparison.Stations[j]
+		if a.Grade != b.Grade {
+			return a.Grade < b.Grade
+		}
+		if a.AveragePaid != b.AveragePaid {
+			return a.AveragePaid < b.AveragePaid
+		}
+		return a.WarehouseNumber < b.WarehouseNumber
+	})
+
+	return comparison, nil
+}
@@ -0,0 +1,170 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetFuelSummary(t *testing.T) {
+	details := map[string]map[string]interface{}{
+		"G1": {
+			"transactionBarcode": "G1", "transactionDateTime": "2025-01-05T08:00:00",
+			"itemArray": []map[string]interface{}{
+				{"itemNumber": "F1", "fuelUnitQuantity": 10.0, "fuelGradeDescription": "REGULAR", "fuelUomCode": "GAL", "amount": 30.00},
+			},
+		},
+		"G2": {
+			"transactionBarcode": "G2", "transactionDateTime": "2025-01-15T08:00:00",
+			"itemArray": []map[string]interface{}{
+				// Two fuel lines on one receipt are one fill-up
+				{"itemNumber": "F1", "fuelUnitQuantity": 7.0, "fuelGradeDescription": "REGULAR", "fuelUomCode": "GAL", "amount": 21.70},
+				{"itemNumber": "F1", "fuelUnitQuantity": 5.0, "fuelGradeDescription": "REGULAR", "fuelUomCode": "GAL", "amount": 15.50},
+			},
+		},
+		"G3": {
+			"transactionBarcode": "G3", "transactionDateTime": "2025-02-04T08:00:00",
+			"itemArray": []map[string]interface{}{
+				{"itemNumber": "F2", "fuelUnitQuantity": 8.0, "fuelGradeDescription": "PREMIUM", "fuelUomCode": "GAL", "amount": 32.00},
+			},
+		},
+		"G4": {
+			"transactionBarcode": "G4", "transactionDateTime": "02/10/2025",
+			"itemArray": []map[string]interface{}{
+				{"itemNumber": "F1", "fuelUnitQuantity": 9.0, "fuelGradeDescription": "REGULAR", "fuelUomCode": "GAL", "amount": 27.00},
+			},
+		},
+	}
+
+	var documentType interface{}
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if barcode, ok := req.Variables["barcode"].(string); ok {
+			assert.Equal(t, DocumentTypeFuel, req.Variables["documentType"])
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{details[barcode]}},
+			})
+			return
+		}
+		documentType = req.Variables["documentType"]
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{
+				"receipts": []map[string]interface{}{
+					{"transactionBarcode": "G3"}, {"transactionBarcode": "G1"}, {"transactionBarcode": "G2"},
+					{"transactionBarcode": "W1", "receiptType": ReceiptTypeCarWash},
+					{"transactionBarcode": "G4"}, // Unparseable date: skipped
+				},
+			},
+		})
+	})
+
+	summary, err := client.GetFuelSummary(context.Background(), "2025-01-01", "2025-02-28",
+		OdometerReading{Date: time.Date(2025, 1, 5, 9, 0, 0, 0, time.UTC), Miles: 10000},
+		OdometerReading{Date: time.Date(2025, 2, 4, 9, 0, 0, 0, time.UTC), Miles: 10600},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, DocumentTypeFuel, documentType)
+
+	assert.Equal(t, 30.0, summary.Volume)
+	assert.Equal(t, "GAL", summary.UnitOfMeasure)
+	assert.Equal(t, 99.20, summary.Spent)
+	assert.Equal(t, 3.307, summary.AveragePrice)
+	assert.Equal(t, 3, summary.FillUps)
+	assert.Equal(t, 15.0, summary.DaysBetween)
+
+	require.Len(t, summary.ByGrade, 2)
+	assert.Equal(t, FuelGradePremium, summary.ByGrade[0].Grade)
+	assert.Equal(t, 4.0, summary.ByGrade[0].AveragePrice)
+	assert.Equal(t, FuelGradeRegular, summary.ByGrade[1].Grade)
+	assert.Equal(t, 3.055, summary.ByGrade[1].AveragePrice)
+
+	require.Len(t, summary.ByMonth, 2)
+	assert.Equal(t, FuelMonthSummary{Month: "2025-01", Volume: 22, Spent: 67.20, AveragePrice: 3.055, FillUps: 2}, summary.ByMonth[0])
+
+	// 600 miles on the 20 gallons bought after the first reading
+	assert.Equal(t, 30.0, summary.MPG)
+}
+
+func TestFuelEconomy_NeedsTwoReadings(t *testing.T) {
+	purchases := []fuelPurchase{{date: time.Now(), item: ReceiptItem{FuelUnitQuantity: 10}}}
+	assert.Equal(t, 0.0, fuelEconomy(purchases, nil))
+	assert.Equal(t, 0.0, fuelEconomy(purchases, []OdometerReading{{Date: time.Now(), Miles: 100}}))
+}
+
+func TestCompareFuelPrices(t *testing.T) {
+	details := map[string]map[string]interface{}{
+		"G1": {
+			"transactionBarcode": "G1", "transactionDateTime": "2025-01-05T08:00:00",
+			"warehouseNumber": 847, "warehouseName": "Issaquah",
+			"itemArray": []map[string]interface{}{
+				{"itemNumber": "F1", "fuelUnitQuantity": 10.0, "fuelGradeDescription": "REGULAR", "itemUnitPriceAmount": 3.099, "amount": 30.99},
+			},
+		},
+		"G2": {
+			"transactionBarcode": "G2", "transactionDateTime": "2025-01-15T08:00:00",
+			"warehouseNumber": 1, "warehouseName": "Seattle",
+			"itemArray": []map[string]interface{}{
+				{"itemNumber": "F1", "fuelUnitQuantity": 10.0, "fuelGradeDescription": "REGULAR", "amount": 32.00},
+			},
+		},
+		"G3": {
+			"transactionBarcode": "G3", "transactionDateTime": "2025-02-04T08:00:00",
+			"warehouseNumber": 847, "warehouseName": "Issaquah",
+			"itemArray": []map[string]interface{}{
+				{"itemNumber": "F1", "fuelUnitQuantity": 10.0, "fuelGradeDescription": "REGULAR", "itemUnitPriceAmount": 2.999, "amount": 29.99},
+				{"itemNumber": "F2", "fuelUnitQuantity": 5.0, "fuelGradeDescription": "PREMIUM", "itemUnitPriceAmount": 3.699, "amount": 18.50},
+			},
+		},
+	}
+
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/AjaxGetGasPrices" {
+			if r.URL.Query().Get("warehouseNumber") != "847" {
+				w.Write([]byte(`{"warehouseNumber": "1"}`))
+				return
+			}
+			w.Write([]byte(`{"warehouseNumber": "847", "gasPrices": {"regular": "3.199", "premium": "3.599"}}`))
+			return
+		}
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if barcode, ok := req.Variables["barcode"].(string); ok {
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{details[barcode]}},
+			})
+			return
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{
+				"receipts": []map[string]interface{}{
+					{"transactionBarcode": "G3"}, {"transactionBarcode": "G1"}, {"transactionBarcode": "G2"},
+				},
+			},
+		})
+	})
+
+	comparison, err := client.CompareFuelPrices(context.Background(), "2025-01-01", "2025-02-28")
+	require.NoError(t, err)
+
+	require.Len(t, comparison.Fills, 4)
+	assert.Equal(t, FuelFill{
+		Date: time.Date(2025, 1, 5, 8, 0, 0, 0, time.UTC), WarehouseNumber: 847, WarehouseName: "Issaquah",
+		Grade: FuelGradeRegular, Volume: 10, PaidPrice: 3.099, PostedPrice: 3.199, Difference: -0.1,
+	}, comparison.Fills[0])
+	assert.Equal(t, 3.2, comparison.Fills[1].PaidPrice, "unit price derived from amount")
+	assert.Zero(t, comparison.Fills[1].PostedPrice, "station without posted prices")
+	assert.Zero(t, comparison.Fills[1].Difference)
+	assert.Equal(t, 0.1, comparison.Fills[3].Difference)
+
+	assert.Equal(t, []FuelStationPrice{
+		{WarehouseNumber: 847, WarehouseName: "Issaquah", Grade: FuelGradePremium, FillUps: 1, Volume: 5, AveragePaid: 3.7, PostedPrice: 3.599},
+		{WarehouseNumber: 847, WarehouseName: "Issaquah", Grade: FuelGradeRegular, FillUps: 2, Volume: 20, AveragePaid: 3.049, PostedPrice: 3.199},
+		{WarehouseNumber: 1, WarehouseName: "Seattle", Grade: FuelGradeRegular, FillUps: 1, Volume: 10, AveragePaid: 3.2},
+	}, comparison.Stations)
+}
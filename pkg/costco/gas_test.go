@@ -0,0 +1,69 @@
+package costco
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetGasPrices(t *testing.T) {
+	client := newMockClient(t, Config{WarehouseNumber: "847"}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/AjaxGetGasPrices", r.URL.Path)
+		assert.Equal(t, "847", r.URL.Query().Get("warehouseNumber"))
+		w.Write([]byte(`{
+			"warehouseNumber": "847",
+			"gasPrices": {"regular": "4.199", "premium": "$4.499", "diesel": ""},
+			"lastUpdated": "2026-10-15T08:00:00Z"
+		}`))
+	})
+
+	prices, err := client.GetGasPrices(context.Background(), "")
+	require.NoError(t, err)
+
+	assert.Equal(t, "847", prices.WarehouseNumber)
+	assert.Equal(t, 4.199, prices.Regular)
+	assert.Equal(t, 4.499, prices.Price(FuelGradePremium))
+	assert.Zero(t, prices.Diesel, "missing grade is not sold")
+	assert.Equal(t, time.Date(2026, 10, 15, 8, 0, 0, 0, time.UTC), prices.LastUpdated)
+}
+
+func TestGetGasPrices_NoGasStation(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"warehouseNumber": "1"}`))
+	})
+
+	_, err := client.GetGasPrices(context.Background(), "1")
+	assert.ErrorContains(t, err, "no gas prices")
+}
+
+func TestGetGasPrices_InvalidPrice(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"gasPrices": {"regular": "N/A"}}`))
+	})
+
+	_, err := client.GetGasPrices(context.Background(), "1")
+	assert.ErrorContains(t, err, "parsing regular price")
+}
+
+func TestReceiptItemFuelGrade(t *testing.T) {
+	tests := []struct {
+		name     string
+		item     ReceiptItem
+		expected string
+	}{
+		{"merchandise", ReceiptItem{ItemDescription01: "BANANAS"}, ""},
+		{"regular", ReceiptItem{FuelUnitQuantity: 12.3, FuelGradeDescription: "Regular"}, FuelGradeRegular},
+		{"premium", ReceiptItem{FuelUnitQuantity: 10, FuelGradeDescription: "PREMIUM UNLEADED"}, FuelGradePremium},
+		{"diesel", ReceiptItem{FuelUnitQuantity: 40, FuelGradeDescription: "Diesel"}, FuelGradeDiesel},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.item.FuelGrade())
+		})
+	}
+}
@@ -3,8 +3,11 @@ package costco
 import (
 	"context"
 	"fmt"
+	"iter"
 	"log/slog"
+	"math"
 	"sort"
+	"sync"
 	"time"
 )
 
@@ -15,6 +18,14 @@ import (
 // including all line items for each receipt.
 //
 // The startDate and endDate should be in YYYY-MM-DD format.
+// Receipts are filtered by the client's configured DocumentType and DocumentSubType.
+// With Config.IncludeBusinessDelivery, Business Delivery orders are appended as well, and
+// with Config.IncludeServiceOrders, Photo Center and optical orders.
+// Details are fetched concurrently (Config.DetailWorkers at a time) and returned in
+// the order GetReceipts listed them. With Config.UseLocalStore, receipts come from
+// ~/.costco/transactions.json and only days after the sync watermark (or before the
+// synced range) are fetched; results are then in date order. Repeats of the same
+// transaction (see TransactionWithItems.Key) are dropped.
 // Returns a slice of TransactionWithItems, each containing full receipt details and all items.
 //
 // Example:
@@ -29,58 +40,223 @@ func (c *Client) GetAllTransactionItems(ctx context.Context, startDate, endDate
 		slog.String("start_date", startDate),
 		slog.String("end_date", endDate))
 
-	// First get all receipts
-	receipts, err := c.GetReceipts(ctx, startDate, endDate, "all", "all")
+	var transactions []TransactionWithItems
+	var err error
+	if c.config.UseLocalStore {
+		transactions, err = c.storedTransactions(ctx, startDate, endDate)
+	} else {
+		filterType, filterSubType := c.documentFilters()
+		transactions, _, err = c.fetchTransactions(ctx, startDate, endDate, filterType, filterSubType)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("getting receipts: %w", err)
+		return nil, err
+	}
+
+	if c.config.IncludeBusinessDelivery {
+		orders, err := c.GetBusinessDeliveryOrders(ctx, startDate, endDate)
+		if err != nil {
+			return nil, fmt.Errorf("getting business delivery orders: %w", err)
+		}
+		for _, order := range orders {
+			transactions = append(transactions, order.Transaction())
+		}
+	}
+	if c.config.IncludeServiceOrders {
+		transactions = append(transactions, c.serviceOrderTransactions(ctx, startDate, endDate)...)
+	}
+
+	// The API can list a receipt more than once; count each transaction once
+	transactions, err = c.applyTags(DedupeTransactions(transactions))
+	if err != nil {
+		return nil, err
+	}
+	return c.applyNotes(transactions), nil
+}
+
+// fetchTransactions lists the receipts matching the document filters and fetches their
+// details with a bounded worker pool; results keep the receipt order. It also returns the
+// receipts whose details couldn't be fetched.
+func (c *Client) fetchTransactions(ctx context.Context, startDate, endDate, documentType, documentSubType string) ([]TransactionWithItems, []Receipt, error) {
+	pending, err := c.detailReceipts(ctx, startDate, endDate, documentType, documentSubType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	results := make([]*TransactionWithItems, len(pending))
+	failed := c.fetchDetails(ctx, pending, func(i int, tx TransactionWithItems) {
+		results[i] = &tx
+	})
+
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
 	}
 
 	var transactions []TransactionWithItems
+	for _, tx := range results {
+		if tx != nil {
+			transactions = append(transactions, *tx)
+		}
+	}
+
+	return transactions, failed, nil
+}
 
-	// For each receipt, get the full details
+// detailReceipts lists the receipts in a date range that have a barcode to fetch details for.
+func (c *Client) detailReceipts(ctx context.Context, startDate, endDate, documentType, documentSubType string) ([]Receipt, error) {
+	receipts, err := c.GetReceipts(ctx, startDate, endDate, documentType, documentSubType)
+	if err != nil {
+		return nil, fmt.Errorf("getting receipts: %w", err)
+	}
+
+	var pending []Receipt
 	for _, receipt := range receipts.Receipts {
 		// Skip if no barcode
-		if receipt.TransactionBarcode == "" {
-			continue
+		if receipt.TransactionBarcode != "" {
+			pending = append(pending, receipt)
 		}
+	}
+	return pending, nil
+}
 
-		// Determine document type based on receipt type
-		documentType := "warehouse"
-		if receipt.ReceiptType == "Gas Station" || receipt.DocumentType == "fuel" {
-			documentType = "fuel"
+// fetchDetails fetches the details of each receipt with a bounded worker pool and
+// calls visit from the worker goroutines with the receipt's index and transaction.
+// Receipts whose details fail are skipped and returned; after ctx is cancelled no more
+// are fetched. Config.Progress is told about each receipt done, failed or not.
+func (c *Client) fetchDetails(ctx context.Context, pending []Receipt, visit func(i int, tx TransactionWithItems)) []Receipt {
+	var (
+		failedMu sync.Mutex
+		failed   []Receipt
+	)
+	var progressMu sync.Mutex
+	done := 0
+	progress := func(fetched int) {
+		if c.config.Progress == nil {
+			return
 		}
+		progressMu.Lock()
+		defer progressMu.Unlock()
+		done += fetched
+		c.config.Progress(done, len(pending))
+	}
+	if len(pending) > 0 {
+		progress(0)
+	}
 
-		// Get full receipt details including all items
-		detail, err := c.GetReceiptDetail(ctx, receipt.TransactionBarcode, documentType)
-		if err != nil {
-			c.getLogger().Warn("failed to get receipt details",
-				slog.String("barcode", receipt.TransactionBarcode),
-				slog.String("document_type", documentType),
-				slog.String("error", err.Error()))
-			continue
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(c.detailWorkers(), len(pending)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if ctx.Err() != nil {
+					continue
+				}
+				if tx := c.transactionWithItems(ctx, pending[i]); tx != nil {
+					visit(i, *tx)
+				} else {
+					failedMu.Lock()
+					failed = append(failed, pending[i])
+					failedMu.Unlock()
+				}
+				progress(1)
+			}
+		}()
+	}
+	for i := range pending {
+		if ctx.Err() != nil {
+			break
 		}
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return failed
+}
 
-		// Parse the transaction date
-		txDate, _ := time.Parse("2006-01-02T15:04:05", detail.TransactionDateTime)
+// detailWorkers returns the configured receipt detail concurrency.
+func (c *Client) detailWorkers() int {
+	if c.config.DetailWorkers > 0 {
+		return c.config.DetailWorkers
+	}
+	return DefaultDetailWorkers
+}
 
-		transaction := TransactionWithItems{
-			TransactionBarcode: detail.TransactionBarcode,
-			TransactionDate:    txDate,
-			WarehouseName:      detail.WarehouseName,
-			Total:              detail.Total,
-			Items:              detail.ItemArray,
-			MembershipNumber:   detail.MembershipNumber,
-		}
+// transactionWithItems fetches a receipt's details. Failures are logged and return nil
+// so one bad receipt doesn't fail the whole range.
+func (c *Client) transactionWithItems(ctx context.Context, receipt Receipt) *TransactionWithItems {
+	documentType := receipt.DetailDocumentType()
+
+	// Get full receipt details including all items
+	detail, err := c.GetReceiptDetail(ctx, receipt.TransactionBarcode, documentType)
+	if err != nil {
+		c.getLogger().Warn("failed to get receipt details",
+			slog.String("barcode", receipt.TransactionBarcode),
+			slog.String("document_type", documentType),
+			slog.String("error", err.Error()))
+		return nil
+	}
 
-		transactions = append(transactions, transaction)
+	// Parse the transaction date
+	txDate, _ := time.Parse("2006-01-02T15:04:05", detail.TransactionDateTime)
+
+	return &TransactionWithItems{
+		TransactionBarcode: detail.TransactionBarcode,
+		TransactionDate:    txDate,
+		TransactionType:    detail.TransactionType,
+		WarehouseName:      detail.WarehouseName,
+		WarehouseNumber:    detail.WarehouseNumber,
+		WarehouseCity:      detail.WarehouseCity,
+		Total:              detail.Total,
+		Taxes:              detail.Taxes,
+		InstantSavings:     detail.InstantSavings,
+		CouponSavings:      detail.CouponSavings(),
+		TaxLines:           detail.TaxBreakdown(),
+		Tenders:            detail.TenderArray,
+		Items:              detail.ItemArray,
+		MembershipNumber:   detail.MembershipNumber,
+		Currency:           detail.Currency,
+		Source:             TransactionSourceReceipt,
+		DocumentType:       documentType,
+	}
+}
+
+// documentFilters returns the receipt document type and sub-type configured for
+// the analytics helpers, falling back to "all" when unset.
+func (c *Client) documentFilters() (string, string) {
+	documentType := c.config.DocumentType
+	if documentType == "" {
+		documentType = DefaultDocumentType
+	}
+	documentSubType := c.config.DocumentSubType
+	if documentSubType == "" {
+		documentSubType = DefaultDocumentSubType
 	}
+	return documentType, documentSubType
+}
 
-	return transactions, nil
+// analyticsItems returns the receipt items the analytics helpers count. By default
+// discount lines are folded into their parent items with NetDiscounts; discounts
+// that match no item are kept as-is so totals still add up. With Config.GrossPrices
+// the discount lines are dropped and items keep their shelf price.
+func (c *Client) analyticsItems(items []ReceiptItem) []ReceiptItem {
+	if c.config.GrossPrices {
+		gross := make([]ReceiptItem, 0, len(items))
+		for _, item := range items {
+			if !item.IsDiscount() {
+				gross = append(gross, item)
+			}
+		}
+		return gross
+	}
+	netted, orphaned := NetDiscounts(items)
+	return append(netted, orphaned...)
 }
 
 // GetItemHistory retrieves the complete purchase history for a specific item number
 // within the given date range. Returns a chronological list of all transactions
-// where the item was purchased, including date, quantity, price, and receipt barcode.
+// where the item was purchased, including date, quantity, price, discount, warehouse,
+// and receipt barcode.
 //
 // The startDate and endDate should be in YYYY-MM-DD format.
 // The itemNumber is the Costco item identifier.
@@ -98,26 +274,71 @@ func (c *Client) GetItemHistory(ctx context.Context, itemNumber, startDate, endD
 		return nil, err
 	}
 
-	var history []ItemPurchase
+	return purchasesByItem(transactions)[itemNumber], nil
+}
+
+// purchasesByItem groups the regular item lines of transactions into per-item
+// purchase histories, in transaction order.
+func purchasesByItem(transactions []TransactionWithItems) map[string][]ItemPurchase {
+	histories := make(map[string][]ItemPurchase)
 
 	for _, tx := range transactions {
+		// NetDiscounts keeps regular items in order, so netted[i] is the i-th non-discount item
+		netted, _ := NetDiscounts(tx.Items)
+		i := 0
 		for _, item := range tx.Items {
-			if item.ItemNumber == itemNumber {
-				history = append(history, ItemPurchase{
-					Date:     tx.TransactionDate.Format("2006-01-02"),
-					Quantity: item.Unit,
-					Price:    item.Amount,
-					Barcode:  tx.TransactionBarcode,
-				})
+			if item.IsDiscount() {
+				continue
 			}
+			net := netted[i]
+			i++
+			size, _ := item.Size()
+			histories[item.ItemNumber] = append(histories[item.ItemNumber], ItemPurchase{
+				Date:      tx.TransactionDate.Format("2006-01-02"),
+				Quantity:  item.Unit,
+				Price:     item.Amount,
+				Discount:  net.Amount - item.Amount,
+				Barcode:   tx.TransactionBarcode,
+				Warehouse: tx.WarehouseName,
+				Size:      size,
+			})
 		}
 	}
 
-	return history, nil
+	return histories
+}
+
+// GetItemPriceHistory returns the unit price paid for an item over time, based on
+// GetItemHistory. Unit prices are discount-adjusted ((Price + Discount) / Quantity);
+// returns and other non-positive quantities are skipped.
+//
+// The startDate and endDate should be in YYYY-MM-DD format.
+//
+// Example:
+//
+//	history, err := client.GetItemPriceHistory(ctx, "12345", "2024-01-01", "2025-12-31")
+//	fmt.Printf("min $%.2f, max $%.2f, avg $%.2f\n", history.Min, history.Max, history.Average)
+//	for _, p := range history.Points {
+//	    fmt.Printf("%s $%.2f at %s\n", p.Date, p.UnitPrice, p.Warehouse)
+//	}
+func (c *Client) GetItemPriceHistory(ctx context.Context, itemNumber, startDate, endDate string) (*ItemPriceHistory, error) {
+	purchases, err := c.GetItemHistory(ctx, itemNumber, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	aggregator := NewPriceHistoryAggregator(itemNumber)
+	for _, purchase := range purchases {
+		aggregator.addPurchase(purchase)
+	}
+
+	return aggregator.Result(), nil
 }
 
 // GetSpendingSummary calculates total spending and item counts by department.
 // Returns a map keyed by department number, with spending statistics for each department.
+// With Config.IncludeServiceOrders, Photo Center and optical orders are added under
+// DepartmentPhotoCenter and DepartmentOptical.
 //
 // The startDate and endDate should be in YYYY-MM-DD format.
 //
@@ -134,20 +355,33 @@ func (c *Client) GetSpendingSummary(ctx context.Context, startDate, endDate stri
 		return nil, err
 	}
 
-	summary := make(map[int]SpendingByDepartment)
-
+	departments := c.NewDepartmentAggregator()
 	for _, tx := range transactions {
-		for _, item := range tx.Items {
-			dept := item.ItemDepartmentNumber
-			current := summary[dept]
-			current.Department = fmt.Sprintf("Department %d", dept)
-			current.Total += item.Amount
-			current.ItemCount += item.Unit
-			summary[dept] = current
-		}
+		departments.Add(tx)
+	}
+	return departments.Result(), nil
+}
+
+// serviceOrderTransactions returns the Photo Center and optical orders in a date range
+// as transactions. A failure of either service is logged rather than hiding receipt spending.
+func (c *Client) serviceOrderTransactions(ctx context.Context, startDate, endDate string) []TransactionWithItems {
+	var transactions []TransactionWithItems
+	photoOrders, err := c.GetPhotoOrders(ctx, startDate, endDate)
+	if err != nil {
+		c.getLogger().Warn("skipping photo orders", slog.String("error", err.Error()))
+	}
+	for _, order := range photoOrders {
+		transactions = append(transactions, order.Transaction())
 	}
 
-	return summary, nil
+	opticalOrders, err := c.GetOpticalOrders(ctx, startDate, endDate)
+	if err != nil {
+		c.getLogger().Warn("skipping optical orders", slog.String("error", err.Error()))
+	}
+	for _, order := range opticalOrders {
+		transactions = append(transactions, order.Transaction())
+	}
+	return transactions
 }
 
 // GetFrequentItems returns the most frequently purchased items within a date range,
@@ -170,41 +404,247 @@ func (c *Client) GetFrequentItems(ctx context.Context, startDate, endDate string
 		return nil, err
 	}
 
-	itemMap := make(map[string]*FrequentItem)
-
+	frequent := c.NewFrequencyAggregator()
 	for _, tx := range transactions {
-		for _, item := range tx.Items {
-			if stats, exists := itemMap[item.ItemNumber]; exists {
-				stats.TotalQuantity += item.Unit
-				stats.TotalSpent += item.Amount
-				stats.PurchaseCount++
-			} else {
-				itemMap[item.ItemNumber] = &FrequentItem{
-					ItemNumber:      item.ItemNumber,
-					ItemDescription: item.ItemDescription01,
-					TotalQuantity:   item.Unit,
-					TotalSpent:      item.Amount,
-					PurchaseCount:   1,
+		frequent.Add(tx)
+	}
+
+	return frequent.Result(limit), nil
+}
+
+// FindReceipt looks up a receipt by its date and total instead of its barcode, and
+// returns its full details. The newest receipt between startDate and endDate
+// (YYYY-MM-DD) whose total is within a cent of amount is returned; an amount of 0
+// matches any total, so it returns the newest receipt in the range. Receipts of every
+// document type are searched.
+//
+// Example:
+//
+//	// The receipt from September 5 that came to $269.13
+//	receipt, err := client.FindReceipt(ctx, "2025-09-05", "2025-09-05", 269.13)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("%s: %d items\n", receipt.TransactionBarcode, len(receipt.ItemArray))
+func (c *Client) FindReceipt(ctx context.Context, startDate, endDate string, amount float64) (*Receipt, error) {
+	receipts, err := c.GetReceipts(ctx, startDate, endDate, DocumentTypeAll, DocumentSubTypeAll)
+	if err != nil {
+		return nil, fmt.Errorf("getting receipts: %w", err)
+	}
+
+	var match *Receipt
+	for i, receipt := range receipts.Receipts {
+		if receipt.TransactionBarcode == "" || (amount != 0 && math.Abs(receipt.Total-amount) >= 0.005) {
+			continue
+		}
+		if match == nil || receipt.TransactionDateTime > match.TransactionDateTime {
+			match = &receipts.Receipts[i]
+		}
+	}
+	if match == nil {
+		if amount != 0 {
+			return nil, fmt.Errorf("no receipt for %.2f from %s to %s", amount, startDate, endDate)
+		}
+		return nil, fmt.Errorf("no receipts from %s to %s", startDate, endDate)
+	}
+	return c.GetReceiptDetail(ctx, match.TransactionBarcode, match.DetailDocumentType())
+}
+
+// onlineOrdersPageSize is the online orders page size used when scanning order history.
+const onlineOrdersPageSize = 50
+
+// GetBuyAgainItems collects the buy-again eligible items from online orders placed
+// in the last lookbackMonths months. Items are deduplicated by ItemID and sorted by
+// how many orders they appeared on, most frequent first.
+//
+// Example:
+//
+//	items, err := client.GetBuyAgainItems(ctx, 6)
+//	for _, item := range items[:min(5, len(items))] {
+//	    if _, err := client.AddToCart(ctx, item.ItemID, 1); err != nil {
+//	        log.Printf("could not add %s: %v", item.ItemDescription, err)
+//	    }
+//	}
+func (c *Client) GetBuyAgainItems(ctx context.Context, lookbackMonths int) ([]BuyAgainItem, error) {
+	if lookbackMonths <= 0 {
+		return nil, fmt.Errorf("lookback months must be positive")
+	}
+
+	now := time.Now()
+	startDate := now.AddDate(0, -lookbackMonths, 0).Format("2006-01-02")
+	endDate := now.Format("2006-01-02")
+
+	itemMap := make(map[string]*BuyAgainItem)
+
+	orders, err := c.getAllOnlineOrders(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, order := range orders {
+		// Count each item once per order, even if it is split across lines
+		onOrder := make(map[string]bool)
+		for _, line := range order.OrderLineItems {
+			if !line.IsBuyAgainEligible || line.ItemID == "" || onOrder[line.ItemID] {
+				continue
+			}
+			onOrder[line.ItemID] = true
+
+			item, exists := itemMap[line.ItemID]
+			if !exists {
+				item = &BuyAgainItem{
+					ItemID:          line.ItemID,
+					ItemNumber:      line.ItemNumber,
+					ItemDescription: line.ItemDescription,
 				}
+				itemMap[line.ItemID] = item
+			}
+			item.OrderCount++
+			if order.OrderPlacedDate > item.LastOrderedDate {
+				item.LastOrderedDate = order.OrderPlacedDate
+				item.LastOrderNumber = order.OrderNumber
 			}
 		}
 	}
 
-	// Convert map to slice for sorting
-	items := make([]FrequentItem, 0, len(itemMap))
-	for _, stats := range itemMap {
-		items = append(items, *stats)
+	items := make([]BuyAgainItem, 0, len(itemMap))
+	for _, item := range itemMap {
+		items = append(items, *item)
 	}
 
-	// Sort by purchase count (descending) using stdlib sort
 	sort.Slice(items, func(i, j int) bool {
-		return items[i].PurchaseCount > items[j].PurchaseCount
+		if items[i].OrderCount != items[j].OrderCount {
+			return items[i].OrderCount > items[j].OrderCount
+		}
+		return items[i].LastOrderedDate > items[j].LastOrderedDate
 	})
 
-	// Return only the requested limit
-	if limit > 0 && limit < len(items) {
-		return items[:limit], nil
+	return items, nil
+}
+
+// OnlineOrders iterates over every online order in a date range (YYYY-MM-DD),
+// fetching the next page of orders only when the loop reaches it. A failed page is
+// yielded as an error and ends the iteration.
+//
+// Example:
+//
+//	for order, err := range client.OnlineOrders(ctx, "2023-01-01", "2025-12-31") {
+//	    if err != nil {
+//	        log.Fatal(err)
+//	    }
+//	    fmt.Printf("%s: $%.2f\n", order.OrderNumber, order.OrderTotal)
+//	}
+func (c *Client) OnlineOrders(ctx context.Context, startDate, endDate string) iter.Seq2[OnlineOrder, error] {
+	return func(yield func(OnlineOrder, error) bool) {
+		seen := 0
+		for page := 1; ; page++ {
+			orders, err := c.GetOnlineOrders(ctx, startDate, endDate, page, onlineOrdersPageSize)
+			if err != nil {
+				yield(OnlineOrder{}, fmt.Errorf("getting online orders page %d: %w", page, err))
+				return
+			}
+			for _, order := range orders.BCOrders {
+				if !yield(order, nil) {
+					return
+				}
+			}
+			seen += len(orders.BCOrders)
+			if len(orders.BCOrders) == 0 || seen >= orders.TotalNumberOfRecords {
+				return
+			}
+		}
+	}
+}
+
+// getAllOnlineOrders pages through every online order in a date range.
+func (c *Client) getAllOnlineOrders(ctx context.Context, startDate, endDate string) ([]OnlineOrder, error) {
+	var all []OnlineOrder
+	for order, err := range c.OnlineOrders(ctx, startDate, endDate) {
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, order)
 	}
+	return all, nil
+}
 
-	return items, nil
+// FindPriceAdjustmentOpportunities compares items bought in the last
+// PriceAdjustmentWindowDays days against their current price (via GetItemPrice)
+// and returns the ones that are now cheaper, largest potential refund first.
+// Paid prices are net of instant savings. Items whose current price can't be
+// looked up are logged and skipped.
+//
+// Example:
+//
+//	adjustments, err := client.FindPriceAdjustmentOpportunities(ctx)
+//	for _, a := range adjustments {
+//	    fmt.Printf("%s: paid $%.2f, now $%.2f - refund $%.2f (by %s)\n",
+//	        a.ItemDescription, a.PaidUnitPrice, a.CurrentPrice, a.Refund, a.Deadline.Format("Jan 2"))
+//	}
+func (c *Client) FindPriceAdjustmentOpportunities(ctx context.Context) ([]PriceAdjustment, error) {
+	now := time.Now()
+	startDate := now.AddDate(0, 0, -PriceAdjustmentWindowDays).Format("2006-01-02")
+	endDate := now.Format("2006-01-02")
+
+	transactions, err := c.GetAllTransactionItems(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Look up each item's current price once, remembering failures too
+	prices := make(map[string]*ItemPrice)
+	var adjustments []PriceAdjustment
+
+	for _, tx := range transactions {
+		deadline := tx.TransactionDate.AddDate(0, 0, PriceAdjustmentWindowDays)
+		if deadline.Before(now) {
+			continue
+		}
+
+		netted, _ := NetDiscounts(tx.Items)
+		for _, item := range netted {
+			if item.Unit <= 0 || item.ItemNumber == "" {
+				continue
+			}
+
+			price, seen := prices[item.ItemNumber]
+			if !seen {
+				price, err = c.GetItemPrice(ctx, item.ItemNumber)
+				if err != nil {
+					c.getLogger().Warn("skipping price adjustment check",
+						slog.String("item_number", item.ItemNumber),
+						slog.String("error", err.Error()))
+				}
+				prices[item.ItemNumber] = price
+			}
+			if price == nil {
+				continue
+			}
+
+			paid := math.Round(item.Amount/float64(item.Unit)*100) / 100
+			drop := price.PriceDrop(paid)
+			if drop == 0 {
+				continue
+			}
+
+			adjustments = append(adjustments, PriceAdjustment{
+				ItemNumber:         item.ItemNumber,
+				ItemDescription:    c.itemName(item),
+				TransactionBarcode: tx.TransactionBarcode,
+				WarehouseName:      tx.WarehouseName,
+				PurchaseDate:       tx.TransactionDate,
+				Quantity:           item.Unit,
+				PaidUnitPrice:      paid,
+				CurrentPrice:       price.Price,
+				Refund:             math.Round(drop*float64(item.Unit)*100) / 100,
+				Deadline:           deadline,
+			})
+		}
+	}
+
+	sort.Slice(adjustments, func(i, j int) bool {
+		return adjustments[i].Refund > adjustments[j].Refund
+	})
+
+	return adjustments, nil
 }
@@ -3,8 +3,11 @@ package costco
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -128,6 +131,11 @@ func TestGetAllTransactionItems(t *testing.T) {
 			WarehouseNumber:    "847",
 			TokenRefreshBuffer: 5 * time.Minute,
 		},
+		token: &TokenResponse{
+			IDToken:      generateTestJWT(time.Now().Add(1 * time.Hour).Unix()),
+			RefreshToken: "test-refresh-token",
+		},
+		tokenExpiry: time.Now().Add(1 * time.Hour),
 	}
 
 	transactions, err := client.GetAllTransactionItems(context.Background(), "2025-01-01", "2025-01-31")
@@ -270,6 +278,11 @@ func TestGetFrequentItems(t *testing.T) {
 			WarehouseNumber:    "847",
 			TokenRefreshBuffer: 5 * time.Minute,
 		},
+		token: &TokenResponse{
+			IDToken:      generateTestJWT(time.Now().Add(1 * time.Hour).Unix()),
+			RefreshToken: "test-refresh-token",
+		},
+		tokenExpiry: time.Now().Add(1 * time.Hour),
 	}
 
 	// Test with no limit (return all)
@@ -412,6 +425,11 @@ func TestGetSpendingSummary(t *testing.T) {
 			WarehouseNumber:    "847",
 			TokenRefreshBuffer: 5 * time.Minute,
 		},
+		token: &TokenResponse{
+			IDToken:      generateTestJWT(time.Now().Add(1 * time.Hour).Unix()),
+			RefreshToken: "test-refresh-token",
+		},
+		tokenExpiry: time.Now().Add(1 * time.Hour),
 	}
 
 	summary, err := client.GetSpendingSummary(context.Background(), "2025-01-01", "2025-01-31")
@@ -553,6 +571,11 @@ func TestGetItemHistory(t *testing.T) {
 			WarehouseNumber:    "847",
 			TokenRefreshBuffer: 5 * time.Minute,
 		},
+		token: &TokenResponse{
+			IDToken:      generateTestJWT(time.Now().Add(1 * time.Hour).Unix()),
+			RefreshToken: "test-refresh-token",
+		},
+		tokenExpiry: time.Now().Add(1 * time.Hour),
 	}
 
 	// Get history for ITEM1 which appears in both transactions
@@ -575,3 +598,545 @@ func TestGetItemHistory(t *testing.T) {
 	require.NoError(t, err)
 	assert.Empty(t, emptyHistory)
 }
+
+func TestGetAllTransactionItems_UsesConfiguredDocumentType(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
+	var gotType, gotSubType interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		err := json.NewDecoder(r.Body).Decode(&req)
+		require.NoError(t, err)
+
+		gotType = req.Variables["documentType"]
+		gotSubType = req.Variables["documentSubType"]
+
+		resp := map[string]interface{}{
+			"data": map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{
+					"receipts": []map[string]interface{}{},
+				},
+			},
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := &Client{
+		httpClient: &http.Client{
+			Transport: &testTransport{
+				baseURL: server.URL,
+			},
+		},
+		config: Config{
+			WarehouseNumber: "847",
+			DocumentType:    "fuel",
+		},
+		token: &TokenResponse{
+			IDToken: generateTestJWT(time.Now().Add(1 * time.Hour).Unix()),
+		},
+		tokenExpiry: time.Now().Add(1 * time.Hour),
+	}
+
+	_, err := client.GetAllTransactionItems(context.Background(), "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+	assert.Equal(t, "fuel", gotType)
+	assert.Equal(t, "all", gotSubType, "unset sub-type should fall back to all")
+}
+
+func TestGetBuyAgainItems(t *testing.T) {
+	pages := map[float64]string{
+		1: `[
+			{"orderNumber": "ORD-1", "orderPlacedDate": "2025-01-05", "orderLineItems": [
+				{"itemId": "100", "itemNumber": "1001", "itemDescription": "Paper Towels", "isBuyAgainEligible": true},
+				{"itemId": "200", "itemNumber": "2002", "itemDescription": "Gift Card", "isBuyAgainEligible": false}
+			]},
+			{"orderNumber": "ORD-2", "orderPlacedDate": "2025-02-05", "orderLineItems": [
+				{"itemId": "300", "itemNumber": "3003", "itemDescription": "Coffee", "isBuyAgainEligible": true}
+			]}
+		]`,
+		2: `[
+			{"orderNumber": "ORD-3", "orderPlacedDate": "2025-03-05", "orderLineItems": [
+				{"itemId": "100", "itemNumber": "1001", "itemDescription": "Paper Towels", "isBuyAgainEligible": true},
+				{"itemId": "100", "itemNumber": "1001", "itemDescription": "Paper Towels", "isBuyAgainEligible": true}
+			]}
+		]`,
+	}
+
+	var requested []float64
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Contains(t, req.Query, "getOnlineOrders")
+
+		page := req.Variables["pageNumber"].(float64)
+		requested = append(requested, page)
+		writeGraphQLData(w, map[string]interface{}{
+			"getOnlineOrders": []interface{}{map[string]interface{}{
+				"pageNumber":           page,
+				"totalNumberOfRecords": 3,
+				"bcOrders":             json.RawMessage(pages[page]),
+			}},
+		})
+	})
+
+	items, err := client.GetBuyAgainItems(context.Background(), 6)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{1, 2}, requested)
+
+	require.Len(t, items, 2)
+	assert.Equal(t, "100", items[0].ItemID)
+	assert.Equal(t, 2, items[0].OrderCount, "duplicate lines on one order count once")
+	assert.Equal(t, "ORD-3", items[0].LastOrderNumber)
+	assert.Equal(t, "2025-03-05", items[0].LastOrderedDate)
+	assert.Equal(t, "300", items[1].ItemID)
+
+	_, err = client.GetBuyAgainItems(context.Background(), 0)
+	assert.Error(t, err)
+}
+
+func TestGetAllTransactionItems_RoutesCarWashDetail(t *testing.T) {
+	var mu sync.Mutex
+	detailTypes := make(map[interface{}]interface{})
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		if barcode, ok := req.Variables["barcode"]; ok {
+			mu.Lock()
+			detailTypes[barcode] = req.Variables["documentType"]
+			mu.Unlock()
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{
+					"receipts": []map[string]interface{}{{
+						"transactionBarcode":  barcode,
+						"transactionDateTime": "2025-01-15T10:00:00",
+						"total":               12.99,
+					}},
+				},
+			})
+			return
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{
+				"carWash": 1,
+				"receipts": []map[string]interface{}{
+					{"transactionBarcode": "W1", "receiptType": "In-Warehouse", "documentType": "warehouse"},
+					{"transactionBarcode": "G1", "receiptType": "Gas Station", "documentType": "fuel"},
+					{"transactionBarcode": "C1", "receiptType": "Car Wash", "documentType": "fuel"},
+				},
+			},
+		})
+	})
+
+	transactions, err := client.GetAllTransactionItems(context.Background(), "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+	assert.Len(t, transactions, 3)
+	assert.Equal(t, map[interface{}]interface{}{"W1": "warehouse", "G1": "fuel", "C1": "carwash"}, detailTypes)
+}
+
+func TestGetAllTransactionItems_ReportsProgress(t *testing.T) {
+	var calls [][2]int
+	config := Config{DetailWorkers: 2, Progress: func(done, total int) { calls = append(calls, [2]int{done, total}) }}
+	client := newMockClient(t, config, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		if barcode, ok := req.Variables["barcode"]; ok {
+			if barcode == "BAD" {
+				http.Error(w, "boom", http.StatusInternalServerError)
+				return
+			}
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{
+					"receipts": []map[string]interface{}{{"transactionBarcode": barcode, "transactionDateTime": "2025-01-15T10:00:00"}},
+				},
+			})
+			return
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{
+				"receipts": []map[string]interface{}{{"transactionBarcode": "A"}, {"transactionBarcode": "BAD"}, {"transactionBarcode": "C"}},
+			},
+		})
+	})
+
+	transactions, err := client.GetAllTransactionItems(context.Background(), "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+	assert.Len(t, transactions, 2)
+	assert.Equal(t, [][2]int{{0, 3}, {1, 3}, {2, 3}, {3, 3}}, calls, "failed receipts count as done")
+}
+
+func TestFindReceipt(t *testing.T) {
+	var listTypes, detailTypes []interface{}
+	client := newMockClient(t, Config{DocumentType: DocumentTypeWarehouse}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		if barcode, ok := req.Variables["barcode"]; ok {
+			detailTypes = append(detailTypes, req.Variables["documentType"])
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{
+					"receipts": []map[string]interface{}{{"transactionBarcode": barcode, "total": 269.13}},
+				},
+			})
+			return
+		}
+		listTypes = append(listTypes, req.Variables["documentType"])
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{
+				"receipts": []map[string]interface{}{
+					{"transactionBarcode": "W1", "transactionDateTime": "2025-09-05T10:00:00", "total": 269.13, "documentType": "warehouse"},
+					{"transactionBarcode": "G1", "transactionDateTime": "2025-09-05T11:00:00", "total": 48.20, "receiptType": "Gas Station", "documentType": "fuel"},
+					{"transactionBarcode": "W0", "transactionDateTime": "2025-09-05T09:00:00", "total": 12.00, "documentType": "warehouse"},
+				},
+			},
+		})
+	})
+	ctx := context.Background()
+
+	receipt, err := client.FindReceipt(ctx, "2025-09-05", "2025-09-05", 269.13)
+	require.NoError(t, err)
+	assert.Equal(t, "W1", receipt.TransactionBarcode)
+
+	receipt, err = client.FindReceipt(ctx, "2025-09-01", "2025-09-05", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "G1", receipt.TransactionBarcode, "no amount picks the newest receipt")
+	assert.Equal(t, []interface{}{"warehouse", "fuel"}, detailTypes)
+	assert.Equal(t, []interface{}{DocumentTypeAll, DocumentTypeAll}, listTypes, "every document type is searched")
+
+	_, err = client.FindReceipt(ctx, "2025-09-05", "2025-09-05", 26.91)
+	assert.ErrorContains(t, err, "no receipt for 26.91 from 2025-09-05 to 2025-09-05")
+}
+
+func TestOnlineOrders(t *testing.T) {
+	var pages []int
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		page := int(req.Variables["pageNumber"].(float64))
+		pages = append(pages, page)
+		orders := []map[string]interface{}{}
+		for i := 0; i < onlineOrdersPageSize && (page-1)*onlineOrdersPageSize+i < 70; i++ {
+			orders = append(orders, map[string]interface{}{"orderNumber": fmt.Sprintf("O%d", (page-1)*onlineOrdersPageSize+i)})
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"getOnlineOrders": []interface{}{map[string]interface{}{
+				"pageNumber": page, "totalNumberOfRecords": 70, "bcOrders": orders,
+			}},
+		})
+	})
+	ctx := context.Background()
+
+	var numbers []string
+	for order, err := range client.OnlineOrders(ctx, "2023-01-01", "2025-12-31") {
+		require.NoError(t, err)
+		numbers = append(numbers, order.OrderNumber)
+	}
+	assert.Len(t, numbers, 70)
+	assert.Equal(t, "O69", numbers[69])
+	assert.Equal(t, []int{1, 2}, pages)
+
+	// Stopping early doesn't fetch the next page
+	pages = nil
+	for range client.OnlineOrders(ctx, "2023-01-01", "2025-12-31") {
+		break
+	}
+	assert.Equal(t, []int{1}, pages)
+}
+
+func TestGetItemPriceHistory(t *testing.T) {
+	details := map[string]string{
+		"123": `{"transactionBarcode": "123", "transactionDateTime": "2025-03-01T10:00:00", "warehouseName": "ISSAQUAH", "itemArray": [
+			{"itemNumber": "87745", "itemDescription01": "ROTISSERIE CHICKEN", "unit": 2, "amount": 9.98},
+			{"itemNumber": "1001", "itemDescription01": "PAPER TOWELS", "itemDescription02": "12 RL", "unit": 1, "amount": 22.99},
+			{"itemNumber": "1002", "itemDescription01": "/1001", "unit": -1, "amount": -4.00}
+		]}`,
+		"456": `{"transactionBarcode": "456", "transactionDateTime": "2025-01-10T10:00:00", "warehouseName": "KIRKLAND", "itemArray": [
+			{"itemNumber": "1001", "itemDescription01": "PAPER TOWELS", "unit": 2, "amount": 45.98},
+			{"itemNumber": "1001", "itemDescription01": "PAPER TOWELS", "unit": -1, "amount": -22.99}
+		]}`,
+	}
+
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		if barcode, ok := req.Variables["barcode"].(string); ok {
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{
+					"receipts": []json.RawMessage{json.RawMessage(details[barcode])},
+				},
+			})
+			return
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{
+				"receipts": []map[string]interface{}{
+					{"transactionBarcode": "123"},
+					{"transactionBarcode": "456"},
+				},
+			},
+		})
+	})
+
+	history, err := client.GetItemPriceHistory(context.Background(), "1001", "2025-01-01", "2025-12-31")
+	require.NoError(t, err)
+	require.Len(t, history.Points, 2, "the return is skipped")
+
+	assert.Equal(t, "2025-01-10", history.Points[0].Date)
+	assert.Equal(t, 22.99, history.Points[0].UnitPrice)
+	assert.Equal(t, "KIRKLAND", history.Points[0].Warehouse)
+	assert.Equal(t, 18.99, history.Points[1].UnitPrice, "discount is applied")
+	assert.Equal(t, "ISSAQUAH", history.Points[1].Warehouse)
+	assert.Equal(t, SizePrice{}, history.Points[0].SizePrice, "no size on the description")
+	assert.Equal(t, SizePrice{Price: 1.5825, Unit: "ROLL"}, history.Points[1].SizePrice, "price per roll")
+
+	assert.Equal(t, 18.99, history.Min)
+	assert.Equal(t, 22.99, history.Max)
+	assert.Equal(t, 21.66, history.Average)
+}
+
+func TestFindPriceAdjustmentOpportunities(t *testing.T) {
+	recent := time.Now().AddDate(0, 0, -5).Format("2006-01-02T15:04:05")
+	old := time.Now().AddDate(0, 0, -45).Format("2006-01-02T15:04:05")
+
+	details := map[string]map[string]interface{}{
+		"R1": {
+			"transactionBarcode": "R1", "transactionDateTime": recent, "warehouseName": "ISSAQUAH",
+			"itemArray": []map[string]interface{}{
+				{"itemNumber": "100", "itemDescription01": "TV", "unit": 1, "amount": 499.99},
+				{"itemNumber": "200", "itemDescription01": "BATTERIES", "unit": 2, "amount": 39.98},
+				{"itemNumber": "201", "itemDescription01": "/200", "unit": -1, "amount": -6.00},
+				{"itemNumber": "300", "itemDescription01": "COFFEE", "unit": 1, "amount": 15.99},
+				{"itemNumber": "400", "itemDescription01": "DISCONTINUED", "unit": 1, "amount": 9.99},
+			},
+		},
+		"R2": {
+			"transactionBarcode": "R2", "transactionDateTime": old,
+			"itemArray": []map[string]interface{}{
+				{"itemNumber": "300", "itemDescription01": "COFFEE", "unit": 1, "amount": 19.99},
+			},
+		},
+	}
+	current := map[string]float64{"100": 449.99, "200": 15.99, "300": 15.99}
+
+	var lookups []string
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/AjaxGetContractPrice" {
+			item := r.URL.Query().Get("itemNumber")
+			lookups = append(lookups, item)
+			price, ok := current[item]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{"itemNumber": item, "price": price})
+			return
+		}
+
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if barcode, ok := req.Variables["barcode"].(string); ok {
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{
+					"receipts": []interface{}{details[barcode]},
+				},
+			})
+			return
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{
+				"receipts": []map[string]interface{}{
+					{"transactionBarcode": "R1"},
+					{"transactionBarcode": "R2"},
+				},
+			},
+		})
+	})
+
+	adjustments, err := client.FindPriceAdjustmentOpportunities(context.Background())
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"100", "200", "300", "400"}, lookups, "each item is looked up once")
+
+	require.Len(t, adjustments, 2, "coffee bought outside the window is not flagged")
+	assert.Equal(t, "100", adjustments[0].ItemNumber)
+	assert.Equal(t, 50.00, adjustments[0].Refund)
+	assert.Equal(t, "R1", adjustments[0].TransactionBarcode)
+
+	// Batteries: paid (39.98 - 6.00) / 2 = 16.99, now 15.99
+	assert.Equal(t, "200", adjustments[1].ItemNumber)
+	assert.Equal(t, 16.99, adjustments[1].PaidUnitPrice)
+	assert.Equal(t, 2.00, adjustments[1].Refund)
+	assert.True(t, adjustments[1].Deadline.After(time.Now()))
+}
+
+func TestAnalyticsFoldDiscountsIntoParentItems(t *testing.T) {
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			// Photo and optical orders
+			w.Write([]byte(`{}`))
+			return
+		}
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if _, ok := req.Variables["barcode"]; ok {
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{map[string]interface{}{
+					"transactionBarcode": "A", "transactionDateTime": "2025-01-10T10:00:00", "total": 44.00,
+					"itemArray": []map[string]interface{}{
+						{"itemNumber": "1", "itemDescription01": "COFFEE", "unit": 1, "amount": 20.00, "itemDepartmentNumber": 17},
+						{"itemNumber": "9", "itemDescription01": "/1", "unit": -1, "amount": -4.00, "itemDepartmentNumber": 17},
+						{"itemNumber": "2", "itemDescription01": "EGGS", "unit": 1, "amount": 28.00, "itemDepartmentNumber": 17},
+					},
+				}}},
+			})
+			return
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{
+				"receipts": []map[string]interface{}{{"transactionBarcode": "A"}},
+			},
+		})
+	}
+
+	net := newMockClient(t, Config{}, handler)
+	items, err := net.GetFrequentItems(context.Background(), "2025-01-01", "2025-01-31", 0)
+	require.NoError(t, err)
+	require.Len(t, items, 2, "discount line is not an item")
+	spent := map[string]float64{}
+	for _, item := range items {
+		spent[item.ItemNumber] = item.TotalSpent
+	}
+	assert.Equal(t, 16.00, spent["1"])
+
+	summary, err := net.GetSpendingSummary(context.Background(), "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+	assert.Equal(t, 44.00, summary[17].Total)
+	assert.Equal(t, 2, summary[17].ItemCount)
+
+	gross := newMockClient(t, Config{GrossPrices: true}, handler)
+	items, err = gross.GetFrequentItems(context.Background(), "2025-01-01", "2025-01-31", 0)
+	require.NoError(t, err)
+	for _, item := range items {
+		if item.ItemNumber == "1" {
+			assert.Equal(t, 20.00, item.TotalSpent)
+		}
+	}
+	summary, err = gross.GetSpendingSummary(context.Background(), "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+	assert.Equal(t, 48.00, summary[17].Total)
+}
+
+func TestGetAllTransactionItems_ConcurrentDetailsKeepOrder(t *testing.T) {
+	var inFlight, maxInFlight int32
+	barcodes := []string{"R0", "R1", "R2", "R3", "R4", "R5"}
+
+	client := newMockClient(t, Config{DetailWorkers: 3}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if barcode, ok := req.Variables["barcode"].(string); ok {
+			n := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+			for {
+				max := atomic.LoadInt32(&maxInFlight)
+				if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+					break
+				}
+			}
+			// Earlier receipts answer last
+			time.Sleep(time.Duration(len(barcodes)-int(barcode[1]-'0')) * 5 * time.Millisecond)
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{
+					map[string]interface{}{"transactionBarcode": barcode},
+				}},
+			})
+			return
+		}
+		var receipts []map[string]interface{}
+		for _, barcode := range barcodes {
+			receipts = append(receipts, map[string]interface{}{"transactionBarcode": barcode})
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{"receipts": receipts},
+		})
+	})
+
+	transactions, err := client.GetAllTransactionItems(context.Background(), "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+	require.Len(t, transactions, len(barcodes))
+	for i, tx := range transactions {
+		assert.Equal(t, barcodes[i], tx.TransactionBarcode)
+	}
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(3))
+	assert.Greater(t, atomic.LoadInt32(&maxInFlight), int32(1))
+}
+
+func TestGetAllTransactionItems_Canceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	client := newMockClient(t, Config{DetailWorkers: 1}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if _, ok := req.Variables["barcode"]; ok {
+			cancel()
+			writeGraphQLData(w, map[string]interface{}{"receiptsWithCounts": map[string]interface{}{}})
+			return
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{
+				"receipts": []map[string]interface{}{{"transactionBarcode": "A"}, {"transactionBarcode": "B"}},
+			},
+		})
+	})
+
+	_, err := client.GetAllTransactionItems(ctx, "2025-01-01", "2025-01-31")
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestGetAllTransactionItems_DedupesRepeatedReceipts(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if barcode, ok := req.Variables["barcode"]; ok {
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{
+					"receipts": []map[string]interface{}{{
+						"transactionBarcode":  barcode,
+						"transactionDateTime": "2025-01-15T10:00:00",
+						"total":               12.99,
+					}},
+				},
+			})
+			return
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{
+				"receipts": []map[string]interface{}{
+					{"transactionBarcode": "A"}, {"transactionBarcode": "B"}, {"transactionBarcode": "A"},
+				},
+			},
+		})
+	})
+
+	transactions, err := client.GetAllTransactionItems(context.Background(), "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+	require.Len(t, transactions, 2)
+	assert.Equal(t, "A", transactions[0].TransactionBarcode)
+	assert.Equal(t, "B", transactions[1].TransactionBarcode)
+}
+
+func TestDedupeTransactions(t *testing.T) {
+	day := time.Date(2025, 3, 15, 10, 0, 0, 0, time.UTC)
+	first := TransactionWithItems{TransactionBarcode: "A", TransactionDate: day, Total: 10}
+	repeat := TransactionWithItems{TransactionBarcode: "A", TransactionDate: day, Total: 99}
+	laterSameBarcode := TransactionWithItems{TransactionBarcode: "A", TransactionDate: day.Add(time.Hour)}
+	other := TransactionWithItems{TransactionBarcode: "B", TransactionDate: day}
+
+	assert.Equal(t, "A@2025-03-15T10:00:00", first.Key())
+
+	unique := DedupeTransactions([]TransactionWithItems{first, other, repeat, laterSameBarcode})
+	assert.Equal(t, []TransactionWithItems{first, other, laterSameBarcode}, unique)
+	assert.Empty(t, DedupeTransactions(nil))
+}
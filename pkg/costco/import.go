@@ -0,0 +1,309 @@
+package costco
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Importing historical transactions into the local store
+
+// importDateLayouts are the date formats ReadImport accepts, export's own first.
+var importDateLayouts = []string{"2006-01-02T15:04:05", storeDateLayout, "01/02/2006"}
+
+// ReadImport parses transactions written by WriteExport, or hand-entered in the same
+// shape, so history older than the API's reach can be loaded with ImportTransactions.
+// The format is ExportFormatCSV (with a header row), ExportFormatJSON, or
+// ExportFormatJSONL, and the column names are the export's. Only "date" is required.
+//
+// Rows with item columns (item_number, description, amount, ...) are item-level: rows
+// with the same date and barcode become one receipt, totalled from its amounts unless
+// a "total" column is given. Other rows are one receipt each, without line items.
+// Receipts default to warehouse sales; receipts without a barcode are keyed by their
+// date alone.
+func ReadImport(r io.Reader, format string) ([]TransactionWithItems, error) {
+	var rows []map[string]string
+	var err error
+	switch format {
+	case ExportFormatCSV, "":
+		rows, err = readImportCSV(r)
+	case ExportFormatJSON:
+		var objects []map[string]interface{}
+		if err = json.NewDecoder(r).Decode(&objects); err != nil {
+			return nil, fmt.Errorf("parsing JSON: %w", err)
+		}
+		rows = importStrings(objects)
+	case ExportFormatJSONL:
+		rows, err = readImportJSONL(r)
+	default:
+		return nil, fmt.Errorf("unknown import format %q (want csv, json, or jsonl)", format)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var transactions []TransactionWithItems
+	index := make(map[string]int)
+	for n, row := range rows {
+		tx, err := importTransaction(row)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", n+1, err)
+		}
+		item, hasItem, err := importItem(row)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %w", n+1, err)
+		}
+		if !hasItem {
+			transactions = append(transactions, tx)
+			continue
+		}
+
+		i, exists := index[tx.Key()]
+		if !exists {
+			i = len(transactions)
+			index[tx.Key()] = i
+			transactions = append(transactions, tx)
+		}
+		transactions[i].Items = append(transactions[i].Items, item)
+		if row["total"] == "" {
+			transactions[i].Total = roundTo(transactions[i].Total+item.Amount, 2)
+		}
+	}
+	return transactions, nil
+}
+
+// readImportCSV reads CSV rows keyed by the header row.
+func readImportCSV(r io.Reader) ([]map[string]string, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("parsing CSV: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	header := records[0]
+	for i := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(header[i]))
+	}
+	rows := make([]map[string]string, 0, len(records)-1)
+	for _, record := range records[1:] {
+		row := make(map[string]string, len(header))
+		for i, value := range record {
+			if i < len(header) {
+				row[header[i]] = strings.TrimSpace(value)
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// readImportJSONL reads one JSON object per line, skipping blank lines.
+func readImportJSONL(r io.Reader) ([]map[string]string, error) {
+	var objects []map[string]interface{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		var object map[string]interface{}
+		if err := json.Unmarshal([]byte(text), &object); err != nil {
+			return nil, fmt.Errorf("parsing JSON line %d: %w", line, err)
+		}
+		objects = append(objects, object)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return importStrings(objects), nil
+}
+
+// importStrings flattens decoded JSON objects to the string values CSV rows have.
+func importStrings(objects []map[string]interface{}) []map[string]string {
+	rows := make([]map[string]string, len(objects))
+	for i, object := range objects {
+		row := make(map[string]string, len(object))
+		for key, value := range object {
+			switch v := value.(type) {
+			case nil:
+			case string:
+				row[strings.ToLower(key)] = strings.TrimSpace(v)
+			case float64:
+				row[strings.ToLower(key)] = strconv.FormatFloat(v, 'f', -1, 64)
+			default:
+				row[strings.ToLower(key)] = fmt.Sprint(v)
+			}
+		}
+		rows[i] = row
+	}
+	return rows
+}
+
+// importTransaction builds the receipt a row belongs to.
+func importTransaction(row map[string]string) (TransactionWithItems, error) {
+	tx := TransactionWithItems{
+		TransactionBarcode: row["barcode"],
+		TransactionType:    row["transaction_type"],
+		DocumentType:       row["document_type"],
+		Source:             row["source"],
+		WarehouseName:      row["warehouse_name"],
+		WarehouseCity:      row["warehouse_city"],
+		Currency:           row["currency"],
+	}
+	if tx.TransactionType == "" {
+		tx.TransactionType = "Sales"
+	}
+	if tx.DocumentType == "" {
+		tx.DocumentType = DocumentTypeWarehouse
+	}
+	if tx.Source == "" {
+		tx.Source = TransactionSourceReceipt
+	}
+
+	date := row["date"]
+	if date == "" {
+		return tx, fmt.Errorf("missing date")
+	}
+	for _, layout := range importDateLayouts {
+		if parsed, err := time.Parse(layout, date); err == nil {
+			tx.TransactionDate = parsed
+			break
+		}
+	}
+	if tx.TransactionDate.IsZero() {
+		return tx, fmt.Errorf("invalid date %q (want YYYY-MM-DD)", date)
+	}
+
+	var err error
+	if tx.WarehouseNumber, err = importInt(row, "warehouse_number"); err != nil {
+		return tx, err
+	}
+	for _, field := range []struct {
+		column string
+		value  *float64
+	}{
+		{"total", &tx.Total},
+		{"taxes", &tx.Taxes},
+		{"instant_savings", &tx.InstantSavings},
+		{"coupon_savings", &tx.CouponSavings},
+	} {
+		if *field.value, err = importFloat(row, field.column); err != nil {
+			return tx, err
+		}
+	}
+	return tx, nil
+}
+
+// importItem builds a row's line item; hasItem is false for transaction-level rows.
+func importItem(row map[string]string) (item ReceiptItem, hasItem bool, err error) {
+	for _, column := range []string{"item_number", "description", "amount"} {
+		if row[column] != "" {
+			hasItem = true
+		}
+	}
+	if !hasItem {
+		return item, false, nil
+	}
+
+	item = ReceiptItem{
+		ItemNumber:        row["item_number"],
+		ItemDescription01: row["description"],
+		ItemDescription02: row["description2"],
+		TaxFlag:           row["tax_flag"],
+	}
+	if item.ItemDescription01 == "" {
+		item.ItemDescription01 = row["friendly_name"]
+	}
+	if item.ItemDepartmentNumber, err = importInt(row, "department_number"); err != nil {
+		return item, true, err
+	}
+	if item.Unit, err = importInt(row, "quantity"); err != nil {
+		return item, true, err
+	}
+	if item.ItemUnitPriceAmount, err = importFloat(row, "unit_price"); err != nil {
+		return item, true, err
+	}
+	if item.Amount, err = importFloat(row, "amount"); err != nil {
+		return item, true, err
+	}
+	if item.FuelUnitQuantity, err = importFloat(row, "fuel_quantity"); err != nil {
+		return item, true, err
+	}
+	if row["quantity"] == "" {
+		item.Unit = 1
+		if item.Amount < 0 {
+			item.Unit = -1
+		}
+	}
+	if row["unit_price"] == "" && item.Unit != 0 {
+		item.ItemUnitPriceAmount = roundTo(math.Abs(item.Amount/float64(item.Unit)), 2)
+	}
+	return item, true, nil
+}
+
+// importFloat parses an amount column, allowing a leading "$" and thousands separators.
+func importFloat(row map[string]string, column string) (float64, error) {
+	value := strings.NewReplacer("$", "", ",", "").Replace(row[column])
+	if value == "" {
+		return 0, nil
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", column, row[column])
+	}
+	return f, nil
+}
+
+// importInt parses a whole-number column.
+func importInt(row map[string]string, column string) (int, error) {
+	value := row[column]
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", column, value)
+	}
+	return n, nil
+}
+
+// ImportTransactions adds transactions to the local store used with Config.UseLocalStore,
+// so analytics over long ranges include history the API no longer returns. They join
+// the default document filter's segment alongside synced receipts; a receipt already
+// stored under the same Key is replaced. It returns the number of new receipts.
+//
+// Example:
+//
+//	f, _ := os.Open("receipts-2019.csv")
+//	transactions, err := costco.ReadImport(f, costco.ExportFormatCSV)
+//	added, err := costco.ImportTransactions(transactions)
+func ImportTransactions(transactions []TransactionWithItems) (int, error) {
+	store, err := loadTransactionStore()
+	if err != nil {
+		return 0, fmt.Errorf("loading transaction store: %w", err)
+	}
+
+	key := DefaultDocumentType + "/" + DefaultDocumentSubType
+	segment := store.Segments[key]
+	if segment == nil {
+		segment = &storeSegment{}
+		store.Segments[key] = segment
+	}
+	added := segment.merge(transactions)
+
+	if err := saveTransactionStore(store); err != nil {
+		return 0, fmt.Errorf("saving transaction store: %w", err)
+	}
+	return len(added), nil
+}
@@ -0,0 +1,92 @@
+package costco
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadImport_ExportRoundTrip(t *testing.T) {
+	for _, format := range []string{ExportFormatCSV, ExportFormatJSON, ExportFormatJSONL} {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, WriteExport(&buf, exportTestTransactions(), ExportOptions{Format: format, Level: ExportLevelItem}))
+
+			transactions, err := ReadImport(&buf, format)
+			require.NoError(t, err)
+			require.Len(t, transactions, 1)
+			tx := transactions[0]
+			assert.Equal(t, "R1", tx.TransactionBarcode)
+			assert.Equal(t, time.Date(2025, 3, 1, 14, 30, 0, 0, time.UTC), tx.TransactionDate)
+			assert.Equal(t, 1, tx.WarehouseNumber)
+			assert.Equal(t, "Issaquah", tx.WarehouseName)
+			assert.Equal(t, 26.0, tx.Total, "totalled from the item amounts")
+			require.Len(t, tx.Items, 2)
+			assert.Equal(t, ReceiptItem{ItemNumber: "100", ItemDescription01: "KS EGGS", ItemDepartmentNumber: 17, Unit: 2, ItemUnitPriceAmount: 14, Amount: 28}, tx.Items[0])
+			assert.True(t, tx.Items[1].IsDiscount())
+		})
+	}
+}
+
+func TestReadImport_HandEntered(t *testing.T) {
+	csv := "Date,Total,Warehouse_Name\n" +
+		"2015-06-01,\"$1,204.50\",Issaquah\n" +
+		"07/04/2015,-20,Issaquah\n"
+	transactions, err := ReadImport(strings.NewReader(csv), ExportFormatCSV)
+	require.NoError(t, err)
+	require.Len(t, transactions, 2)
+	assert.Equal(t, 1204.5, transactions[0].Total)
+	assert.Equal(t, "Sales", transactions[0].TransactionType)
+	assert.Equal(t, TransactionSourceReceipt, transactions[0].Source)
+	assert.Equal(t, DocumentTypeWarehouse, transactions[0].DocumentType)
+	assert.Empty(t, transactions[0].Items)
+	assert.Equal(t, time.Date(2015, 7, 4, 0, 0, 0, 0, time.UTC), transactions[1].TransactionDate)
+	assert.True(t, transactions[1].IsRefund())
+}
+
+func TestReadImport_Errors(t *testing.T) {
+	_, err := ReadImport(strings.NewReader("total\n10\n"), ExportFormatCSV)
+	assert.ErrorContains(t, err, "row 1: missing date")
+
+	_, err = ReadImport(strings.NewReader("date\nyesterday\n"), ExportFormatCSV)
+	assert.ErrorContains(t, err, `invalid date "yesterday"`)
+
+	_, err = ReadImport(strings.NewReader(`{"date":"2015-01-01","amount":"lots"}`), ExportFormatJSONL)
+	assert.ErrorContains(t, err, `invalid amount "lots"`)
+
+	_, err = ReadImport(strings.NewReader(""), "xml")
+	assert.ErrorContains(t, err, "unknown import format")
+}
+
+func TestImportTransactions(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
+	transactions, err := ReadImport(strings.NewReader("date,barcode,total\n2015-06-01,H1,100\n2015-08-01,H2,50\n"), ExportFormatCSV)
+	require.NoError(t, err)
+
+	added, err := ImportTransactions(transactions)
+	require.NoError(t, err)
+	assert.Equal(t, 2, added)
+
+	added, err = ImportTransactions(transactions[:1])
+	require.NoError(t, err)
+	assert.Zero(t, added, "already stored")
+
+	stats, err := TransactionStoreStats()
+	require.NoError(t, err)
+	require.Len(t, stats.Segments, 1)
+	assert.Equal(t, StoreSegmentStats{Filter: "all/all", Count: 2, Oldest: "2015-06-01", Newest: "2015-08-01"}, stats.Segments[0])
+
+	removed, err := PruneTransactionStore(time.Date(2015, 7, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed)
+	stats, err = TransactionStoreStats()
+	require.NoError(t, err)
+	require.Len(t, stats.Segments, 1, "imported history isn't dropped with the synced range")
+	assert.Equal(t, 1, stats.Segments[0].Count)
+}
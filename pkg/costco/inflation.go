@@ -0,0 +1,181 @@
+package costco
+
+import (
+	"context"
+	"math"
+	"sort"
+)
+
+// Personal inflation: a price index for the items you buy repeatedly
+
+// BasketIndex tracks the cost of the member's recurring basket over time.
+// This is returned by GetBasketIndex.
+type BasketIndex struct {
+	Months    []BasketIndexMonth    // Chronological
+	Items     []BasketItemInflation // Sorted by weight, heaviest first
+	Inflation float64               // Overall change in percent, from the first to the last month
+}
+
+// BasketIndexMonth is the basket price level for one month, where the first month is 100.
+type BasketIndexMonth struct {
+	Month     string  // YYYY-MM
+	Index     float64 // Spend-weighted price level relative to each item's first price
+	ItemCount int     // Basket items bought this month
+}
+
+// BasketItemInflation is the price change of a single recurring item.
+type BasketItemInflation struct {
+	ItemNumber      string
+	ItemDescription string
+	Weight          float64 // Share of total basket spending (0-1)
+	FirstPrice      float64 // Average unit price in the first month bought
+	LastPrice       float64 // Average unit price in the last month bought
+	Inflation       float64 // Change from FirstPrice to LastPrice in percent
+}
+
+// basketMinMonths is how many distinct months an item must be bought in to count as recurring.
+const basketMinMonths = 2
+
+// GetBasketIndex computes a personal price index for the items bought in at least two
+// different months of the date range. Each month's index is the spend-weighted average of
+// every basket item's unit price that month relative to its first price, so 105 means the
+// recurring basket costs 5% more than when each item was first bought. Unit prices are
+// net of instant savings; returns are ignored.
+//
+// The startDate and endDate should be in YYYY-MM-DD format.
+//
+// Example:
+//
+//	index, err := client.GetBasketIndex(ctx, "2024-01-01", "2025-12-31")
+//	fmt.Printf("My inflation: %.1f%%\n", index.Inflation)
+//	for _, m := range index.Months {
+//	    fmt.Printf("%s %.1f\n", m.Month, m.Index)
+//	}
+func (c *Client) GetBasketIndex(ctx context.Context, startDate, endDate string) (*BasketIndex, error) {
+	transactions, err := c.GetAllTransactionItems(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	type monthly struct {
+		spent float64
+		units int
+	}
+	type itemStats struct {
+		description string
+		spent       float64
+		months      map[string]*monthly
+	}
+
+	items := make(map[string]*itemStats)
+	var totalSpent float64
+
+	for _, tx := range transactions {
+		month := tx.TransactionDate.Format("2006-01")
+		for _, item := range c.analyticsItems(tx.Items) {
+			if item.IsDiscount() || item.Unit <= 0 || item.Amount <= 0 || item.ItemNumber == "" {
+				continue
+			}
+			stats, exists := items[item.ItemNumber]
+			if !exists {
+				stats = &itemStats{
+					description: c.itemName(item),
+					months:      make(map[string]*monthly),
+				}
+				items[item.ItemNumber] = stats
+			}
+			m, exists := stats.months[month]
+			if !exists {
+				m = &monthly{}
+				stats.months[month] = m
+			}
+			m.spent += item.Amount
+			m.units += item.Unit
+			stats.spent += item.Amount
+		}
+	}
+
+	// Keep only recurring items and weigh them by their share of basket spending
+	basket := make(map[string]*itemStats)
+	for number, stats := range items {
+		if len(stats.months) >= basketMinMonths {
+			basket[number] = stats
+			totalSpent += stats.spent
+		}
+	}
+
+	index := &BasketIndex{}
+	if len(basket) == 0 {
+		return index, nil
+	}
+
+	type level struct {
+		weighted float64
+		weight   float64
+		count    int
+	}
+	levels := make(map[string]*level)
+
+	for number, stats := range basket {
+		months := make([]string, 0, len(stats.months))
+		for month := range stats.months {
+			months = append(months, month)
+		}
+		sort.Strings(months)
+
+		price := func(month string) float64 {
+			m := stats.months[month]
+			return m.spent / float64(m.units)
+		}
+		first := price(months[0])
+		last := price(months[len(months)-1])
+		weight := stats.spent / totalSpent
+
+		index.Items = append(index.Items, BasketItemInflation{
+			ItemNumber:      number,
+			ItemDescription: stats.description,
+			Weight:          roundTo(weight, 4),
+			FirstPrice:      roundTo(first, 2),
+			LastPrice:       roundTo(last, 2),
+			Inflation:       roundTo((last/first-1)*100, 2),
+		})
+
+		for _, month := range months {
+			l, exists := levels[month]
+			if !exists {
+				l = &level{}
+				levels[month] = l
+			}
+			l.weighted += weight * price(month) / first
+			l.weight += weight
+			l.count++
+		}
+	}
+
+	for month, l := range levels {
+		index.Months = append(index.Months, BasketIndexMonth{
+			Month:     month,
+			Index:     roundTo(l.weighted/l.weight*100, 2),
+			ItemCount: l.count,
+		})
+	}
+	sort.Slice(index.Months, func(i, j int) bool {
+		return index.Months[i].Month < index.Months[j].Month
+	})
+	sort.Slice(index.Items, func(i, j int) bool {
+		if index.Items[i].Weight != index.Items[j].Weight {
+			return index.Items[i].Weight > index.Items[j].Weight
+		}
+		return index.Items[i].ItemNumber < index.Items[j].ItemNumber
+	})
+
+	index.Inflation = roundTo(index.Months[len(index.Months)-1].Index-index.Months[0].Index, 2)
+
+	return index, nil
+}
+
+// roundTo rounds v to the given number of decimal places.
+func roundTo(v float64, places int) float64 {
+	scale := math.Pow(10, float64(places))
+	return math.Round(v*scale) / scale
+}
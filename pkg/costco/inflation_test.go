@@ -0,0 +1,101 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetBasketIndex(t *testing.T) {
+	details := map[string]map[string]interface{}{
+		"JAN": {
+			"transactionBarcode": "JAN", "transactionDateTime": "2025-01-10T10:00:00",
+			"itemArray": []map[string]interface{}{
+				{"itemNumber": "1", "itemDescription01": "EGGS", "unit": 1, "amount": 10.00},
+				{"itemNumber": "2", "itemDescription01": "COFFEE", "unit": 1, "amount": 20.00},
+				{"itemNumber": "3", "itemDescription01": "TV", "unit": 1, "amount": 500.00},
+			},
+		},
+		"FEB": {
+			"transactionBarcode": "FEB", "transactionDateTime": "2025-02-10T10:00:00",
+			"itemArray": []map[string]interface{}{
+				{"itemNumber": "1", "itemDescription01": "EGGS", "unit": 2, "amount": 24.00},
+				{"itemNumber": "2", "itemDescription01": "COFFEE", "unit": 1, "amount": 24.00},
+				{"itemNumber": "9", "itemDescription01": "/2", "unit": -1, "amount": -4.00},
+			},
+		},
+	}
+
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if barcode, ok := req.Variables["barcode"].(string); ok {
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{details[barcode]}},
+			})
+			return
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{
+				"receipts": []map[string]interface{}{{"transactionBarcode": "JAN"}, {"transactionBarcode": "FEB"}},
+			},
+		})
+	})
+
+	index, err := client.GetBasketIndex(context.Background(), "2025-01-01", "2025-02-28")
+	require.NoError(t, err)
+
+	// The TV was bought once and is not part of the basket
+	require.Len(t, index.Items, 2)
+	// Spending: eggs 34, coffee 40 (net of the $4 discount)
+	assert.Equal(t, "2", index.Items[0].ItemNumber)
+	assert.Equal(t, 0.0, index.Items[0].Inflation, "coffee cost 20 net in both months")
+	assert.Equal(t, "1", index.Items[1].ItemNumber)
+	assert.Equal(t, 10.00, index.Items[1].FirstPrice)
+	assert.Equal(t, 12.00, index.Items[1].LastPrice)
+	assert.Equal(t, 20.0, index.Items[1].Inflation)
+
+	require.Len(t, index.Months, 2)
+	assert.Equal(t, "2025-01", index.Months[0].Month)
+	assert.Equal(t, 100.0, index.Months[0].Index)
+	// Weighted: eggs 34/74 * 1.2 + coffee 40/74 * 1.0
+	assert.Equal(t, 109.19, index.Months[1].Index)
+	assert.Equal(t, 9.19, index.Inflation)
+}
+
+func TestGetBasketIndex_TiesByItemNumber(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
+	items := func(day time.Time) []ReceiptItem {
+		var items []ReceiptItem
+		for _, number := range []string{"30", "10", "20"} {
+			items = append(items, ReceiptItem{ItemNumber: number, ItemDescription01: "ITEM " + number, Unit: 1, Amount: 5})
+		}
+		return items
+	}
+	jan := time.Date(2025, 1, 10, 12, 0, 0, 0, time.UTC)
+	feb := time.Date(2025, 2, 10, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, saveTransactionStore(&transactionStore{Segments: map[string]*storeSegment{
+		"all/all": {Start: "2025-01-01", Watermark: "2025-02-28", Transactions: []TransactionWithItems{
+			{TransactionBarcode: "JAN", TransactionDate: jan, Total: 15, Items: items(jan)},
+			{TransactionBarcode: "FEB", TransactionDate: feb, Total: 15, Items: items(feb)},
+		}},
+	}}))
+
+	client := newMockClient(t, Config{UseLocalStore: true}, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	})
+	for range 5 {
+		index, err := client.GetBasketIndex(context.Background(), "2025-01-01", "2025-02-28")
+		require.NoError(t, err)
+		require.Len(t, index.Items, 3)
+		assert.Equal(t, []string{"10", "20", "30"},
+			[]string{index.Items[0].ItemNumber, index.Items[1].ItemNumber, index.Items[2].ItemNumber})
+	}
+}
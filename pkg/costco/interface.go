@@ -25,11 +25,11 @@ type CostcoClient interface {
 	GetOnlineOrders(ctx context.Context, startDate, endDate string, pageNumber, pageSize int) (*OnlineOrdersResponse, error)
 
 	// GetReceipts retrieves warehouse receipts within the specified date range.
-	// Can filter by documentType ("all", "warehouse", "fuel") and documentSubType.
+	// Can filter by documentType (DocumentTypeAll, DocumentTypeWarehouse, DocumentTypeFuel) and documentSubType.
 	GetReceipts(ctx context.Context, startDate, endDate, documentType, documentSubType string) (*ReceiptsWithCountsResponse, error)
 
 	// GetReceiptDetail retrieves full details for a specific receipt identified by barcode.
-	// documentType should be "warehouse" or "fuel" depending on the receipt type.
+	// documentType should be "warehouse", "fuel", or "carwash"; use Receipt.DetailDocumentType.
 	GetReceiptDetail(ctx context.Context, barcode, documentType string) (*Receipt, error)
 
 	// GetAllTransactionItems fetches all receipts in a date range and retrieves full item details for each.
@@ -41,10 +41,15 @@ type CostcoClient interface {
 	GetItemHistory(ctx context.Context, itemNumber, startDate, endDate string) ([]ItemPurchase, error)
 
 	// GetSpendingSummary calculates total spending and item counts by department.
-	// Returns a map keyed by department number.
+	// Returns a map keyed by department number; with Config.IncludeServiceOrders, Photo
+	// Center and optical orders use DepartmentPhotoCenter and DepartmentOptical.
 	GetSpendingSummary(ctx context.Context, startDate, endDate string) (map[int]SpendingByDepartment, error)
 
 	// GetFrequentItems returns the most frequently purchased items, sorted by purchase frequency.
 	// The limit parameter controls how many items to return (0 = return all).
 	GetFrequentItems(ctx context.Context, startDate, endDate string, limit int) ([]FrequentItem, error)
+
+	// GetMembership retrieves the membership type, member-since and renewal dates,
+	// and household cardholders for the signed-in member.
+	GetMembership(ctx context.Context) (*Membership, error)
 }
@@ -0,0 +1,44 @@
+package costco
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+// Online order invoices
+
+// GetOrderInvoice downloads the official invoice PDF for an online order,
+// suitable for expense reports and warranty claims.
+//
+// Example:
+//
+//	pdf, err := client.GetOrderInvoice(ctx, order.OrderNumber)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	os.WriteFile("invoice-"+order.OrderNumber+".pdf", pdf, 0644)
+func (c *Client) GetOrderInvoice(ctx context.Context, orderNumber string) ([]byte, error) {
+	if orderNumber == "" {
+		return nil, fmt.Errorf("order number is required")
+	}
+
+	c.getLogger().Info("fetching order invoice", slog.String("order_number", orderNumber))
+
+	var pdf []byte
+	endpoint := OrderInvoiceEndpoint + "/" + url.PathEscape(orderNumber)
+	if err := c.executeREST(ctx, http.MethodGet, endpoint, nil, nil, true, &pdf); err != nil {
+		return nil, err
+	}
+
+	if !bytes.HasPrefix(pdf, []byte("%PDF")) {
+		return nil, fmt.Errorf("invoice for order %s is not a PDF", orderNumber)
+	}
+
+	c.getLogger().Info("fetched order invoice", slog.Int("bytes", len(pdf)))
+
+	return pdf, nil
+}
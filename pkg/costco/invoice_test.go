@@ -0,0 +1,36 @@
+package costco
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOrderInvoice(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/ebusiness/order/v1/invoices/1234567890", r.URL.Path)
+		assert.Equal(t, "*/*", r.Header.Get("Accept"))
+		assert.NotEmpty(t, r.Header.Get(HeaderAuthorization))
+		w.Header().Set("Content-Type", "application/pdf")
+		w.Write([]byte("%PDF-1.7\n..."))
+	})
+
+	pdf, err := client.GetOrderInvoice(context.Background(), "1234567890")
+	require.NoError(t, err)
+	assert.Equal(t, "%PDF-1.7\n...", string(pdf))
+}
+
+func TestGetOrderInvoice_Errors(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"error": "not found"}`))
+	})
+
+	_, err := client.GetOrderInvoice(context.Background(), "")
+	assert.Error(t, err)
+
+	_, err = client.GetOrderInvoice(context.Background(), "1234567890")
+	assert.ErrorContains(t, err, "not a PDF")
+}
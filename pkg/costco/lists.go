@@ -0,0 +1,165 @@
+package costco
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+// Saved lists (favorites) on Costco.com
+
+// SavedList represents a Costco.com saved list
+type SavedList struct {
+	ListID    string          `json:"listId"`
+	Name      string          `json:"name"`
+	Items     []SavedListItem `json:"items"`
+	UpdatedAt string          `json:"updatedAt"`
+}
+
+// SavedListItem represents a single item on a saved list
+type SavedListItem struct {
+	ItemNumber  string `json:"itemNumber"`
+	Description string `json:"description"`
+	Quantity    int    `json:"quantity"`
+}
+
+// HasItem reports whether the list contains the item number.
+func (l *SavedList) HasItem(itemNumber string) bool {
+	for _, item := range l.Items {
+		if item.ItemNumber == itemNumber {
+			return true
+		}
+	}
+	return false
+}
+
+// GetLists retrieves all saved lists for the signed-in member.
+//
+// Example:
+//
+//	lists, err := client.GetLists(ctx)
+//	for _, list := range lists {
+//	    fmt.Printf("%s (%d items)\n", list.Name, len(list.Items))
+//	}
+func (c *Client) GetLists(ctx context.Context) ([]SavedList, error) {
+	c.getLogger().Info("fetching saved lists")
+
+	var result struct {
+		Lists []SavedList `json:"lists"`
+	}
+	if err := c.executeREST(ctx, http.MethodGet, ListsEndpoint, nil, nil, true, &result); err != nil {
+		return nil, err
+	}
+
+	c.getLogger().Info("fetched saved lists", slog.Int("list_count", len(result.Lists)))
+
+	return result.Lists, nil
+}
+
+// CreateList creates a new, empty saved list.
+//
+// Example:
+//
+//	list, err := client.CreateList(ctx, "Monthly Staples")
+func (c *Client) CreateList(ctx context.Context, name string) (*SavedList, error) {
+	if name == "" {
+		return nil, fmt.Errorf("list name is required")
+	}
+
+	c.getLogger().Info("creating saved list", slog.String("name", name))
+
+	var list SavedList
+	body := map[string]interface{}{"name": name}
+	if err := c.executeREST(ctx, http.MethodPost, ListsEndpoint, nil, body, true, &list); err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
+
+// RenameList changes a saved list's name and returns the updated list.
+func (c *Client) RenameList(ctx context.Context, listID, name string) (*SavedList, error) {
+	if listID == "" || name == "" {
+		return nil, fmt.Errorf("list ID and name are required")
+	}
+
+	c.getLogger().Info("renaming saved list", slog.String("list_id", listID), slog.String("name", name))
+
+	var list SavedList
+	body := map[string]interface{}{"name": name}
+	if err := c.executeREST(ctx, http.MethodPatch, listEndpoint(listID), nil, body, true, &list); err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
+
+// DeleteList deletes a saved list.
+func (c *Client) DeleteList(ctx context.Context, listID string) error {
+	if listID == "" {
+		return fmt.Errorf("list ID is required")
+	}
+
+	c.getLogger().Info("deleting saved list", slog.String("list_id", listID))
+
+	return c.executeREST(ctx, http.MethodDelete, listEndpoint(listID), nil, nil, true, nil)
+}
+
+// AddToList adds items to a saved list and returns the updated list.
+// Items already on the list are left unchanged by the service.
+//
+// Example:
+//
+//	// Push the most frequently bought items into a list to shop from on the website
+//	frequent, _ := client.GetFrequentItems(ctx, start, end, 20)
+//	items := make([]costco.SavedListItem, len(frequent))
+//	for i, f := range frequent {
+//	    items[i] = costco.SavedListItem{ItemNumber: f.ItemNumber, Quantity: 1}
+//	}
+//	list, err := client.AddToList(ctx, list.ListID, items...)
+func (c *Client) AddToList(ctx context.Context, listID string, items ...SavedListItem) (*SavedList, error) {
+	if listID == "" {
+		return nil, fmt.Errorf("list ID is required")
+	}
+	if len(items) == 0 {
+		return nil, fmt.Errorf("at least one item is required")
+	}
+
+	c.getLogger().Info("adding items to saved list",
+		slog.String("list_id", listID),
+		slog.Int("item_count", len(items)))
+
+	var list SavedList
+	body := map[string]interface{}{"items": items}
+	if err := c.executeREST(ctx, http.MethodPost, listEndpoint(listID)+"/items", nil, body, true, &list); err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
+
+// RemoveFromList removes an item from a saved list and returns the updated list.
+func (c *Client) RemoveFromList(ctx context.Context, listID, itemNumber string) (*SavedList, error) {
+	if listID == "" || itemNumber == "" {
+		return nil, fmt.Errorf("list ID and item number are required")
+	}
+
+	c.getLogger().Info("removing item from saved list",
+		slog.String("list_id", listID),
+		slog.String("item_number", itemNumber))
+
+	var list SavedList
+	endpoint := listEndpoint(listID) + "/items/" + url.PathEscape(itemNumber)
+	if err := c.executeREST(ctx, http.MethodDelete, endpoint, nil, nil, true, &list); err != nil {
+		return nil, err
+	}
+
+	return &list, nil
+}
+
+// listEndpoint returns the URL of a single saved list.
+func listEndpoint(listID string) string {
+	return ListsEndpoint + "/" + url.PathEscape(listID)
+}
@@ -0,0 +1,121 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// listsServer is an in-memory saved lists service for tests
+func listsServer(t *testing.T) http.HandlerFunc {
+	lists := map[string]*SavedList{}
+	return func(w http.ResponseWriter, r *http.Request) {
+		path := strings.TrimPrefix(r.URL.Path, "/ebusiness/lists/v1/lists")
+		parts := strings.Split(strings.Trim(path, "/"), "/")
+
+		var body struct {
+			Name  string          `json:"name"`
+			Items []SavedListItem `json:"items"`
+		}
+		if r.Body != nil {
+			json.NewDecoder(r.Body).Decode(&body)
+		}
+
+		switch {
+		case path == "" && r.Method == http.MethodGet:
+			result := struct {
+				Lists []SavedList `json:"lists"`
+			}{}
+			for _, l := range lists {
+				result.Lists = append(result.Lists, *l)
+			}
+			json.NewEncoder(w).Encode(result)
+			return
+		case path == "" && r.Method == http.MethodPost:
+			list := &SavedList{ListID: "list-1", Name: body.Name}
+			lists[list.ListID] = list
+			json.NewEncoder(w).Encode(list)
+			return
+		}
+
+		list, ok := lists[parts[0]]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		switch {
+		case len(parts) == 1 && r.Method == http.MethodPatch:
+			list.Name = body.Name
+		case len(parts) == 1 && r.Method == http.MethodDelete:
+			delete(lists, parts[0])
+			w.WriteHeader(http.StatusNoContent)
+			return
+		case len(parts) == 2 && r.Method == http.MethodPost:
+			list.Items = append(list.Items, body.Items...)
+		case len(parts) == 3 && r.Method == http.MethodDelete:
+			for i, item := range list.Items {
+				if item.ItemNumber == parts[2] {
+					list.Items = append(list.Items[:i], list.Items[i+1:]...)
+					break
+				}
+			}
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		json.NewEncoder(w).Encode(list)
+	}
+}
+
+func TestSavedLists(t *testing.T) {
+	client := newMockClient(t, Config{}, listsServer(t))
+	ctx := context.Background()
+
+	list, err := client.CreateList(ctx, "Staples")
+	require.NoError(t, err)
+	assert.Equal(t, "Staples", list.Name)
+
+	list, err = client.AddToList(ctx, list.ListID,
+		SavedListItem{ItemNumber: "1529345", Quantity: 1},
+		SavedListItem{ItemNumber: "30669", Quantity: 2})
+	require.NoError(t, err)
+	assert.True(t, list.HasItem("30669"))
+
+	list, err = client.RemoveFromList(ctx, list.ListID, "30669")
+	require.NoError(t, err)
+	assert.False(t, list.HasItem("30669"))
+	assert.True(t, list.HasItem("1529345"))
+
+	list, err = client.RenameList(ctx, list.ListID, "Monthly Staples")
+	require.NoError(t, err)
+	assert.Equal(t, "Monthly Staples", list.Name)
+
+	lists, err := client.GetLists(ctx)
+	require.NoError(t, err)
+	require.Len(t, lists, 1)
+	assert.Equal(t, "Monthly Staples", lists[0].Name)
+
+	require.NoError(t, client.DeleteList(ctx, list.ListID))
+	lists, err = client.GetLists(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, lists)
+}
+
+func TestSavedLists_InvalidInput(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("no request expected")
+	})
+	ctx := context.Background()
+
+	_, err := client.CreateList(ctx, "")
+	assert.Error(t, err)
+	_, err = client.AddToList(ctx, "list-1")
+	assert.ErrorContains(t, err, "at least one item")
+	_, err = client.RemoveFromList(ctx, "", "1529345")
+	assert.Error(t, err)
+	assert.Error(t, client.DeleteList(ctx, ""))
+}
@@ -0,0 +1,95 @@
+package costco
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Locale and currency preferences
+
+// Locale identifies the language/region used to present receipt data.
+type Locale string
+
+// Supported locales
+const (
+	LocaleEnUS Locale = "en-US"
+	LocaleEnCA Locale = "en-CA"
+	LocaleFrCA Locale = "fr-CA"
+)
+
+// Supported currencies
+const (
+	CurrencyUSD = "USD"
+	CurrencyCAD = "CAD"
+)
+
+// IsFrench reports whether descriptions should prefer the French variants.
+func (l Locale) IsFrench() bool {
+	return l == LocaleFrCA
+}
+
+// DefaultCurrency returns the currency amounts are billed in for the locale,
+// or "" for an unknown locale.
+func (l Locale) DefaultCurrency() string {
+	switch l {
+	case LocaleEnUS:
+		return CurrencyUSD
+	case LocaleEnCA, LocaleFrCA:
+		return CurrencyCAD
+	default:
+		return ""
+	}
+}
+
+// Region returns the country the locale shops in, or "" for an unknown locale.
+func (l Locale) Region() Region {
+	switch l.DefaultCurrency() {
+	case CurrencyUSD:
+		return RegionUS
+	case CurrencyCAD:
+		return RegionCA
+	default:
+		return ""
+	}
+}
+
+// Description returns the item's primary description in the given locale.
+// For French locales the French description is used when the receipt provides one.
+//
+// Example:
+//
+//	fmt.Println(item.Description(costco.LocaleFrCA)) // "TORTILLA AMANDE"
+func (item *ReceiptItem) Description(locale Locale) string {
+	if locale.IsFrench() && item.FrenchItemDescription1 != "" {
+		return item.FrenchItemDescription1
+	}
+	return item.ItemDescription01
+}
+
+// currency returns the currency to tag amounts with, or "" when neither a
+// locale nor a currency is configured.
+func (c *Client) currency() string {
+	if c.config.Currency != "" {
+		return c.config.Currency
+	}
+	return c.config.Locale.DefaultCurrency()
+}
+
+// countryCode returns the ISO country code for warehouse lookups based on the configured locale.
+func (c *Client) countryCode() string {
+	if c.config.Locale.DefaultCurrency() == CurrencyCAD {
+		return "CA"
+	}
+	return "US"
+}
+
+// acceptLanguage returns the Accept-Language header for API requests in the
+// configured locale, falling back to US English.
+func (c *Client) acceptLanguage() string {
+	locale := c.config.Locale
+	if locale == "" {
+		locale = LocaleEnUS
+	}
+	language, _, _ := strings.Cut(string(locale), "-")
+	return fmt.Sprintf("%s,%s;q=0.9", locale, language)
+}
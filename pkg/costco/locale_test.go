@@ -0,0 +1,103 @@
+package costco
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocaleDefaultCurrency(t *testing.T) {
+	assert.Equal(t, CurrencyUSD, LocaleEnUS.DefaultCurrency())
+	assert.Equal(t, CurrencyCAD, LocaleEnCA.DefaultCurrency())
+	assert.Equal(t, CurrencyCAD, LocaleFrCA.DefaultCurrency())
+	assert.Equal(t, "", Locale("").DefaultCurrency())
+}
+
+func TestReceiptItem_Description(t *testing.T) {
+	item := ReceiptItem{
+		ItemDescription01:      "ALM TORTILLA",
+		FrenchItemDescription1: "TORTILLA AMANDE",
+	}
+	assert.Equal(t, "ALM TORTILLA", item.Description(LocaleEnUS))
+	assert.Equal(t, "ALM TORTILLA", item.Description(LocaleEnCA))
+	assert.Equal(t, "TORTILLA AMANDE", item.Description(LocaleFrCA))
+
+	// Falls back to English when no French description is available
+	english := ReceiptItem{ItemDescription01: "GUAC BOWL"}
+	assert.Equal(t, "GUAC BOWL", english.Description(LocaleFrCA))
+}
+
+func TestConfigValidate_Region(t *testing.T) {
+	assert.NoError(t, Config{Locale: LocaleFrCA}.Validate())
+	assert.NoError(t, Config{Locale: LocaleEnCA, Currency: CurrencyCAD}.Validate())
+	assert.NoError(t, Config{Currency: CurrencyCAD}.Validate())
+
+	err := Config{Locale: LocaleEnUS, Currency: CurrencyCAD}.Validate()
+	assert.ErrorContains(t, err, "currency: CAD does not match locale en-US")
+
+	err = Config{Locale: "de-DE"}.Validate()
+	assert.ErrorContains(t, err, "locale:")
+
+	err = (&StoredConfig{Locale: LocaleFrCA, Currency: "EUR"}).Validate()
+	assert.ErrorContains(t, err, "currency:")
+}
+
+func TestGetReceiptDetail_TagsCurrency(t *testing.T) {
+	client := newMockClient(t, Config{Locale: LocaleFrCA}, func(w http.ResponseWriter, r *http.Request) {
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{
+				"receipts": []map[string]interface{}{
+					{"transactionBarcode": "123", "total": 10.00},
+				},
+			},
+		})
+	})
+
+	receipt, err := client.GetReceiptDetail(context.Background(), "123", "warehouse")
+	require.NoError(t, err)
+	assert.Equal(t, CurrencyCAD, receipt.Currency)
+}
+
+func TestGetReceiptDetail_NoLocaleLeavesCurrencyEmpty(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{
+				"receipts": []map[string]interface{}{
+					{"transactionBarcode": "123", "total": 10.00},
+				},
+			},
+		})
+	})
+
+	receipt, err := client.GetReceiptDetail(context.Background(), "123", "warehouse")
+	require.NoError(t, err)
+	assert.Empty(t, receipt.Currency)
+}
+
+func TestAcceptLanguage_FollowsLocale(t *testing.T) {
+	var languages []string
+	client := newMockClient(t, Config{Locale: LocaleFrCA}, func(w http.ResponseWriter, r *http.Request) {
+		languages = append(languages, r.Header.Get("Accept-Language"))
+		if r.URL.Path == "/ebusiness/photo/v1/orders" {
+			w.Write([]byte(`{"orders": []}`))
+			return
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{
+				"receipts": []map[string]interface{}{{"transactionBarcode": "123"}},
+			},
+		})
+	})
+
+	_, err := client.GetReceiptDetail(context.Background(), "123", "warehouse")
+	require.NoError(t, err)
+	_, err = client.GetPhotoOrders(context.Background(), "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"fr-CA,fr;q=0.9", "fr-CA,fr;q=0.9"}, languages, "GraphQL and REST requests")
+
+	client.config.Locale = ""
+	assert.Equal(t, "en-US,en;q=0.9", client.acceptLanguage())
+}
@@ -0,0 +1,150 @@
+package costco
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"strings"
+	"time"
+)
+
+// Membership-related types
+
+// Membership types reported by the API
+const (
+	MembershipTypeGoldStar  = "Gold Star"
+	MembershipTypeBusiness  = "Business"
+	MembershipTypeExecutive = "Executive"
+)
+
+// Membership represents the signed-in member's Costco membership
+type Membership struct {
+	MembershipNumber string       `json:"membershipNumber"`
+	MembershipType   string       `json:"membershipType"`  // "Gold Star", "Business", or "Executive"
+	MemberSinceDate  string       `json:"memberSinceDate"` // YYYY-MM-DD
+	RenewalDate      string       `json:"renewalDate"`     // YYYY-MM-DD
+	Status           string       `json:"status"`          // e.g. "Active"
+	AutoRenew        bool         `json:"autoRenew"`
+	Cardholders      []Cardholder `json:"cardholders"`
+}
+
+// Cardholder represents a primary or household card on a membership
+type Cardholder struct {
+	FirstName        string `json:"firstName"`
+	LastName         string `json:"lastName"`
+	MembershipNumber string `json:"membershipNumber"`
+	CardholderType   string `json:"cardholderType"` // e.g. "Primary", "Household", "Affiliate"
+	IsPrimary        bool   `json:"isPrimary"`
+}
+
+// IsExecutive reports whether the membership earns the Executive 2% reward.
+func (m *Membership) IsExecutive() bool {
+	return m.MembershipType == MembershipTypeExecutive
+}
+
+// DaysUntilRenewal returns the number of days from now until the renewal date.
+// Returns an error if the renewal date is missing or malformed.
+func (m *Membership) DaysUntilRenewal(now time.Time) (int, error) {
+	renewal, err := time.Parse("2006-01-02", m.RenewalDate)
+	if err != nil {
+		return 0, fmt.Errorf("parsing renewal date %q: %w", m.RenewalDate, err)
+	}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return int(renewal.Sub(today).Hours() / 24), nil
+}
+
+// GetMembership retrieves the membership type, member-since and renewal dates,
+// and household cardholders for the signed-in member.
+//
+// Example:
+//
+//	membership, err := client.GetMembership(ctx)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("%s member since %s, renews %s\n",
+//	    membership.MembershipType, membership.MemberSinceDate, membership.RenewalDate)
+func (c *Client) GetMembership(ctx context.Context) (*Membership, error) {
+	c.getLogger().Info("fetching membership info")
+
+	c.getLogger().Debug("executing graphql query", slog.String("operation", "getMembershipInfo"))
+
+	var result struct {
+		MembershipInfo *Membership `json:"membershipInfo"`
+	}
+
+	if err := c.executeGraphQL(ctx, MembershipInfoQuery, map[string]interface{}{}, &result); err != nil {
+		return nil, err
+	}
+
+	if result.MembershipInfo == nil {
+		return nil, fmt.Errorf("no membership info returned")
+	}
+
+	c.getLogger().Info("fetched membership info",
+		slog.String("membership_type", result.MembershipInfo.MembershipType),
+		slog.Int("cardholder_count", len(result.MembershipInfo.Cardholders)))
+
+	return result.MembershipInfo, nil
+}
+
+// FullName returns the cardholder's first and last name.
+func (h *Cardholder) FullName() string {
+	return strings.TrimSpace(h.FirstName + " " + h.LastName)
+}
+
+// GetHouseholdMembers retrieves the primary and household cardholders on the membership.
+// Pair it with AssignCardholders to split spending per person.
+//
+// Example:
+//
+//	members, err := client.GetHouseholdMembers(ctx)
+//	for _, m := range members {
+//	    fmt.Printf("%s (%s) card %s\n", m.FullName(), m.CardholderType, m.MembershipNumber)
+//	}
+func (c *Client) GetHouseholdMembers(ctx context.Context) ([]Cardholder, error) {
+	membership, err := c.GetMembership(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting membership: %w", err)
+	}
+	return membership.Cardholders, nil
+}
+
+// AssignCardholders annotates each transaction with the cardholder whose card was scanned,
+// matched on the receipt's membership number. Transactions whose membership number doesn't
+// match a cardholder (or is missing) are left unassigned.
+func AssignCardholders(transactions []TransactionWithItems, members []Cardholder) {
+	byNumber := make(map[string]*Cardholder, len(members))
+	for i := range members {
+		if members[i].MembershipNumber != "" {
+			byNumber[members[i].MembershipNumber] = &members[i]
+		}
+	}
+	for i := range transactions {
+		transactions[i].Cardholder = byNumber[transactions[i].MembershipNumber]
+	}
+}
+
+// SpendingByCardholder totals transaction spending per cardholder name.
+// Unassigned transactions are totaled under "Unknown".
+//
+// Example:
+//
+//	transactions, _ := client.GetAllTransactionItems(ctx, start, end)
+//	members, _ := client.GetHouseholdMembers(ctx)
+//	costco.AssignCardholders(transactions, members)
+//	for name, total := range costco.SpendingByCardholder(transactions) {
+//	    fmt.Printf("%s: $%.2f\n", name, total)
+//	}
+func SpendingByCardholder(transactions []TransactionWithItems) map[string]float64 {
+	totals := make(map[string]float64)
+	for _, tx := range transactions {
+		name := "Unknown"
+		if tx.Cardholder != nil {
+			name = tx.Cardholder.FullName()
+		}
+		totals[name] = math.Round((totals[name]+tx.Total)*100) / 100
+	}
+	return totals
+}
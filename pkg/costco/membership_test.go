@@ -0,0 +1,110 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var _ CostcoClient = (*Client)(nil)
+
+func TestGetMembership(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Contains(t, req.Query, "membershipInfo")
+
+		writeGraphQLData(w, map[string]interface{}{
+			"membershipInfo": map[string]interface{}{
+				"membershipNumber": "111222333",
+				"membershipType":   "Executive",
+				"memberSinceDate":  "2015-03-01",
+				"renewalDate":      "2027-03-31",
+				"status":           "Active",
+				"autoRenew":        true,
+				"cardholders": []map[string]interface{}{
+					{"firstName": "Pat", "lastName": "Smith", "membershipNumber": "111222333", "cardholderType": "Primary", "isPrimary": true},
+					{"firstName": "Sam", "lastName": "Smith", "membershipNumber": "111222334", "cardholderType": "Household"},
+				},
+			},
+		})
+	})
+
+	membership, err := client.GetMembership(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, "111222333", membership.MembershipNumber)
+	assert.True(t, membership.IsExecutive())
+	assert.Equal(t, "2015-03-01", membership.MemberSinceDate)
+	assert.True(t, membership.AutoRenew)
+	require.Len(t, membership.Cardholders, 2)
+	assert.True(t, membership.Cardholders[0].IsPrimary)
+	assert.Equal(t, "Household", membership.Cardholders[1].CardholderType)
+
+	days, err := membership.DaysUntilRenewal(time.Date(2027, 3, 1, 15, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Equal(t, 30, days)
+}
+
+func TestGetMembership_Missing(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		writeGraphQLData(w, map[string]interface{}{"membershipInfo": nil})
+	})
+
+	_, err := client.GetMembership(context.Background())
+	assert.ErrorContains(t, err, "no membership info")
+}
+
+func TestMembership_DaysUntilRenewalInvalid(t *testing.T) {
+	m := &Membership{}
+	_, err := m.DaysUntilRenewal(time.Now())
+	assert.Error(t, err)
+}
+
+func TestGetHouseholdMembers(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		writeGraphQLData(w, map[string]interface{}{
+			"membershipInfo": map[string]interface{}{
+				"cardholders": []map[string]interface{}{
+					{"firstName": "Pat", "lastName": "Smith", "membershipNumber": "111222333", "cardholderType": "Primary", "isPrimary": true},
+					{"firstName": "Sam", "lastName": "Smith", "membershipNumber": "111222334", "cardholderType": "Household"},
+				},
+			},
+		})
+	})
+
+	members, err := client.GetHouseholdMembers(context.Background())
+	require.NoError(t, err)
+	require.Len(t, members, 2)
+	assert.Equal(t, "Sam Smith", members[1].FullName())
+}
+
+func TestAssignCardholders(t *testing.T) {
+	members := []Cardholder{
+		{FirstName: "Pat", LastName: "Smith", MembershipNumber: "111222333", IsPrimary: true},
+		{FirstName: "Sam", LastName: "Smith", MembershipNumber: "111222334"},
+	}
+	transactions := []TransactionWithItems{
+		{MembershipNumber: "111222333", Total: 100.10},
+		{MembershipNumber: "111222334", Total: 40.00},
+		{MembershipNumber: "111222333", Total: 20.05},
+		{MembershipNumber: "", Total: 5.00},
+	}
+
+	AssignCardholders(transactions, members)
+
+	require.NotNil(t, transactions[0].Cardholder)
+	assert.True(t, transactions[0].Cardholder.IsPrimary)
+	assert.Equal(t, "Sam", transactions[1].Cardholder.FirstName)
+	assert.Nil(t, transactions[3].Cardholder)
+
+	totals := SpendingByCardholder(transactions)
+	assert.Equal(t, 120.15, totals["Pat Smith"])
+	assert.Equal(t, 40.00, totals["Sam Smith"])
+	assert.Equal(t, 5.00, totals["Unknown"])
+}
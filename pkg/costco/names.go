@@ -0,0 +1,122 @@
+package costco
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Receipt description normalization
+
+const abbreviationsFile = "abbreviations.json"
+
+//go:embed abbreviations.json
+var embeddedAbbreviations []byte
+
+var (
+	abbreviationsOnce     sync.Once
+	abbreviations         map[string]string
+	abbreviationMu        sync.RWMutex
+	abbreviationOverrides map[string]string
+
+	defaultAbbreviationsOnce sync.Once
+)
+
+// FriendlyName returns the item's receipt description with common Costco abbreviations
+// expanded and words title-cased, e.g. "KS ORG PNT BTR" → "Kirkland Signature Organic
+// Peanut Butter". Words with digits (sizes, counts) and unknown words of one or two
+// letters are kept as printed. Mappings loaded by LoadAbbreviationOverrides take
+// precedence over the built-in dictionary.
+func (item *ReceiptItem) FriendlyName() string {
+	return friendlyName(item.ItemDescription01)
+}
+
+// itemName returns the description the analytics helpers report for an item: the
+// French description for French locales when the receipt has one, otherwise FriendlyName.
+func (c *Client) itemName(item ReceiptItem) string {
+	if c.config.Locale.IsFrench() && item.FrenchItemDescription1 != "" {
+		return item.FrenchItemDescription1
+	}
+	return item.FriendlyName()
+}
+
+func friendlyName(description string) string {
+	abbreviationsOnce.Do(func() {
+		if err := json.Unmarshal(embeddedAbbreviations, &abbreviations); err != nil {
+			panic(fmt.Sprintf("parsing embedded %s: %v", abbreviationsFile, err))
+		}
+	})
+	abbreviationMu.RLock()
+	defer abbreviationMu.RUnlock()
+
+	words := strings.Fields(description)
+	for i, word := range words {
+		key := strings.ToUpper(word)
+		if expanded, ok := abbreviationOverrides[key]; ok {
+			words[i] = expanded
+		} else if expanded, ok := abbreviations[key]; ok {
+			words[i] = expanded
+		} else {
+			words[i] = titleWord(word)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// titleWord title-cases a plain word, leaving short words and anything with digits alone.
+func titleWord(word string) string {
+	if len(word) <= 2 || strings.IndexFunc(word, unicode.IsDigit) >= 0 {
+		return word
+	}
+	runes := []rune(strings.ToLower(word))
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
+
+// LoadAbbreviationOverrides reads extra receipt abbreviations for FriendlyName from a
+// JSON file mapping abbreviations to words, e.g. {"TRTLA": "Tortilla"}. An empty path
+// means ~/.costco/abbreviations.json, which the first NewClient loads automatically.
+// A missing file is not an error and keeps the current overrides.
+func LoadAbbreviationOverrides(path string) error {
+	if path == "" {
+		configPath, err := getConfigPath()
+		if err != nil {
+			return err
+		}
+		path = filepath.Join(configPath, abbreviationsFile)
+	}
+
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var raw map[string]string
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("parsing %s: %w", path, err)
+	}
+	overrides := make(map[string]string, len(raw))
+	for abbreviation, word := range raw {
+		overrides[strings.ToUpper(abbreviation)] = word
+	}
+
+	abbreviationMu.Lock()
+	abbreviationOverrides = overrides
+	abbreviationMu.Unlock()
+
+	return nil
+}
+
+// loadDefaultAbbreviationOverrides loads ~/.costco/abbreviations.json once per process,
+// so creating another client never replaces overrides a caller loaded from elsewhere.
+func loadDefaultAbbreviationOverrides() (err error) {
+	defaultAbbreviationsOnce.Do(func() { err = LoadAbbreviationOverrides("") })
+	return err
+}
@@ -0,0 +1,66 @@
+package costco
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFriendlyName(t *testing.T) {
+	tests := []struct {
+		description string
+		want        string
+	}{
+		{"KS ORG PNT BTR", "Kirkland Signature Organic Peanut Butter"},
+		{"ALM TORTILLA", "Almond Tortilla"},
+		{"KS BNLS CHKN BRST 6.5LB", "Kirkland Signature Boneless Chicken Breast 6.5LB"},
+		{"LG TV 65IN", "Large TV 65IN"},
+		{"  PAPER   TOWELS ", "Paper Towels"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		item := ReceiptItem{ItemDescription01: tt.description}
+		assert.Equal(t, tt.want, item.FriendlyName(), tt.description)
+	}
+}
+
+func TestLoadAbbreviationOverrides(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("COSTCO_TEST_CONFIG_PATH", dir)
+	t.Cleanup(func() {
+		abbreviationMu.Lock()
+		abbreviationOverrides = nil
+		abbreviationMu.Unlock()
+	})
+
+	item := ReceiptItem{ItemDescription01: "KS TRTLA CHPS"}
+	assert.Equal(t, "Kirkland Signature Trtla Chps", item.FriendlyName())
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, abbreviationsFile), []byte(`{"trtla": "Tortilla", "CHPS": "Chips", "KS": "Kirkland"}`), 0600))
+	require.NoError(t, LoadAbbreviationOverrides(""))
+	assert.Equal(t, "Kirkland Tortilla Chips", item.FriendlyName(), "overrides extend and replace the dictionary")
+
+	bad := filepath.Join(dir, "bad.json")
+	require.NoError(t, os.WriteFile(bad, []byte(`["KS"]`), 0600))
+	assert.ErrorContains(t, LoadAbbreviationOverrides(bad), "parsing")
+
+	require.NoError(t, LoadAbbreviationOverrides(filepath.Join(dir, "missing.json")))
+	assert.Equal(t, "Kirkland Tortilla Chips", item.FriendlyName(), "a missing file keeps overrides")
+
+	NewClient(Config{Email: "test@example.com"})
+	custom := filepath.Join(dir, "custom.json")
+	require.NoError(t, os.WriteFile(custom, []byte(`{"TRTLA": "Tortilla"}`), 0600))
+	require.NoError(t, LoadAbbreviationOverrides(custom))
+	NewClient(Config{Email: "test@example.com"})
+	assert.Equal(t, "Kirkland Signature Tortilla Chps", item.FriendlyName(), "new clients keep overrides loaded from elsewhere")
+}
+
+func TestEmbeddedAbbreviations(t *testing.T) {
+	var dictionary map[string]string
+	require.NoError(t, json.Unmarshal(embeddedAbbreviations, &dictionary))
+	assert.NotEmpty(t, dictionary)
+}
@@ -0,0 +1,136 @@
+package costco
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Local notes on receipts and their items
+
+const notesFile = "notes.json"
+
+// ReceiptNotes is what was written about one receipt: a note on the whole receipt
+// and notes on its items, by item number.
+type ReceiptNotes struct {
+	Note  string            `json:"note,omitempty"`
+	Items map[string]string `json:"items,omitempty"`
+}
+
+// TransactionNotes maps receipt barcodes to their notes. It is kept in ~/.costco/notes.json,
+// apart from the transaction store, so clearing or pruning the store keeps the notes.
+type TransactionNotes map[string]*ReceiptNotes
+
+// LoadNotes reads the notes set with SetNote. A missing file means no notes.
+func LoadNotes() (TransactionNotes, error) {
+	notes := make(TransactionNotes)
+
+	configPath, err := getConfigPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(filepath.Join(configPath, notesFile))
+	if os.IsNotExist(err) {
+		return notes, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &notes); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", notesFile, err)
+	}
+	return notes, nil
+}
+
+// saveNotes writes ~/.costco/notes.json.
+func saveNotes(notes TransactionNotes) error {
+	if err := ensureConfigDir(); err != nil {
+		return err
+	}
+
+	configPath, err := getConfigPath()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(notes, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(configPath, notesFile), data, 0600)
+}
+
+// SetNote sets the note on the receipt with a barcode, or on one of its items when
+// itemNumber is given. An empty note removes it. Analytics calls fill in
+// TransactionWithItems.Note and ItemNotes, and exports include them.
+//
+// Example:
+//
+//	err := costco.SetNote("21134300501862509061323", "", "split with Dave")
+//	err = costco.SetNote("21134300501862509061323", "1553261", "for the office")
+func SetNote(barcode, itemNumber, note string) error {
+	barcode = strings.TrimSpace(barcode)
+	if barcode == "" {
+		return fmt.Errorf("barcode is required")
+	}
+	itemNumber = strings.TrimSpace(itemNumber)
+	note = strings.TrimSpace(note)
+
+	notes, err := LoadNotes()
+	if err != nil {
+		return err
+	}
+	receipt := notes[barcode]
+	if receipt == nil {
+		receipt = &ReceiptNotes{}
+	}
+
+	switch {
+	case itemNumber == "":
+		receipt.Note = note
+	case note == "":
+		delete(receipt.Items, itemNumber)
+	default:
+		if receipt.Items == nil {
+			receipt.Items = make(map[string]string)
+		}
+		receipt.Items[itemNumber] = note
+	}
+
+	if receipt.Note == "" && len(receipt.Items) == 0 {
+		delete(notes, barcode)
+	} else {
+		notes[barcode] = receipt
+	}
+	return saveNotes(notes)
+}
+
+// applyNotes fills in each transaction's Note and ItemNotes. Unreadable notes are
+// logged and skipped rather than failing the call.
+func (c *Client) applyNotes(transactions []TransactionWithItems) []TransactionWithItems {
+	fill := c.noteFiller()
+	for i := range transactions {
+		fill(&transactions[i])
+	}
+	return transactions
+}
+
+// noteFiller loads the notes once and returns a function that fills in a transaction's
+// Note and ItemNotes. It is safe for concurrent use.
+func (c *Client) noteFiller() func(tx *TransactionWithItems) {
+	notes, err := LoadNotes()
+	if err != nil {
+		c.getLogger().Warn("ignoring unreadable notes", slog.String("error", err.Error()))
+	}
+	return func(tx *TransactionWithItems) {
+		if receipt := notes[tx.TransactionBarcode]; receipt != nil {
+			tx.Note = receipt.Note
+			tx.ItemNotes = receipt.Items
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package costco
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetNote(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
+	require.NoError(t, SetNote("R1", "", " split with Dave "))
+	require.NoError(t, SetNote("R1", "100", "for the office"))
+	notes, err := LoadNotes()
+	require.NoError(t, err)
+	assert.Equal(t, TransactionNotes{"R1": {Note: "split with Dave", Items: map[string]string{"100": "for the office"}}}, notes)
+
+	require.NoError(t, SetNote("R1", "", ""))
+	require.NoError(t, SetNote("R1", "100", ""))
+	notes, err = LoadNotes()
+	require.NoError(t, err)
+	assert.Empty(t, notes)
+
+	assert.ErrorContains(t, SetNote("", "", "x"), "barcode is required")
+}
+
+func TestGetAllTransactionItems_Notes(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
+	tx := exportTestTransactions()[0]
+	tx.TransactionDate = time.Date(2025, 1, 5, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, saveTransactionStore(&transactionStore{Segments: map[string]*storeSegment{
+		"all/all": {Start: "2025-01-01", Watermark: "2025-01-31", Transactions: []TransactionWithItems{tx}},
+	}}))
+	require.NoError(t, SetNote("R1", "", "split with Dave"))
+	require.NoError(t, SetNote("R1", "100", "for the office"))
+
+	client := newMockClient(t, Config{UseLocalStore: true}, func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	})
+	transactions, err := client.GetAllTransactionItems(context.Background(), "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+	require.Len(t, transactions, 1)
+	assert.Equal(t, "split with Dave", transactions[0].Note)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteExport(&buf, transactions, ExportOptions{
+		Level:   ExportLevelItem,
+		Columns: []string{"item_number", "note", "receipt_note"},
+	}))
+	assert.Equal(t, "item_number,note,receipt_note\n"+
+		"100,for the office,split with Dave\n"+
+		"333,,split with Dave\n", buf.String())
+}
@@ -0,0 +1,127 @@
+package costco
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Member-only savings (coupon book) offers
+
+// Offer channels
+const (
+	OfferChannelWarehouse = "WAREHOUSE"
+	OfferChannelOnline    = "ONLINE"
+	OfferChannelBoth      = "BOTH"
+)
+
+// Offer represents an active instant-savings offer from the member coupon book
+type Offer struct {
+	OfferID        string   `json:"offerId"`
+	Description    string   `json:"description"`
+	ItemNumbers    []string `json:"itemNumbers"` // Items the discount applies to
+	DiscountAmount float64  `json:"discountAmount"`
+	LimitPerMember int      `json:"limitPerMember"` // 0 means no limit
+	Channel        string   `json:"channel"`        // One of the OfferChannel constants
+	ValidFrom      string   `json:"validFrom"`      // YYYY-MM-DD, inclusive
+	ValidTo        string   `json:"validTo"`        // YYYY-MM-DD, inclusive
+}
+
+// AppliesTo reports whether the offer covers the item number.
+func (o *Offer) AppliesTo(itemNumber string) bool {
+	for _, n := range o.ItemNumbers {
+		if n == itemNumber {
+			return true
+		}
+	}
+	return false
+}
+
+// ActiveOn reports whether the offer's validity window includes the given day.
+func (o *Offer) ActiveOn(date time.Time) bool {
+	day := date.Format("2006-01-02")
+	return (o.ValidFrom == "" || day >= o.ValidFrom) && (o.ValidTo == "" || day <= o.ValidTo)
+}
+
+// OffersForItems returns the offers that cover any of the given item numbers,
+// e.g. the item numbers from GetFrequentItems for "items you buy are on sale" alerts.
+//
+// Example:
+//
+//	frequent, _ := client.GetFrequentItems(ctx, start, end, 50)
+//	numbers := make([]string, len(frequent))
+//	for i, f := range frequent {
+//	    numbers[i] = f.ItemNumber
+//	}
+//	for _, offer := range costco.OffersForItems(offers, numbers) {
+//	    fmt.Printf("On sale: %s ($%.2f off until %s)\n", offer.Description, offer.DiscountAmount, offer.ValidTo)
+//	}
+func OffersForItems(offers []Offer, itemNumbers []string) []Offer {
+	wanted := make(map[string]bool, len(itemNumbers))
+	for _, n := range itemNumbers {
+		wanted[n] = true
+	}
+
+	var matches []Offer
+	for _, offer := range offers {
+		for _, n := range offer.ItemNumbers {
+			if wanted[n] {
+				matches = append(matches, offer)
+				break
+			}
+		}
+	}
+	return matches
+}
+
+// GetCurrentOffers retrieves the active member-only instant-savings offers for a warehouse.
+// If warehouseNumber is empty, the client's configured warehouse is used.
+// Offers whose validity window doesn't include today are dropped.
+//
+// Example:
+//
+//	offers, err := client.GetCurrentOffers(ctx, "")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, offer := range offers {
+//	    fmt.Printf("%s: $%.2f off (%s - %s)\n", offer.Description, offer.DiscountAmount, offer.ValidFrom, offer.ValidTo)
+//	}
+func (c *Client) GetCurrentOffers(ctx context.Context, warehouseNumber string) ([]Offer, error) {
+	if warehouseNumber == "" {
+		warehouseNumber = c.config.WarehouseNumber
+	}
+	if warehouseNumber == "" {
+		return nil, fmt.Errorf("warehouse number is required")
+	}
+
+	c.getLogger().Info("fetching current offers", slog.String("warehouse_number", warehouseNumber))
+
+	params := url.Values{}
+	params.Set("langId", "-1")
+	params.Set("warehouseNumber", warehouseNumber)
+
+	var result struct {
+		Offers []Offer `json:"offers"`
+	}
+	if err := c.executeREST(ctx, http.MethodGet, OffersEndpoint, params, nil, false, &result); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	offers := make([]Offer, 0, len(result.Offers))
+	for _, offer := range result.Offers {
+		if offer.ActiveOn(now) {
+			offers = append(offers, offer)
+		}
+	}
+
+	c.getLogger().Info("fetched current offers",
+		slog.String("warehouse_number", warehouseNumber),
+		slog.Int("offer_count", len(offers)))
+
+	return offers, nil
+}
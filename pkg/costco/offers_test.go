@@ -0,0 +1,80 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetCurrentOffers(t *testing.T) {
+	today := time.Now()
+	client := newMockClient(t, Config{WarehouseNumber: "847"}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/AjaxGetWarehouseSavings", r.URL.Path)
+		assert.Equal(t, "847", r.URL.Query().Get("warehouseNumber"))
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"offers": []Offer{
+				{
+					OfferID:        "o1",
+					Description:    "Kirkland Signature Paper Towels",
+					ItemNumbers:    []string{"1529345"},
+					DiscountAmount: 4.00,
+					Channel:        OfferChannelBoth,
+					ValidFrom:      today.AddDate(0, 0, -3).Format("2006-01-02"),
+					ValidTo:        today.AddDate(0, 0, 10).Format("2006-01-02"),
+				},
+				{
+					OfferID:   "expired",
+					ValidFrom: today.AddDate(0, -1, 0).Format("2006-01-02"),
+					ValidTo:   today.AddDate(0, 0, -1).Format("2006-01-02"),
+				},
+			},
+		})
+	})
+
+	offers, err := client.GetCurrentOffers(context.Background(), "")
+	require.NoError(t, err)
+	require.Len(t, offers, 1, "expired offers are dropped")
+
+	assert.Equal(t, "o1", offers[0].OfferID)
+	assert.Equal(t, 4.00, offers[0].DiscountAmount)
+	assert.True(t, offers[0].AppliesTo("1529345"))
+	assert.False(t, offers[0].AppliesTo("30669"))
+}
+
+func TestGetCurrentOffers_RequiresWarehouse(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("no request expected")
+	})
+
+	_, err := client.GetCurrentOffers(context.Background(), "")
+	assert.Error(t, err)
+}
+
+func TestOffersForItems(t *testing.T) {
+	offers := []Offer{
+		{OfferID: "a", ItemNumbers: []string{"1", "2"}},
+		{OfferID: "b", ItemNumbers: []string{"3"}},
+		{OfferID: "c", ItemNumbers: []string{"2", "4"}},
+	}
+
+	matches := OffersForItems(offers, []string{"2", "9"})
+	require.Len(t, matches, 2)
+	assert.Equal(t, "a", matches[0].OfferID)
+	assert.Equal(t, "c", matches[1].OfferID)
+
+	assert.Empty(t, OffersForItems(offers, nil))
+}
+
+func TestOfferActiveOn(t *testing.T) {
+	offer := Offer{ValidFrom: "2026-10-01", ValidTo: "2026-10-26"}
+
+	assert.True(t, offer.ActiveOn(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, offer.ActiveOn(time.Date(2026, 10, 26, 23, 0, 0, 0, time.UTC)))
+	assert.False(t, offer.ActiveOn(time.Date(2026, 10, 27, 0, 0, 0, 0, time.UTC)))
+	assert.True(t, (&Offer{}).ActiveOn(time.Now()), "open-ended offers are always active")
+}
@@ -0,0 +1,117 @@
+package costco
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Optical department orders
+
+// DepartmentOptical is the GetSpendingSummary key for optical orders,
+// which are billed outside of warehouse receipts.
+const DepartmentOptical = -2
+
+// Optical order types
+const (
+	OpticalOrderGlasses  = "GLASSES"
+	OpticalOrderContacts = "CONTACTS"
+)
+
+// OpticalOrder represents a glasses or contact lens order from the optical department
+type OpticalOrder struct {
+	OrderNumber       string             `json:"orderNumber"`
+	OrderDate         string             `json:"orderDate"` // YYYY-MM-DD
+	Type              string             `json:"orderType"` // OpticalOrderGlasses or OpticalOrderContacts
+	Status            string             `json:"status"`    // e.g. "In Lab", "Ready for Pickup", "Picked Up"
+	WarehouseNumber   string             `json:"warehouseNumber"`
+	PatientName       string             `json:"patientName"`
+	InsuranceCoverage float64            `json:"insuranceCoverage"` // Amount paid by vision insurance
+	Total             float64            `json:"total"`             // Amount paid by the member
+	Items             []OpticalOrderItem `json:"items"`
+	Currency          string             `json:"currency,omitempty"` // Set by the client from the configured locale
+}
+
+// OpticalOrderItem represents a frame, lens, or contact lens box on an optical order
+type OpticalOrderItem struct {
+	Description string  `json:"description"` // e.g. "Kirkland Signature Frame", "Progressive Lenses"
+	Quantity    int     `json:"quantity"`
+	Amount      float64 `json:"amount"`
+}
+
+// Transaction converts the order to a TransactionWithItems with its items under
+// DepartmentOptical, so it can be analyzed and exported alongside receipts. Insurance
+// coverage becomes a credit line, so the items add up to what the member paid.
+func (o *OpticalOrder) Transaction() TransactionWithItems {
+	orderDate, _ := time.Parse("2006-01-02", o.OrderDate)
+	warehouseNumber, _ := strconv.Atoi(o.WarehouseNumber)
+
+	items := make([]ReceiptItem, 0, len(o.Items)+1)
+	for _, item := range o.Items {
+		items = append(items, ReceiptItem{
+			ItemDescription01:    item.Description,
+			ItemDepartmentNumber: DepartmentOptical,
+			Unit:                 item.Quantity,
+			Amount:               item.Amount,
+		})
+	}
+	if o.InsuranceCoverage > 0 {
+		items = append(items, ReceiptItem{
+			ItemDescription01:    "Insurance Coverage",
+			ItemDepartmentNumber: DepartmentOptical,
+			Amount:               -o.InsuranceCoverage,
+		})
+	}
+
+	return TransactionWithItems{
+		TransactionBarcode: o.OrderNumber,
+		TransactionDate:    orderDate,
+		WarehouseName:      DepartmentName(DepartmentOptical),
+		WarehouseNumber:    warehouseNumber,
+		Total:              o.Total,
+		Items:              items,
+		Currency:           o.Currency,
+		Source:             TransactionSourceOptical,
+	}
+}
+
+// GetOpticalOrders retrieves glasses and contact lens orders within a date range.
+// The startDate and endDate should be in YYYY-MM-DD format.
+// Set Config.IncludeServiceOrders to fold these orders into GetAllTransactionItems and
+// the analytics helpers built on it, e.g. under DepartmentOptical in GetSpendingSummary.
+//
+// Example:
+//
+//	orders, err := client.GetOpticalOrders(ctx, "2025-01-01", "2025-12-31")
+//	for _, order := range orders {
+//	    fmt.Printf("%s %s for %s: $%.2f (insurance $%.2f)\n",
+//	        order.OrderDate, order.Type, order.PatientName, order.Total, order.InsuranceCoverage)
+//	}
+func (c *Client) GetOpticalOrders(ctx context.Context, startDate, endDate string) ([]OpticalOrder, error) {
+	c.getLogger().Info("fetching optical orders",
+		slog.String("start_date", startDate),
+		slog.String("end_date", endDate))
+
+	params := url.Values{}
+	params.Set("startDate", startDate)
+	params.Set("endDate", endDate)
+
+	var result struct {
+		Orders []OpticalOrder `json:"orders"`
+	}
+	if err := c.executeREST(ctx, http.MethodGet, OpticalEndpoint, params, nil, true, &result); err != nil {
+		return nil, err
+	}
+
+	currency := c.currency()
+	for i := range result.Orders {
+		result.Orders[i].Currency = currency
+	}
+
+	c.getLogger().Info("fetched optical orders", slog.Int("order_count", len(result.Orders)))
+
+	return result.Orders, nil
+}
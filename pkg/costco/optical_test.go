@@ -0,0 +1,103 @@
+package costco
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testOpticalOrdersJSON = `{"orders": [
+	{
+		"orderNumber": "OP-1",
+		"orderDate": "2025-03-02",
+		"orderType": "GLASSES",
+		"status": "Picked Up",
+		"patientName": "Pat Smith",
+		"insuranceCoverage": 150.00,
+		"total": 189.98,
+		"items": [
+			{"description": "Kirkland Signature Frame", "quantity": 1, "amount": 69.99},
+			{"description": "Progressive Lenses", "quantity": 2, "amount": 269.99}
+		]
+	}
+]}`
+
+func TestGetOpticalOrders(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/ebusiness/optical/v1/orders", r.URL.Path)
+		assert.Equal(t, "2025-01-01", r.URL.Query().Get("startDate"))
+		w.Write([]byte(testOpticalOrdersJSON))
+	})
+
+	orders, err := client.GetOpticalOrders(context.Background(), "2025-01-01", "2025-12-31")
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+
+	assert.Equal(t, OpticalOrderGlasses, orders[0].Type)
+	assert.Equal(t, 150.00, orders[0].InsuranceCoverage)
+	assert.Equal(t, 189.98, orders[0].Total)
+	require.Len(t, orders[0].Items, 2)
+}
+
+func TestGetSpendingSummary_IncludesOpticalOrders(t *testing.T) {
+	client := newMockClient(t, Config{IncludeServiceOrders: true}, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ebusiness/optical/v1/orders":
+			w.Write([]byte(testOpticalOrdersJSON))
+		case "/ebusiness/photo/v1/orders":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{}},
+			})
+		}
+	})
+
+	summary, err := client.GetSpendingSummary(context.Background(), "2025-01-01", "2025-12-31")
+	require.NoError(t, err)
+
+	optical, ok := summary[DepartmentOptical]
+	require.True(t, ok)
+	assert.Equal(t, "Optical", optical.Department)
+	assert.InDelta(t, 189.98, optical.Total, 0.001, "only the member-paid amount counts as spending")
+	assert.Equal(t, 3, optical.ItemCount)
+	assert.NotContains(t, summary, DepartmentPhotoCenter)
+}
+
+func TestGetAllTransactionItems_IncludesServiceOrders(t *testing.T) {
+	client := newMockClient(t, Config{IncludeServiceOrders: true}, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ebusiness/optical/v1/orders":
+			w.Write([]byte(testOpticalOrdersJSON))
+		case "/ebusiness/photo/v1/orders":
+			w.Write([]byte(testPhotoOrdersJSON))
+		default:
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{}},
+			})
+		}
+	})
+
+	transactions, err := client.GetAllTransactionItems(context.Background(), "2025-01-01", "2025-12-31")
+	require.NoError(t, err)
+
+	sources := map[string]TransactionWithItems{}
+	for _, tx := range transactions {
+		sources[tx.Source] = tx
+	}
+	require.Contains(t, sources, TransactionSourcePhotoCenter)
+	require.Contains(t, sources, TransactionSourceOptical)
+
+	photo := sources[TransactionSourcePhotoCenter]
+	assert.Equal(t, 20.00, photo.Total)
+	require.Len(t, photo.Items, 2)
+	assert.Equal(t, DepartmentPhotoCenter, photo.Items[0].ItemDepartmentNumber)
+
+	optical := sources[TransactionSourceOptical]
+	assert.Equal(t, 189.98, optical.Total)
+	require.Len(t, optical.Items, 3)
+	assert.Equal(t, -150.00, optical.Items[2].Amount, "insurance coverage is a credit line")
+}
@@ -11,19 +11,98 @@ import (
 // Email is used only for logging purposes. Authentication is done via tokens.
 // WarehouseNumber defaults to "847" if not provided.
 // TokenRefreshBuffer controls how early tokens are refreshed (default: 5 minutes before expiry).
+// DocumentType and DocumentSubType filter the receipts fetched by the analytics helpers (default: "all").
+// SecretBackend selects where sensitive fields live; a keychain pointer in Email is resolved by NewClient.
+// Locale and Currency control description language and the currency amounts are tagged with.
+// StaleTokenMaxAge controls when expired token files are deleted on startup (default: 7 days, negative disables).
+// ReadOnly disables all writes to ~/.costco (for Lambda or read-only containers); combine it with
+// Tokens to keep the session purely in memory.
+// IncludeBusinessDelivery adds Costco Business Delivery orders to the analytics helpers.
+// IncludeServiceOrders adds Photo Center and optical orders to the analytics helpers and exports, at the cost of two more API calls.
+// GrossPrices makes the analytics helpers report shelf prices instead of folding discount lines into their items.
+// DetailWorkers bounds how many receipt details GetAllTransactionItems fetches at once (default: 4, 1 = one at a time).
+// Calendar sets custom month start days, biweekly periods, and fiscal years for the period-based reports.
+// UseLocalStore makes the analytics helpers read receipts from ~/.costco/transactions.json and fetch only unsynced days.
+// Progress, if set, is called with the receipt details fetched so far and the batch total while GetAllTransactionItems,
+// Aggregate, and SyncStore fetch details; calls come from the worker goroutines, one at a time.
 // Logger is optional - if nil, all logs are silently discarded.
 type Config struct {
-	Email              string        // Costco account email (for logging only)
-	WarehouseNumber    string        // Default warehouse number (default: "847")
-	TokenRefreshBuffer time.Duration // How early to refresh tokens before expiry (default: 5min)
-	Logger             *slog.Logger  // Optional structured logger (nil = silent)
+	Email                   string                // Costco account email (for logging only)
+	SecretBackend           SecretBackend         // Where sensitive config fields are stored (default: "file")
+	WarehouseNumber         string                // Default warehouse number (default: "847")
+	DocumentType            string                // Receipt document type used by analytics helpers (default: "all")
+	DocumentSubType         string                // Receipt document sub-type used by analytics helpers (default: "all")
+	Locale                  Locale                // Presentation locale: en-US, en-CA, fr-CA (default: none)
+	Currency                string                // Currency code for amounts (default: derived from Locale)
+	TokenRefreshBuffer      time.Duration         // How early to refresh tokens before expiry (default: 5min)
+	StaleTokenMaxAge        time.Duration         // Age after which expired token files are removed (default: 7 days)
+	ReadOnly                bool                  // Never write tokens or config to disk (default: false)
+	IncludeBusinessDelivery bool                  // Include Business Delivery orders in GetAllTransactionItems (default: false)
+	IncludeServiceOrders    bool                  // Include Photo Center and optical orders in GetAllTransactionItems (default: false)
+	GrossPrices             bool                  // Report item amounts before discounts in analytics helpers (default: false)
+	DetailWorkers           int                   // Concurrent receipt detail fetches in GetAllTransactionItems (default: 4)
+	UseLocalStore           bool                  // Run analytics against the local transaction store (default: false)
+	Calendar                FiscalCalendar        // Report period boundaries (default: calendar months and years)
+	Tags                    []string              // Only analyze transactions with any of these tags; see TagTransaction (default: all)
+	Tokens                  *StoredTokens         // Initial tokens; when set, ~/.costco/tokens.json is not read
+	Progress                func(done, total int) // Receipt detail fetch progress (optional)
+	Logger                  *slog.Logger          // Optional structured logger (nil = silent)
 }
 
 // StoredConfig represents user configuration persisted to disk.
 // This is saved to ~/.costco/config.json and contains non-sensitive settings.
+// Besides the account email it carries the profile's query defaults, which are
+// honored by the CLI and passed to the library via ClientConfig.
+// With SecretBackendKeychain the email is kept in the OS keychain and config.json
+// only holds a pointer to it.
 type StoredConfig struct {
-	Email           string `json:"email"`
-	WarehouseNumber string `json:"warehouse_number"`
+	Email                string             `json:"email"`
+	WarehouseNumber      string             `json:"warehouse_number"`
+	SecretBackend        SecretBackend      `json:"secret_backend,omitempty"`
+	DefaultDateRangeDays int                `json:"default_date_range_days,omitempty"`
+	OutputFormat         string             `json:"output_format,omitempty"`
+	Locale               Locale             `json:"locale,omitempty"`
+	Currency             string             `json:"currency,omitempty"`
+	DocumentType         string             `json:"document_type,omitempty"`
+	DocumentSubType      string             `json:"document_sub_type,omitempty"`
+	Budgets              map[string]float64 `json:"budgets,omitempty"` // Monthly budget by category; see BudgetCategory
+}
+
+// ClientConfig builds a client Config from the stored profile defaults.
+// Unset fields are left empty so NewClient can apply its own defaults.
+//
+// Example:
+//
+//	stored, _ := costco.LoadConfig()
+//	client := costco.NewClient(stored.ClientConfig())
+func (s *StoredConfig) ClientConfig() Config {
+	return Config{
+		Email:           s.Email,
+		SecretBackend:   s.SecretBackend,
+		WarehouseNumber: s.WarehouseNumber,
+		DocumentType:    s.DocumentType,
+		DocumentSubType: s.DocumentSubType,
+		Locale:          s.Locale,
+		Currency:        s.Currency,
+	}
+}
+
+// DateRangeDays returns the default query window length in days,
+// falling back to DefaultDateRangeDays when the profile doesn't set one.
+func (s *StoredConfig) DateRangeDays() int {
+	if s.DefaultDateRangeDays > 0 {
+		return s.DefaultDateRangeDays
+	}
+	return DefaultDateRangeDays
+}
+
+// Format returns the preferred output format ("text" or "json"),
+// falling back to DefaultOutputFormat when the profile doesn't set one.
+func (s *StoredConfig) Format() string {
+	if s.OutputFormat != "" {
+		return s.OutputFormat
+	}
+	return DefaultOutputFormat
 }
 
 // StoredTokens represents authentication tokens persisted to disk.
@@ -14,7 +14,30 @@ type OnlineOrder struct {
 	OrderCancelAllowed bool            `json:"orderCancelAllowed"`
 	OrderPaymentFailed bool            `json:"orderPaymentFailed"`
 	OrderReturnAllowed bool            `json:"orderReturnAllowed"`
+	Payments           []OrderPayment  `json:"paymentSummary"`
 	OrderLineItems     []OrderLineItem `json:"orderLineItems"`
+	Currency           string          `json:"currency,omitempty"` // Set by the client from the configured locale
+}
+
+// OrderPayment represents one tender used to pay for an online order.
+// Together with the order date it lets online orders be matched to credit card
+// transactions, as Receipt.TenderArray does for warehouse receipts.
+type OrderPayment struct {
+	TenderType string  `json:"tenderType"` // e.g. "CREDIT_CARD", "SHOP_CARD"
+	CardType   string  `json:"cardType"`   // e.g. "Visa", "Costco Shop Card"
+	LastFour   string  `json:"lastFourDigits"`
+	Amount     float64 `json:"amount"`
+}
+
+// PaidWith returns the total amount charged to the card ending in lastFour.
+func (o *OnlineOrder) PaidWith(lastFour string) float64 {
+	var total float64
+	for _, p := range o.Payments {
+		if p.LastFour == lastFour {
+			total += p.Amount
+		}
+	}
+	return total
 }
 
 // OrderLineItem represents a single line item within an online order
@@ -0,0 +1,53 @@
+package costco
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Overview of a date range for reports
+
+// Overview is the summary, monthly series, and savings of a date range, built from
+// one fetch of the transactions. This is returned by GetOverview.
+type Overview struct {
+	Summary *Summary        // Same as GetSummary
+	Months  *SpendSeries    // Same as GetSpendSeries with ReportPeriodMonth
+	Savings *SavingsSummary // Same as GetSavingsSummary
+}
+
+// GetOverview reports what GetSummary, GetSpendSeries by month, and GetSavingsSummary
+// report for a date range, fetching the transactions once instead of three times.
+//
+// The startDate and endDate should be in YYYY-MM-DD format.
+//
+// Example:
+//
+//	overview, err := client.GetOverview(ctx, "2025-01-01", "2025-12-31")
+//	for _, month := range overview.Months.Points {
+//	    fmt.Printf("%s: $%.2f\n", month.Label, month.Spend)
+//	}
+func (c *Client) GetOverview(ctx context.Context, startDate, endDate string) (*Overview, error) {
+	start, err := time.Parse("2006-01-02", startDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start date %q: %w", startDate, err)
+	}
+	end, err := time.Parse("2006-01-02", endDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid end date %q: %w", endDate, err)
+	}
+	if end.Before(start) {
+		return nil, fmt.Errorf("end date %s is before start date %s", endDate, startDate)
+	}
+
+	transactions, err := c.GetAllTransactionItems(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Overview{
+		Summary: c.summarize(startDate, endDate, transactions),
+		Months:  c.spendSeries(transactions, start, end, ReportPeriodMonth),
+		Savings: c.savingsSummary(transactions),
+	}, nil
+}
@@ -0,0 +1,33 @@
+package costco
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOverview(t *testing.T) {
+	client := newStreamTestClient(t, 2)
+	ctx := context.Background()
+
+	overview, err := client.GetOverview(ctx, "2025-01-01", "2025-03-31")
+	require.NoError(t, err)
+
+	summary, err := client.GetSummary(ctx, "2025-01-01", "2025-03-31")
+	require.NoError(t, err)
+	assert.Equal(t, summary, overview.Summary)
+
+	series, err := client.GetSpendSeries(ctx, "2025-01-01", "2025-03-31", ReportPeriodMonth)
+	require.NoError(t, err)
+	assert.Equal(t, series, overview.Months)
+	require.Len(t, overview.Months.Points, 3, "empty months are included")
+
+	savings, err := client.GetSavingsSummary(ctx, "2025-01-01", "2025-03-31")
+	require.NoError(t, err)
+	assert.Equal(t, savings, overview.Savings)
+
+	_, err = client.GetOverview(ctx, "2025-03-31", "2025-01-01")
+	assert.ErrorContains(t, err, "before start date")
+}
@@ -0,0 +1,138 @@
+package costco
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// Shopping patterns by day and time
+
+// ShoppingPatterns describes when warehouse trips happen.
+// This is returned by GetShoppingPatterns.
+type ShoppingPatterns struct {
+	ByWeekday   []WeekdayStats // Sunday first
+	ByHour      []HourStats    // Midnight first
+	BusiestDay  time.Weekday   // Weekday with the most trips
+	BusiestHour int            // Hour of day (0-23) with the most trips
+	GasOrder    GasTripOrder   // How fill-ups line up with warehouse trips
+}
+
+// WeekdayStats counts warehouse trips and spending on one day of the week.
+type WeekdayStats struct {
+	Weekday       time.Weekday
+	Trips         int
+	Total         float64
+	AverageBasket float64 // Total / Trips
+}
+
+// HourStats counts warehouse trips starting in one hour of the day.
+type HourStats struct {
+	Hour  int // 0-23
+	Trips int
+}
+
+// GasTripOrder counts gas station visits by whether they were on the same day
+// as a warehouse trip and which came first.
+type GasTripOrder struct {
+	BeforeWarehouse int // Filled up, then shopped
+	AfterWarehouse  int // Shopped, then filled up
+	GasOnly         int // No warehouse trip that day
+}
+
+// GetShoppingPatterns buckets warehouse trips by weekday and hour using each
+// receipt's transaction time, reporting the busiest day and hour and the average
+// basket for each weekday. Gas station receipts aren't counted as trips; instead
+// GasOrder reports whether fill-ups usually come before or after shopping.
+// Returns are ignored.
+//
+// The startDate and endDate should be in YYYY-MM-DD format.
+//
+// Example:
+//
+//	patterns, err := client.GetShoppingPatterns(ctx, "2025-01-01", "2025-12-31")
+//	fmt.Printf("Busiest: %s around %d:00\n", patterns.BusiestDay, patterns.BusiestHour)
+//	for _, d := range patterns.ByWeekday {
+//	    fmt.Printf("%-9s %3d trips, avg $%.2f\n", d.Weekday, d.Trips, d.AverageBasket)
+//	}
+func (c *Client) GetShoppingPatterns(ctx context.Context, startDate, endDate string) (*ShoppingPatterns, error) {
+	transactions, err := c.GetAllTransactionItems(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	patterns := &ShoppingPatterns{
+		ByWeekday: make([]WeekdayStats, 7),
+		ByHour:    make([]HourStats, 24),
+	}
+	for day := range patterns.ByWeekday {
+		patterns.ByWeekday[day].Weekday = time.Weekday(day)
+	}
+	for hour := range patterns.ByHour {
+		patterns.ByHour[hour].Hour = hour
+	}
+
+	// Warehouse trip times and gas fill-up times, by day
+	shopping := make(map[string][]time.Time)
+	var fillUps []time.Time
+
+	for _, tx := range transactions {
+		if tx.Source != TransactionSourceReceipt || tx.IsRefund() || tx.TransactionDate.IsZero() {
+			continue
+		}
+		switch tx.DocumentType {
+		case DocumentTypeFuel:
+			fillUps = append(fillUps, tx.TransactionDate)
+		case DocumentTypeWarehouse:
+			day := &patterns.ByWeekday[tx.TransactionDate.Weekday()]
+			day.Trips++
+			day.Total += tx.Total
+			patterns.ByHour[tx.TransactionDate.Hour()].Trips++
+
+			key := tx.TransactionDate.Format("2006-01-02")
+			shopping[key] = append(shopping[key], tx.TransactionDate)
+		}
+	}
+
+	for i := range patterns.ByWeekday {
+		day := &patterns.ByWeekday[i]
+		day.Total = roundTo(day.Total, 2)
+		if day.Trips > 0 {
+			day.AverageBasket = roundTo(day.Total/float64(day.Trips), 2)
+		}
+		if day.Trips > patterns.ByWeekday[patterns.BusiestDay].Trips {
+			patterns.BusiestDay = day.Weekday
+		}
+	}
+	for _, hour := range patterns.ByHour {
+		if hour.Trips > patterns.ByHour[patterns.BusiestHour].Trips {
+			patterns.BusiestHour = hour.Hour
+		}
+	}
+
+	for _, fillUp := range fillUps {
+		trips := shopping[fillUp.Format("2006-01-02")]
+		if len(trips) == 0 {
+			patterns.GasOrder.GasOnly++
+			continue
+		}
+		// Compare against the trip closest in time
+		sort.Slice(trips, func(i, j int) bool {
+			return absDuration(trips[i].Sub(fillUp)) < absDuration(trips[j].Sub(fillUp))
+		})
+		if fillUp.Before(trips[0]) {
+			patterns.GasOrder.BeforeWarehouse++
+		} else {
+			patterns.GasOrder.AfterWarehouse++
+		}
+	}
+
+	return patterns, nil
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
@@ -0,0 +1,60 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetShoppingPatterns(t *testing.T) {
+	// 2025-01-04 and 2025-01-11 are Saturdays, 2025-01-08 is a Wednesday
+	details := map[string]map[string]interface{}{
+		"W1": {"transactionBarcode": "W1", "transactionDateTime": "2025-01-04T10:15:00", "total": 200.00},
+		"G1": {"transactionBarcode": "G1", "transactionDateTime": "2025-01-04T09:50:00", "total": 50.00},
+		"W2": {"transactionBarcode": "W2", "transactionDateTime": "2025-01-11T10:40:00", "total": 100.00},
+		"G2": {"transactionBarcode": "G2", "transactionDateTime": "2025-01-11T11:30:00", "total": 45.00},
+		"W3": {"transactionBarcode": "W3", "transactionDateTime": "2025-01-08T18:05:00", "total": 60.00},
+		"G3": {"transactionBarcode": "G3", "transactionDateTime": "2025-01-09T07:00:00", "total": 40.00},
+	}
+
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if barcode, ok := req.Variables["barcode"].(string); ok {
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{details[barcode]}},
+			})
+			return
+		}
+		var receipts []map[string]interface{}
+		for _, barcode := range []string{"W1", "G1", "W2", "G2", "W3", "G3"} {
+			receipt := map[string]interface{}{"transactionBarcode": barcode}
+			if barcode[0] == 'G' {
+				receipt["receiptType"] = ReceiptTypeGasStation
+			}
+			receipts = append(receipts, receipt)
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{"receipts": receipts},
+		})
+	})
+
+	patterns, err := client.GetShoppingPatterns(context.Background(), "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+
+	assert.Equal(t, time.Saturday, patterns.BusiestDay)
+	assert.Equal(t, 10, patterns.BusiestHour)
+
+	saturday := patterns.ByWeekday[time.Saturday]
+	assert.Equal(t, 2, saturday.Trips)
+	assert.Equal(t, 150.00, saturday.AverageBasket)
+	assert.Equal(t, 1, patterns.ByWeekday[time.Wednesday].Trips)
+	assert.Equal(t, 1, patterns.ByHour[18].Trips)
+
+	assert.Equal(t, GasTripOrder{BeforeWarehouse: 1, AfterWarehouse: 1, GasOnly: 1}, patterns.GasOrder)
+}
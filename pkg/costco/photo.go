@@ -0,0 +1,104 @@
+package costco
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Costco Photo Center orders
+
+// DepartmentPhotoCenter is the GetSpendingSummary key for Photo Center orders,
+// which have no warehouse department number.
+const DepartmentPhotoCenter = -1
+
+// PhotoOrder represents a Costco Photo Center order (prints, photo books, canvas, ...)
+type PhotoOrder struct {
+	OrderNumber           string           `json:"orderNumber"`
+	OrderDate             string           `json:"orderDate"` // YYYY-MM-DD
+	Status                string           `json:"status"`    // e.g. "Ready for Pickup", "Shipped", "Picked Up"
+	PickupWarehouseNumber string           `json:"pickupWarehouseNumber,omitempty"`
+	SubTotal              float64          `json:"subTotal"`
+	Tax                   float64          `json:"tax"`
+	Total                 float64          `json:"total"`
+	Items                 []PhotoOrderItem `json:"items"`
+	Currency              string           `json:"currency,omitempty"` // Set by the client from the configured locale
+}
+
+// PhotoOrderItem represents a single product on a Photo Center order
+type PhotoOrderItem struct {
+	SKU         string  `json:"sku"`
+	Description string  `json:"description"` // e.g. "4x6 Glossy Print"
+	Quantity    int     `json:"quantity"`
+	Amount      float64 `json:"amount"`
+}
+
+// Transaction converts the order to a TransactionWithItems with its items under
+// DepartmentPhotoCenter, so it can be analyzed and exported alongside receipts.
+func (o *PhotoOrder) Transaction() TransactionWithItems {
+	orderDate, _ := time.Parse("2006-01-02", o.OrderDate)
+	warehouseNumber, _ := strconv.Atoi(o.PickupWarehouseNumber)
+
+	items := make([]ReceiptItem, len(o.Items))
+	for i, item := range o.Items {
+		items[i] = ReceiptItem{
+			ItemNumber:           item.SKU,
+			ItemDescription01:    item.Description,
+			ItemDepartmentNumber: DepartmentPhotoCenter,
+			Unit:                 item.Quantity,
+			Amount:               item.Amount,
+		}
+	}
+
+	return TransactionWithItems{
+		TransactionBarcode: o.OrderNumber,
+		TransactionDate:    orderDate,
+		WarehouseName:      DepartmentName(DepartmentPhotoCenter),
+		WarehouseNumber:    warehouseNumber,
+		Total:              o.Total,
+		Taxes:              o.Tax,
+		Items:              items,
+		Currency:           o.Currency,
+		Source:             TransactionSourcePhotoCenter,
+	}
+}
+
+// GetPhotoOrders retrieves Costco Photo Center orders within a date range.
+// The startDate and endDate should be in YYYY-MM-DD format.
+// Set Config.IncludeServiceOrders to fold these orders into GetAllTransactionItems and
+// the analytics helpers built on it, e.g. under DepartmentPhotoCenter in GetSpendingSummary.
+//
+// Example:
+//
+//	orders, err := client.GetPhotoOrders(ctx, "2025-01-01", "2025-12-31")
+//	for _, order := range orders {
+//	    fmt.Printf("%s %s: $%.2f\n", order.OrderDate, order.Status, order.Total)
+//	}
+func (c *Client) GetPhotoOrders(ctx context.Context, startDate, endDate string) ([]PhotoOrder, error) {
+	c.getLogger().Info("fetching photo orders",
+		slog.String("start_date", startDate),
+		slog.String("end_date", endDate))
+
+	params := url.Values{}
+	params.Set("startDate", startDate)
+	params.Set("endDate", endDate)
+
+	var result struct {
+		Orders []PhotoOrder `json:"orders"`
+	}
+	if err := c.executeREST(ctx, http.MethodGet, PhotoEndpoint, params, nil, true, &result); err != nil {
+		return nil, err
+	}
+
+	currency := c.currency()
+	for i := range result.Orders {
+		result.Orders[i].Currency = currency
+	}
+
+	c.getLogger().Info("fetched photo orders", slog.Int("order_count", len(result.Orders)))
+
+	return result.Orders, nil
+}
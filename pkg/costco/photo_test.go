@@ -0,0 +1,97 @@
+package costco
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPhotoOrdersJSON = `{"orders": [
+	{
+		"orderNumber": "P100",
+		"orderDate": "2025-01-05",
+		"status": "Picked Up",
+		"pickupWarehouseNumber": "847",
+		"subTotal": 18.50,
+		"tax": 1.50,
+		"total": 20.00,
+		"items": [
+			{"sku": "4X6G", "description": "4x6 Glossy Print", "quantity": 50, "amount": 8.50},
+			{"sku": "BOOK", "description": "Photo Book", "quantity": 1, "amount": 10.00}
+		]
+	}
+]}`
+
+func TestGetPhotoOrders(t *testing.T) {
+	client := newMockClient(t, Config{Locale: LocaleEnCA}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/ebusiness/photo/v1/orders", r.URL.Path)
+		assert.Equal(t, "2025-01-01", r.URL.Query().Get("startDate"))
+		assert.Equal(t, "2025-01-31", r.URL.Query().Get("endDate"))
+		w.Write([]byte(testPhotoOrdersJSON))
+	})
+
+	orders, err := client.GetPhotoOrders(context.Background(), "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+
+	assert.Equal(t, "P100", orders[0].OrderNumber)
+	assert.Equal(t, 20.00, orders[0].Total)
+	assert.Equal(t, CurrencyCAD, orders[0].Currency)
+	require.Len(t, orders[0].Items, 2)
+	assert.Equal(t, 50, orders[0].Items[0].Quantity)
+}
+
+func TestGetSpendingSummary_IncludesPhotoOrders(t *testing.T) {
+	client := newMockClient(t, Config{IncludeServiceOrders: true}, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/ebusiness/photo/v1/orders" {
+			w.Write([]byte(testPhotoOrdersJSON))
+			return
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{}},
+		})
+	})
+
+	summary, err := client.GetSpendingSummary(context.Background(), "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+
+	photo, ok := summary[DepartmentPhotoCenter]
+	require.True(t, ok)
+	assert.Equal(t, "Photo Center", photo.Department)
+	assert.Equal(t, 18.50, photo.Total, "item amounts before tax, like receipt departments")
+	assert.Equal(t, 51, photo.ItemCount)
+}
+
+func TestGetSpendingSummary_PhotoOrdersUnavailable(t *testing.T) {
+	client := newMockClient(t, Config{IncludeServiceOrders: true}, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/ebusiness/photo/v1/orders" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{}},
+		})
+	})
+
+	summary, err := client.GetSpendingSummary(context.Background(), "2025-01-01", "2025-01-31")
+	require.NoError(t, err, "photo failures don't fail the summary")
+	assert.Empty(t, summary)
+}
+
+func TestGetSpendingSummary_ServiceOrdersOptIn(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/ebusiness/photo/v1/orders" || r.URL.Path == "/ebusiness/optical/v1/orders" {
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{}},
+		})
+	})
+
+	summary, err := client.GetSpendingSummary(context.Background(), "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+	assert.Empty(t, summary)
+}
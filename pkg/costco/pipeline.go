@@ -0,0 +1,180 @@
+package costco
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Composable item pipelines for custom reports
+
+// ItemRow is one receipt line and the transaction it came from, the unit a Pipeline
+// filters and groups.
+type ItemRow struct {
+	Transaction *TransactionWithItems
+	Item        ReceiptItem
+	Description string // Item description in the client's locale
+
+	calendar FiscalCalendar
+}
+
+// PipelineGroup is one group of a Pipeline's result.
+type PipelineGroup struct {
+	Key      string  // Group key; "" when the pipeline has no GroupBy
+	Count    int     // Number of item lines
+	Quantity int     // Units purchased
+	Total    float64 // Amount spent
+	Value    float64 // Custom value folded by Pipeline.Aggregate (0 if unused)
+}
+
+// Pipeline filters receipt lines, groups them, and folds each group into a
+// PipelineGroup. A Pipeline is an Aggregator, so it can be fed by Client.Aggregate
+// or by Run over transactions already fetched.
+//
+// Example: organic items over $10 by quarter
+//
+//	p := client.NewPipeline().
+//	    Filter(costco.ItemDescriptionContains("organic")).
+//	    Filter(costco.ItemAmountAtLeast(10)).
+//	    GroupBy(costco.ByPeriod(costco.ReportPeriodQuarter))
+//	err := client.Aggregate(ctx, "2025-01-01", "2025-12-31", p)
+//	for _, g := range p.Result() {
+//	    fmt.Printf("%s: $%.2f over %d items\n", g.Key, g.Total, g.Count)
+//	}
+type Pipeline struct {
+	client  *Client
+	filters []func(ItemRow) bool
+	groupBy func(ItemRow) string
+	folds   []func(*PipelineGroup, ItemRow)
+	groups  map[string]*PipelineGroup
+}
+
+// NewPipeline returns an empty Pipeline that honors the client's Locale and
+// GrossPrices settings. Discount lines are folded into their items as in the other
+// analytics helpers and are never passed to filters.
+func (c *Client) NewPipeline() *Pipeline {
+	return &Pipeline{client: c, groups: make(map[string]*PipelineGroup)}
+}
+
+// Filter keeps only the rows for which keep returns true. Filters are combined with AND.
+func (p *Pipeline) Filter(keep func(ItemRow) bool) *Pipeline {
+	p.filters = append(p.filters, keep)
+	return p
+}
+
+// GroupBy groups rows by the key returned for each; see ByPeriod, ByDepartment,
+// ByItem, and ByWarehouse. Without GroupBy every row lands in one group.
+func (p *Pipeline) GroupBy(key func(ItemRow) string) *Pipeline {
+	p.groupBy = key
+	return p
+}
+
+// Aggregate adds a custom fold, called for each row kept with the row's group.
+// Count, Quantity, and Total are always maintained; folds typically set Value.
+func (p *Pipeline) Aggregate(fold func(group *PipelineGroup, row ItemRow)) *Pipeline {
+	p.folds = append(p.folds, fold)
+	return p
+}
+
+// Add runs one transaction's items through the pipeline.
+func (p *Pipeline) Add(tx TransactionWithItems) {
+rows:
+	for _, item := range p.client.analyticsItems(tx.Items) {
+		if item.IsDiscount() {
+			continue
+		}
+		row := ItemRow{Transaction: &tx, Item: item, Description: p.client.itemName(item), calendar: p.client.config.Calendar}
+		for _, keep := range p.filters {
+			if !keep(row) {
+				continue rows
+			}
+		}
+
+		var key string
+		if p.groupBy != nil {
+			key = p.groupBy(row)
+		}
+		group, exists := p.groups[key]
+		if !exists {
+			group = &PipelineGroup{Key: key}
+			p.groups[key] = group
+		}
+		group.Count++
+		group.Quantity += item.Unit
+		group.Total += item.Amount
+		for _, fold := range p.folds {
+			fold(group, row)
+		}
+	}
+}
+
+// Run feeds transactions through the pipeline and returns its result.
+func (p *Pipeline) Run(transactions []TransactionWithItems) []PipelineGroup {
+	for _, tx := range transactions {
+		p.Add(tx)
+	}
+	return p.Result()
+}
+
+// Result returns the groups sorted by key.
+func (p *Pipeline) Result() []PipelineGroup {
+	groups := make([]PipelineGroup, 0, len(p.groups))
+	for _, group := range p.groups {
+		g := *group
+		g.Total = roundTo(g.Total, 2)
+		groups = append(groups, g)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Key < groups[j].Key
+	})
+	return groups
+}
+
+// ItemDescriptionContains keeps items whose description contains substr, ignoring case.
+func ItemDescriptionContains(substr string) func(ItemRow) bool {
+	substr = strings.ToLower(substr)
+	return func(row ItemRow) bool {
+		return strings.Contains(strings.ToLower(row.Description), substr)
+	}
+}
+
+// ItemAmountAtLeast keeps item lines that cost at least amount.
+func ItemAmountAtLeast(amount float64) func(ItemRow) bool {
+	return func(row ItemRow) bool {
+		return row.Item.Amount >= amount
+	}
+}
+
+// ItemInDepartment keeps items from one of the given department numbers.
+func ItemInDepartment(departments ...int) func(ItemRow) bool {
+	return func(row ItemRow) bool {
+		for _, dept := range departments {
+			if row.Item.ItemDepartmentNumber == dept {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// ByPeriod groups rows by the period of their transaction, following Config.Calendar.
+func ByPeriod(period ReportPeriod) func(ItemRow) string {
+	return func(row ItemRow) string {
+		return row.calendar.Label(period, row.Transaction.TransactionDate)
+	}
+}
+
+// ByDepartment groups rows by department name.
+func ByDepartment(row ItemRow) string {
+	return DepartmentName(row.Item.ItemDepartmentNumber)
+}
+
+// ByItem groups rows by item number.
+func ByItem(row ItemRow) string {
+	return row.Item.ItemNumber
+}
+
+// ByWarehouse groups rows by warehouse name and number.
+func ByWarehouse(row ItemRow) string {
+	return row.Transaction.WarehouseName + " #" + strconv.Itoa(row.Transaction.WarehouseNumber)
+}
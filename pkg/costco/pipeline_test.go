@@ -0,0 +1,74 @@
+package costco
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipeline(t *testing.T) {
+	transactions := []TransactionWithItems{
+		{
+			TransactionBarcode: "A", TransactionDate: time.Date(2025, 2, 10, 0, 0, 0, 0, time.UTC),
+			WarehouseName: "Issaquah", WarehouseNumber: 1,
+			Items: []ReceiptItem{
+				{ItemNumber: "1", ItemDescription01: "ORGANIC EGGS", ItemDepartmentNumber: 17, Unit: 1, Amount: 12.00},
+				{ItemNumber: "333", ItemDescription01: "/1", Unit: -1, Amount: -2.00},
+				{ItemNumber: "2", ItemDescription01: "ORGANIC BERRIES", ItemDepartmentNumber: 65, Unit: 1, Amount: 8.00},
+				{ItemNumber: "3", ItemDescription01: "TV", ItemDepartmentNumber: 24, Unit: 1, Amount: 400.00},
+			},
+		},
+		{
+			TransactionBarcode: "B", TransactionDate: time.Date(2025, 5, 3, 0, 0, 0, 0, time.UTC),
+			WarehouseName: "Kirkland", WarehouseNumber: 2,
+			Items: []ReceiptItem{
+				{ItemNumber: "4", ItemDescription01: "Organic Quinoa", ItemDepartmentNumber: 12, Unit: 2, Amount: 15.50},
+				{ItemNumber: "2", ItemDescription01: "ORGANIC BERRIES", ItemDepartmentNumber: 65, Unit: 2, Amount: 16.00},
+			},
+		},
+	}
+	client := &Client{}
+
+	// The "/1" discount folds into the eggs, which drop to $10 and still pass the filter
+	groups := client.NewPipeline().
+		Filter(ItemDescriptionContains("organic")).
+		Filter(ItemAmountAtLeast(10)).
+		GroupBy(ByPeriod(ReportPeriodQuarter)).
+		Run(transactions)
+	assert.Equal(t, []PipelineGroup{
+		{Key: "2025-Q1", Count: 1, Quantity: 1, Total: 10.00},
+		{Key: "2025-Q2", Count: 2, Quantity: 4, Total: 31.50},
+	}, groups)
+
+	// Custom fold and no grouping
+	largest := client.NewPipeline().
+		Filter(ItemInDepartment(65, 24)).
+		Aggregate(func(g *PipelineGroup, row ItemRow) {
+			g.Value = max(g.Value, row.Item.Amount)
+		}).
+		Run(transactions)
+	require.Len(t, largest, 1)
+	assert.Equal(t, "", largest[0].Key)
+	assert.Equal(t, 3, largest[0].Count)
+	assert.Equal(t, 400.00, largest[0].Value)
+
+	byWarehouse := client.NewPipeline().GroupBy(ByWarehouse).Run(transactions)
+	require.Len(t, byWarehouse, 2)
+	assert.Equal(t, "Issaquah #1", byWarehouse[0].Key)
+	assert.Equal(t, 418.00, byWarehouse[0].Total)
+}
+
+func TestPipeline_WithAggregate(t *testing.T) {
+	client := newStreamTestClient(t, 4)
+
+	p := client.NewPipeline().GroupBy(ByItem)
+	require.NoError(t, client.Aggregate(context.Background(), "2025-01-01", "2025-01-31", p))
+
+	groups := p.Result()
+	require.Len(t, groups, 2)
+	assert.Equal(t, PipelineGroup{Key: "1", Count: 4, Quantity: 4, Total: 30.00}, groups[0])
+	assert.Equal(t, PipelineGroup{Key: "2", Count: 4, Quantity: 8, Total: 40.00}, groups[1])
+}
@@ -0,0 +1,289 @@
+package costco
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Product search against Costco's ecommerce search service
+
+// SearchOptions controls paging and warehouse context for SearchProducts.
+type SearchOptions struct {
+	Page            int    // 1-based page number (default: 1)
+	PageSize        int    // Results per page (default: 24)
+	WarehouseNumber string // Warehouse for location pricing/availability (default: client's warehouse)
+}
+
+// Product represents a single product returned by SearchProducts.
+type Product struct {
+	ItemNumber   string  `json:"itemNumber"`
+	Name         string  `json:"name"`
+	Price        float64 `json:"price"`
+	Availability string  `json:"availability"` // e.g. "in stock", "out of stock"
+	URL          string  `json:"url"`
+	ImageURL     string  `json:"imageUrl"`
+}
+
+// InStock reports whether the product is listed as available.
+func (p *Product) InStock() bool {
+	return p.Availability == "in stock" || p.Availability == "instock"
+}
+
+// SearchProductsResponse represents a page of product search results.
+type SearchProductsResponse struct {
+	TotalResults int       `json:"totalResults"`
+	Page         int       `json:"page"`
+	PageSize     int       `json:"pageSize"`
+	Products     []Product `json:"products"`
+}
+
+// searchDoc is a single document in the raw search service response.
+type searchDoc struct {
+	ItemNumber   string  `json:"item_number"`
+	Name         string  `json:"item_product_name"`
+	Price        float64 `json:"item_location_pricing_salePrice"`
+	Availability string  `json:"item_location_availability"`
+	URL          string  `json:"item_product_url"`
+	ImageURL     string  `json:"item_product_img_url"`
+}
+
+// defaultSearchPageSize matches the page size used by costco.com.
+const defaultSearchPageSize = 24
+
+// SearchProducts searches Costco.com products by keyword or item number.
+// Use it to join receipt item numbers to current product data (name, price, availability, URL).
+// The search service is public, so this works without imported tokens.
+//
+// Parameters:
+//   - ctx: Context for cancellation and timeouts
+//   - query: Search text or item number (e.g., "paper towels", "1529345")
+//   - opts: Paging and warehouse options (zero value uses defaults)
+//
+// Example:
+//
+//	results, err := client.SearchProducts(ctx, "1529345", costco.SearchOptions{})
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, p := range results.Products {
+//	    fmt.Printf("%s %s: $%.2f (%s)\n", p.ItemNumber, p.Name, p.Price, p.URL)
+//	}
+func (c *Client) SearchProducts(ctx context.Context, query string, opts SearchOptions) (*SearchProductsResponse, error) {
+	if query == "" {
+		return nil, fmt.Errorf("search query is required")
+	}
+	if opts.Page < 1 {
+		opts.Page = 1
+	}
+	if opts.PageSize <= 0 {
+		opts.PageSize = defaultSearchPageSize
+	}
+	if opts.WarehouseNumber == "" {
+		opts.WarehouseNumber = c.config.WarehouseNumber
+	}
+
+	c.getLogger().Info("searching products",
+		slog.String("query", query),
+		slog.Int("page", opts.Page),
+		slog.Int("page_size", opts.PageSize))
+
+	params := url.Values{}
+	params.Set("q", query)
+	params.Set("start", strconv.Itoa((opts.Page-1)*opts.PageSize))
+	params.Set("rows", strconv.Itoa(opts.PageSize))
+	params.Set("expand", "false")
+	params.Set("locale", "en-US")
+	if opts.WarehouseNumber != "" {
+		params.Set("whloc", opts.WarehouseNumber)
+	}
+
+	var result struct {
+		Response struct {
+			NumFound int         `json:"numFound"`
+			Docs     []searchDoc `json:"docs"`
+		} `json:"response"`
+	}
+
+	if err := c.executeREST(ctx, http.MethodGet, SearchEndpoint, params, nil, false, &result); err != nil {
+		return nil, err
+	}
+
+	products := make([]Product, 0, len(result.Response.Docs))
+	for _, doc := range result.Response.Docs {
+		products = append(products, Product(doc))
+	}
+
+	c.getLogger().Info("searched products",
+		slog.String("query", query),
+		slog.Int("result_count", len(products)),
+		slog.Int("total_results", result.Response.NumFound))
+
+	return &SearchProductsResponse{
+		TotalResults: result.Response.NumFound,
+		Page:         opts.Page,
+		PageSize:     opts.PageSize,
+		Products:     products,
+	}, nil
+}
+
+// Inventory statuses reported by CheckAvailability
+const (
+	StockStatusInStock    = "IN_STOCK"
+	StockStatusLimited    = "LIMITED"
+	StockStatusOutOfStock = "OUT_OF_STOCK"
+	StockStatusNotSold    = "NOT_SOLD" // Item isn't carried at the warehouse / online
+)
+
+// ItemAvailability represents an item's stock status in a warehouse and online
+type ItemAvailability struct {
+	ItemNumber        string    `json:"itemNumber"`
+	WarehouseNumber   string    `json:"warehouseNumber"`
+	InWarehouseStatus string    `json:"inWarehouseStatus"` // One of the StockStatus constants
+	OnlineStatus      string    `json:"onlineStatus"`      // One of the StockStatus constants
+	CheckedAt         time.Time `json:"checkedAt"`
+}
+
+// InWarehouse reports whether the item can currently be bought at the warehouse.
+func (a *ItemAvailability) InWarehouse() bool {
+	return a.InWarehouseStatus == StockStatusInStock || a.InWarehouseStatus == StockStatusLimited
+}
+
+// Online reports whether the item can currently be ordered on Costco.com.
+func (a *ItemAvailability) Online() bool {
+	return a.OnlineStatus == StockStatusInStock || a.OnlineStatus == StockStatusLimited
+}
+
+// CheckAvailability retrieves an item's in-warehouse and online stock status.
+// If warehouseNumber is empty, the client's configured warehouse is used.
+// Combine it with GetFrequentItems to build restock alerts.
+//
+// Example:
+//
+//	avail, err := client.CheckAvailability(ctx, "1529345", "")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	if avail.InWarehouse() {
+//	    fmt.Println("back in stock at the warehouse")
+//	}
+func (c *Client) CheckAvailability(ctx context.Context, itemNumber, warehouseNumber string) (*ItemAvailability, error) {
+	if itemNumber == "" {
+		return nil, fmt.Errorf("item number is required")
+	}
+	if warehouseNumber == "" {
+		warehouseNumber = c.config.WarehouseNumber
+	}
+
+	c.getLogger().Info("checking availability",
+		slog.String("item_number", itemNumber),
+		slog.String("warehouse_number", warehouseNumber))
+
+	params := url.Values{}
+	params.Set("itemNumber", itemNumber)
+	params.Set("warehouseNumber", warehouseNumber)
+
+	var availability ItemAvailability
+	if err := c.executeREST(ctx, http.MethodGet, InventoryEndpoint, params, nil, false, &availability); err != nil {
+		return nil, err
+	}
+
+	if availability.ItemNumber == "" {
+		availability.ItemNumber = itemNumber
+	}
+	if availability.WarehouseNumber == "" {
+		availability.WarehouseNumber = warehouseNumber
+	}
+	if availability.InWarehouseStatus == "" {
+		availability.InWarehouseStatus = StockStatusNotSold
+	}
+	if availability.OnlineStatus == "" {
+		availability.OnlineStatus = StockStatusNotSold
+	}
+	if availability.CheckedAt.IsZero() {
+		availability.CheckedAt = time.Now()
+	}
+
+	c.getLogger().Info("checked availability",
+		slog.String("item_number", itemNumber),
+		slog.String("in_warehouse_status", availability.InWarehouseStatus),
+		slog.String("online_status", availability.OnlineStatus))
+
+	return &availability, nil
+}
+
+// ItemPrice represents the current Costco.com price for an item
+type ItemPrice struct {
+	ItemNumber     string  `json:"itemNumber"`
+	Price          float64 `json:"price"`              // Current price after instant savings
+	RegularPrice   float64 `json:"regularPrice"`       // Price before instant savings
+	InstantSavings float64 `json:"instantSavings"`     // Current instant savings (0 when none)
+	UnitPrice      float64 `json:"unitPrice"`          // Price per UnitOfMeasure (0 when not provided)
+	UnitOfMeasure  string  `json:"unitOfMeasure"`      // e.g. "oz", "ct", "sheet"
+	MemberOnly     bool    `json:"memberOnly"`         // Only purchasable by signed-in members
+	Currency       string  `json:"currency,omitempty"` // Set by the client from the configured locale
+}
+
+// PriceDrop returns how much less the item costs now than the unit price paid,
+// or 0 if the price hasn't dropped. Use it to spot price-adjustment opportunities.
+//
+// Example:
+//
+//	if drop := price.PriceDrop(item.ItemUnitPriceAmount); drop > 0 {
+//	    fmt.Printf("%s dropped $%.2f since purchase\n", item.ItemNumber, drop)
+//	}
+func (p *ItemPrice) PriceDrop(paid float64) float64 {
+	if p.Price <= 0 || paid <= p.Price {
+		return 0
+	}
+	return math.Round((paid-p.Price)*100) / 100
+}
+
+// GetItemPrice retrieves the current online price, unit pricing, and member-only flag for an item.
+// The request is authenticated so member-only prices are included.
+//
+// Example:
+//
+//	price, err := client.GetItemPrice(ctx, "1529345")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("$%.2f ($%.4f/%s)\n", price.Price, price.UnitPrice, price.UnitOfMeasure)
+func (c *Client) GetItemPrice(ctx context.Context, itemNumber string) (*ItemPrice, error) {
+	if itemNumber == "" {
+		return nil, fmt.Errorf("item number is required")
+	}
+
+	c.getLogger().Info("fetching item price", slog.String("item_number", itemNumber))
+
+	params := url.Values{}
+	params.Set("itemNumber", itemNumber)
+	if c.config.WarehouseNumber != "" {
+		params.Set("warehouseNumber", c.config.WarehouseNumber)
+	}
+
+	var price ItemPrice
+	if err := c.executeREST(ctx, http.MethodGet, ItemPriceEndpoint, params, nil, true, &price); err != nil {
+		return nil, err
+	}
+
+	if price.ItemNumber == "" {
+		return nil, fmt.Errorf("no price found for item %s", itemNumber)
+	}
+	if price.RegularPrice == 0 {
+		price.RegularPrice = price.Price + price.InstantSavings
+	}
+	price.Currency = c.currency()
+
+	c.getLogger().Info("fetched item price",
+		slog.String("item_number", itemNumber),
+		slog.Float64("price", price.Price),
+		slog.Bool("member_only", price.MemberOnly))
+
+	return &price, nil
+}
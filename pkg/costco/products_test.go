@@ -0,0 +1,144 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchProducts(t *testing.T) {
+	client := newMockClient(t, Config{WarehouseNumber: "847"}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/apps/www_costco_com/query/www_costco_com_search", r.URL.Path)
+		assert.Equal(t, "paper towels", r.URL.Query().Get("q"))
+		assert.Equal(t, "24", r.URL.Query().Get("start"))
+		assert.Equal(t, "24", r.URL.Query().Get("rows"))
+		assert.Equal(t, "847", r.URL.Query().Get("whloc"))
+		assert.Empty(t, r.Header.Get(HeaderAuthorization), "search should be anonymous")
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"response": map[string]interface{}{
+				"numFound": 30,
+				"docs": []map[string]interface{}{
+					{
+						"item_number":                     "1529345",
+						"item_product_name":               "Kirkland Signature Paper Towels, 12-count",
+						"item_location_pricing_salePrice": 22.99,
+						"item_location_availability":      "in stock",
+						"item_product_url":                "https://www.costco.com/.product.1529345.html",
+						"item_product_img_url":            "https://images.costco-static.com/1529345.jpg",
+					},
+				},
+			},
+		})
+	})
+
+	results, err := client.SearchProducts(context.Background(), "paper towels", SearchOptions{Page: 2})
+	require.NoError(t, err)
+
+	assert.Equal(t, 30, results.TotalResults)
+	assert.Equal(t, 2, results.Page)
+	assert.Equal(t, 24, results.PageSize)
+	require.Len(t, results.Products, 1)
+
+	p := results.Products[0]
+	assert.Equal(t, "1529345", p.ItemNumber)
+	assert.Equal(t, "Kirkland Signature Paper Towels, 12-count", p.Name)
+	assert.Equal(t, 22.99, p.Price)
+	assert.True(t, p.InStock())
+	assert.Equal(t, "https://www.costco.com/.product.1529345.html", p.URL)
+}
+
+func TestSearchProducts_EmptyQuery(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("no request expected")
+	})
+
+	_, err := client.SearchProducts(context.Background(), "", SearchOptions{})
+	assert.Error(t, err)
+}
+
+func TestSearchProducts_HTTPError(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte("unavailable"))
+	})
+
+	_, err := client.SearchProducts(context.Background(), "1529345", SearchOptions{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "503")
+}
+
+func TestCheckAvailability(t *testing.T) {
+	client := newMockClient(t, Config{WarehouseNumber: "847"}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/AjaxGetInventoryDetail", r.URL.Path)
+		assert.Equal(t, "1529345", r.URL.Query().Get("itemNumber"))
+		assert.Equal(t, "847", r.URL.Query().Get("warehouseNumber"))
+		w.Write([]byte(`{"itemNumber":"1529345","inWarehouseStatus":"LIMITED","onlineStatus":"OUT_OF_STOCK"}`))
+	})
+
+	avail, err := client.CheckAvailability(context.Background(), "1529345", "")
+	require.NoError(t, err)
+
+	assert.Equal(t, "847", avail.WarehouseNumber)
+	assert.True(t, avail.InWarehouse())
+	assert.False(t, avail.Online())
+	assert.False(t, avail.CheckedAt.IsZero())
+}
+
+func TestCheckAvailability_NotSold(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	})
+
+	avail, err := client.CheckAvailability(context.Background(), "1529345", "1")
+	require.NoError(t, err)
+	assert.Equal(t, StockStatusNotSold, avail.InWarehouseStatus)
+	assert.Equal(t, StockStatusNotSold, avail.OnlineStatus)
+	assert.False(t, avail.InWarehouse())
+
+	_, err = client.CheckAvailability(context.Background(), "", "1")
+	assert.Error(t, err)
+}
+
+func TestGetItemPrice(t *testing.T) {
+	client := newMockClient(t, Config{Locale: LocaleEnUS}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/AjaxGetContractPrice", r.URL.Path)
+		assert.Equal(t, "1529345", r.URL.Query().Get("itemNumber"))
+		assert.NotEmpty(t, r.Header.Get(HeaderAuthorization), "member pricing requires auth")
+		w.Write([]byte(`{
+			"itemNumber": "1529345",
+			"price": 19.99,
+			"instantSavings": 4.00,
+			"unitPrice": 0.0833,
+			"unitOfMeasure": "sheet",
+			"memberOnly": true
+		}`))
+	})
+
+	price, err := client.GetItemPrice(context.Background(), "1529345")
+	require.NoError(t, err)
+
+	assert.Equal(t, 19.99, price.Price)
+	assert.Equal(t, 23.99, price.RegularPrice)
+	assert.Equal(t, "sheet", price.UnitOfMeasure)
+	assert.True(t, price.MemberOnly)
+	assert.Equal(t, CurrencyUSD, price.Currency)
+
+	assert.Equal(t, 3.0, price.PriceDrop(22.99))
+	assert.Zero(t, price.PriceDrop(19.99))
+	assert.Zero(t, price.PriceDrop(15.00))
+}
+
+func TestGetItemPrice_NotFound(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	})
+
+	_, err := client.GetItemPrice(context.Background(), "999")
+	assert.ErrorContains(t, err, "no price found")
+}
@@ -0,0 +1,134 @@
+package costco
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Named profiles for using several Costco accounts
+
+// DefaultProfile is the profile stored directly in ~/.costco.
+const DefaultProfile = "default"
+
+// profilesDir holds one directory per named profile, e.g. ~/.costco/profiles/spouse.
+const profilesDir = "profiles"
+
+var profileNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]{0,31}$`)
+
+// activeProfile is the profile config, tokens, and the local stores are read from;
+// "" is the default profile.
+var activeProfile string
+
+// UseProfile switches every config, token, and local store function (LoadConfig,
+// SaveTokens, the transaction store, ...) to the named profile. Each named profile
+// keeps its files in ~/.costco/profiles/<name>; DefaultProfile or "" selects
+// ~/.costco itself. The profile doesn't need to exist yet: SaveConfig creates it.
+//
+// The selection is process-wide, so call UseProfile before creating clients.
+//
+// Example:
+//
+//	if err := costco.UseProfile("spouse"); err != nil {
+//	    return err
+//	}
+//	config, err := costco.LoadConfig() // ~/.costco/profiles/spouse/config.json
+func UseProfile(name string) error {
+	if name == DefaultProfile {
+		name = ""
+	}
+	if name != "" && !profileNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid profile name %q: use up to 32 letters, digits, '-', or '_'", name)
+	}
+	activeProfile = name
+	return nil
+}
+
+// ActiveProfile returns the name of the profile selected with UseProfile.
+func ActiveProfile() string {
+	if activeProfile == "" {
+		return DefaultProfile
+	}
+	return activeProfile
+}
+
+// ListProfiles returns the profiles that have a saved config, DefaultProfile first
+// and the named profiles sorted.
+func ListProfiles() ([]string, error) {
+	base, err := getBaseConfigPath()
+	if err != nil {
+		return nil, err
+	}
+
+	var profiles []string
+	if _, err := os.Stat(filepath.Join(base, configFile)); err == nil {
+		profiles = append(profiles, DefaultProfile)
+	}
+
+	entries, err := os.ReadDir(filepath.Join(base, profilesDir))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	var named []string
+	for _, entry := range entries {
+		if !entry.IsDir() || !profileNamePattern.MatchString(entry.Name()) {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(base, profilesDir, entry.Name(), configFile)); err == nil {
+			named = append(named, entry.Name())
+		}
+	}
+	sort.Strings(named)
+	return append(profiles, named...), nil
+}
+
+// RemoveProfile deletes a named profile's config, tokens, and local stores, along with
+// any keychain secrets its config points to. The default profile can't be removed;
+// use ClearTokens to sign it out.
+func RemoveProfile(name string) error {
+	if name == "" || name == DefaultProfile {
+		return errors.New("the default profile can't be removed")
+	}
+	if !profileNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid profile name %q", name)
+	}
+	base, err := getBaseConfigPath()
+	if err != nil {
+		return err
+	}
+	dir := filepath.Join(base, profilesDir, name)
+
+	data, err := os.ReadFile(filepath.Join(dir, configFile))
+	if os.IsNotExist(err) {
+		return fmt.Errorf("profile %q not found", name)
+	}
+	if err != nil {
+		return err
+	}
+	var config StoredConfig
+	if err := json.Unmarshal(data, &config); err == nil {
+		if key, ok := strings.CutPrefix(config.Email, keychainRefPrefix); ok {
+			if store, err := newSecretStore(config.SecretBackend); err == nil && store != nil {
+				if err := store.Delete(key); err != nil {
+					return fmt.Errorf("removing keychain secret: %w", err)
+				}
+			}
+		}
+	}
+
+	return os.RemoveAll(dir)
+}
+
+// profileSecretKey scopes a keychain key to the active profile, so each account's
+// secrets are filed separately.
+func profileSecretKey(key string) string {
+	if activeProfile == "" {
+		return key
+	}
+	return activeProfile + "/" + key
+}
@@ -0,0 +1,93 @@
+package costco
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// useProfile selects a profile for the duration of the test.
+func useProfile(t *testing.T, name string) {
+	t.Helper()
+	require.NoError(t, UseProfile(name))
+	t.Cleanup(func() { activeProfile = "" })
+}
+
+func TestProfiles(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+	base, err := getBaseConfigPath()
+	require.NoError(t, err)
+
+	profiles, err := ListProfiles()
+	require.NoError(t, err)
+	assert.Empty(t, profiles)
+
+	require.NoError(t, SaveConfig(&StoredConfig{Email: "me@example.com", WarehouseNumber: "847"}))
+	require.NoError(t, SaveTokens(&StoredTokens{IDToken: "mine"}))
+
+	useProfile(t, "spouse")
+	assert.Equal(t, "spouse", ActiveProfile())
+	config, err := LoadConfig()
+	require.NoError(t, err)
+	assert.Nil(t, config, "new profile starts empty")
+
+	require.NoError(t, SaveConfig(&StoredConfig{Email: "spouse@example.com", WarehouseNumber: "1001"}))
+	require.NoError(t, SaveTokens(&StoredTokens{IDToken: "theirs"}))
+	assert.FileExists(t, filepath.Join(base, profilesDir, "spouse", configFile))
+
+	tokens, err := LoadTokens()
+	require.NoError(t, err)
+	assert.Equal(t, "theirs", tokens.IDToken)
+
+	require.NoError(t, UseProfile(DefaultProfile))
+	assert.Equal(t, DefaultProfile, ActiveProfile())
+	config, err = LoadConfig()
+	require.NoError(t, err)
+	assert.Equal(t, "me@example.com", config.Email)
+	tokens, err = LoadTokens()
+	require.NoError(t, err)
+	assert.Equal(t, "mine", tokens.IDToken)
+
+	// Directories without a config aren't profiles
+	require.NoError(t, os.MkdirAll(filepath.Join(base, profilesDir, "empty"), 0700))
+	profiles, err = ListProfiles()
+	require.NoError(t, err)
+	assert.Equal(t, []string{DefaultProfile, "spouse"}, profiles)
+
+	require.NoError(t, RemoveProfile("spouse"))
+	assert.NoDirExists(t, filepath.Join(base, profilesDir, "spouse"))
+	assert.EqualError(t, RemoveProfile("spouse"), `profile "spouse" not found`)
+	assert.Error(t, RemoveProfile(DefaultProfile))
+}
+
+func TestUseProfile_InvalidName(t *testing.T) {
+	for _, name := range []string{"../etc", "a/b", "-flag", ".hidden"} {
+		assert.Error(t, UseProfile(name), name)
+	}
+	assert.Equal(t, DefaultProfile, ActiveProfile())
+}
+
+func TestProfiles_KeychainSecretsAreScoped(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+	store := useMemorySecretStore(t)
+
+	require.NoError(t, SaveConfig(&StoredConfig{Email: "me@example.com", SecretBackend: SecretBackendKeychain}))
+	useProfile(t, "spouse")
+	require.NoError(t, SaveConfig(&StoredConfig{Email: "spouse@example.com", SecretBackend: SecretBackendKeychain}))
+
+	assert.Equal(t, "me@example.com", store["email"])
+	assert.Equal(t, "spouse@example.com", store["spouse/email"])
+
+	config, err := LoadConfig()
+	require.NoError(t, err)
+	assert.Equal(t, "spouse@example.com", config.Email)
+
+	require.NoError(t, RemoveProfile("spouse"))
+	assert.NotContains(t, store, "spouse/email")
+	assert.Contains(t, store, "email")
+}
@@ -18,6 +18,12 @@ const OnlineOrdersQuery = `query getOnlineOrders($startDate:String!, $endDate:St
 			orderCancelAllowed
 			orderPaymentFailed : orderPaymentEditAllowed
 			orderReturnAllowed
+			paymentSummary {
+				tenderType
+				cardType
+				lastFourDigits
+				amount
+			}
 			orderLineItems {
 				orderLineItemCancelAllowed
 				orderLineItemId
@@ -186,6 +192,14 @@ const ReceiptDetailQuery = `query receiptsWithCounts($barcode: String!,$document
 				walletId
 				storedValueBucket
 			}    
+			couponArray {
+				upcnumberCoupon
+				voidflagCoupon
+				refundflagCoupon
+				taxflagCoupon
+				amountCoupon
+				unitCoupon
+			}
 			subTaxes {      
 				tax1      
 				tax2      
@@ -223,7 +237,90 @@ const ReceiptDetailQuery = `query receiptsWithCounts($barcode: String!,$document
 	}
 }`
 
+// MembershipInfoQuery fetches the member's membership tier, dates, and household cardholders
+const MembershipInfoQuery = `query getMembershipInfo {
+	membershipInfo {
+		membershipNumber
+		membershipType
+		memberSinceDate
+		renewalDate
+		status
+		autoRenew
+		cardholders {
+			firstName
+			lastName
+			membershipNumber
+			cardholderType
+			isPrimary
+		}
+	}
+}`
+
+// ExecutiveRewardsQuery fetches the current Executive 2% reward accrual and past reward certificates
+const ExecutiveRewardsQuery = `query getExecutiveRewards {
+	executiveRewards {
+		membershipNumber
+		currentAccrual
+		eligibleSpend
+		periodStartDate
+		periodEndDate
+		certificates {
+			certificateNumber
+			amount
+			issueDate
+			expirationDate
+			periodStartDate
+			periodEndDate
+			status
+		}
+	}
+}`
+
+// CancelOrderMutation cancels an entire online order
+const CancelOrderMutation = `mutation cancelOrder($orderNumber: String!) {
+	cancelOrder(orderNumber: $orderNumber) {
+		success
+		orderNumber
+		status
+		errorCode
+		message
+	}
+}`
+
+// CancelOrderLineItemMutation cancels a single line item of an online order
+const CancelOrderLineItemMutation = `mutation cancelOrderLineItem($orderNumber: String!, $orderLineItemId: String!) {
+	cancelOrderLineItem(orderNumber: $orderNumber, orderLineItemId: $orderLineItemId) {
+		success
+		orderNumber
+		orderLineItemId
+		status
+		errorCode
+		message
+	}
+}`
+
+// ShipmentTrackingQuery fetches the latest carrier tracking events for a shipment
+const ShipmentTrackingQuery = `query getShipmentTracking($orderNumber: String!, $trackingNumber: String!) {
+	shipmentTracking(orderNumber: $orderNumber, trackingNumber: $trackingNumber) {
+		orderNumber
+		trackingNumber
+		carrierName
+		trackingSiteUrl
+		status
+		estimatedArrivalDate
+		deliveredDate
+		isDeliveryDelayed
+		trackingEvents {
+			event
+			carrierName
+			eventDate
+			estimatedDeliveryDate
+			scheduledDeliveryDate
+			trackingNumber
+		}
+	}
+}`
+
 // Future queries can be added here:
 // const ProductSearchQuery = `...`
-// const MembershipInfoQuery = `...`
 // const WarehouseLocationsQuery = `...`
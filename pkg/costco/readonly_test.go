@@ -0,0 +1,103 @@
+package costco
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadOnly_RefreshKeepsTokensInMemory(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
+	var logs bytes.Buffer
+	client := newMockClient(t, Config{ReadOnly: true, TokenRefreshBuffer: 5 * time.Minute}, func(w http.ResponseWriter, r *http.Request) {
+		resp := TokenResponse{
+			IDToken:               generateTestJWT(time.Now().Add(1 * time.Hour).Unix()),
+			RefreshToken:          "new-refresh-token",
+			RefreshTokenExpiresIn: 7776000,
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	})
+	client.logger = slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelWarn}))
+
+	require.NoError(t, client.refreshToken())
+	assert.Equal(t, "new-refresh-token", client.token.RefreshToken)
+
+	tokens, err := LoadTokens()
+	require.NoError(t, err)
+	assert.Nil(t, tokens, "read-only client must not write tokens to disk")
+	assert.Empty(t, logs.String(), "read-only mode should not log warnings")
+}
+
+func TestReadOnly_UsesConfiguredTokens(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
+	// A token file on disk is ignored when tokens are supplied in memory
+	require.NoError(t, SaveTokens(&StoredTokens{IDToken: "disk-id", RefreshToken: "disk-refresh"}))
+
+	expiry := time.Now().Add(1 * time.Hour)
+	client := NewClient(Config{
+		ReadOnly: true,
+		Tokens: &StoredTokens{
+			IDToken:      "memory-id",
+			RefreshToken: "memory-refresh",
+			TokenExpiry:  expiry,
+		},
+	})
+	require.NotNil(t, client.token)
+	assert.Equal(t, "memory-id", client.token.IDToken)
+	assert.Equal(t, "memory-refresh", client.token.RefreshToken)
+	assert.Equal(t, expiry, client.tokenExpiry)
+}
+
+func TestReadOnly_SkipsStaleTokenCleanup(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
+	configPath, err := getConfigPath()
+	require.NoError(t, err)
+	data, err := json.Marshal(&StoredTokens{
+		IDToken:               "stale-id",
+		RefreshToken:          "stale-refresh",
+		RefreshTokenExpiresAt: time.Now().Add(-30 * 24 * time.Hour),
+		UpdatedAt:             time.Now().Add(-120 * 24 * time.Hour),
+	})
+	require.NoError(t, err)
+	tokenPath := filepath.Join(configPath, tokenFile)
+	require.NoError(t, os.WriteFile(tokenPath, data, 0600))
+
+	NewClient(Config{ReadOnly: true})
+
+	_, err = os.Stat(tokenPath)
+	assert.NoError(t, err, "read-only client must not delete token files")
+}
+
+func TestReadOnly_ClearSessionLeavesDisk(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
+	require.NoError(t, SaveTokens(&StoredTokens{
+		IDToken:      "id",
+		RefreshToken: "refresh",
+		TokenExpiry:  time.Now().Add(1 * time.Hour),
+	}))
+
+	client := NewClient(Config{ReadOnly: true})
+	require.NoError(t, client.ClearSession())
+	assert.Nil(t, client.token)
+
+	tokens, err := LoadTokens()
+	require.NoError(t, err)
+	assert.NotNil(t, tokens, "read-only ClearSession must not delete the token file")
+}
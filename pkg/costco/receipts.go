@@ -1,6 +1,9 @@
 package costco
 
-import "strings"
+import (
+	"math"
+	"strings"
+)
 
 // Receipt-related types for Costco warehouse and online receipts
 
@@ -33,9 +36,11 @@ type Receipt struct {
 	SequenceNumber      interface{}   `json:"sequenceNumber"` // Can be string or number for fuel receipts
 	ItemArray           []ReceiptItem `json:"itemArray"`
 	TenderArray         []Tender      `json:"tenderArray"`
+	CouponArray         []Coupon      `json:"couponArray"`
 	SubTaxes            *SubTaxes     `json:"subTaxes"`
 	InstantSavings      float64       `json:"instantSavings"`
 	MembershipNumber    string        `json:"membershipNumber"`
+	Currency            string        `json:"currency,omitempty"` // Set by the client from the configured locale
 }
 
 // ReceiptItem represents a single line item on a receipt
@@ -204,6 +209,57 @@ func NetDiscounts(items []ReceiptItem) (netted []ReceiptItem, orphaned []Receipt
 	return
 }
 
+// IsCarWash reports whether the receipt is for a car wash.
+func (r *Receipt) IsCarWash() bool {
+	return r.ReceiptType == ReceiptTypeCarWash || r.DocumentType == DocumentTypeCarWash
+}
+
+// DetailDocumentType returns the document type to pass to GetReceiptDetail for this receipt.
+func (r *Receipt) DetailDocumentType() string {
+	switch {
+	case r.IsCarWash():
+		return DocumentTypeCarWash
+	case r.ReceiptType == ReceiptTypeGasStation || r.DocumentType == DocumentTypeFuel:
+		return DocumentTypeFuel
+	default:
+		return DocumentTypeWarehouse
+	}
+}
+
+// CarWash is the typed view of a car wash receipt
+type CarWash struct {
+	Package  string  // Wash package, e.g. "ULTIMATE WASH"
+	Quantity int     // Number of washes purchased
+	Amount   float64 // Amount paid for the washes
+}
+
+// CarWash returns the wash details of a car wash receipt, or nil for other receipts.
+// The receipt must come from GetReceiptDetail so its items are populated.
+//
+// Example:
+//
+//	if wash := receipt.CarWash(); wash != nil {
+//	    fmt.Printf("%s x%d: $%.2f\n", wash.Package, wash.Quantity, wash.Amount)
+//	}
+func (r *Receipt) CarWash() *CarWash {
+	if !r.IsCarWash() {
+		return nil
+	}
+	wash := &CarWash{}
+	for _, item := range r.ItemArray {
+		if item.IsDiscount() {
+			wash.Amount += item.Amount
+			continue
+		}
+		if wash.Package == "" {
+			wash.Package = item.ItemDescription01
+		}
+		wash.Quantity += item.Unit
+		wash.Amount += item.Amount
+	}
+	return wash
+}
+
 // Tender represents payment information on a receipt
 type Tender struct {
 	TenderTypeCode               string  `json:"tenderTypeCode"`
@@ -227,6 +283,34 @@ type Tender struct {
 	StoredValueBucket            string  `json:"storedValueBucket"`
 }
 
+// Coupon represents a manufacturer or instant-savings coupon redeemed on a receipt
+type Coupon struct {
+	UPCNumber  string  `json:"upcnumberCoupon"`
+	VoidFlag   string  `json:"voidflagCoupon"`   // "Y" when the coupon was voided
+	RefundFlag string  `json:"refundflagCoupon"` // "Y" when the coupon was refunded
+	TaxFlag    string  `json:"taxflagCoupon"`
+	Amount     float64 `json:"amountCoupon"` // Usually negative (a discount)
+	Unit       int     `json:"unitCoupon"`
+}
+
+// IsVoided reports whether the coupon was voided or refunded and should not count as savings.
+func (c *Coupon) IsVoided() bool {
+	return c.VoidFlag == "Y" || c.RefundFlag == "Y"
+}
+
+// CouponSavings returns the total savings from coupons on the receipt as a positive amount,
+// ignoring voided and refunded coupons.
+func (r *Receipt) CouponSavings() float64 {
+	var total float64
+	for _, coupon := range r.CouponArray {
+		if coupon.IsVoided() {
+			continue
+		}
+		total += math.Abs(coupon.Amount)
+	}
+	return math.Round(total*100) / 100
+}
+
 // SubTaxes represents detailed tax breakdown on a receipt
 type SubTaxes struct {
 	Tax1               float64 `json:"tax1"`
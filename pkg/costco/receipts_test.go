@@ -1,9 +1,11 @@
 package costco
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestReceiptItem_IsDiscount(t *testing.T) {
@@ -405,3 +407,56 @@ func TestNetDiscounts(t *testing.T) {
 		}
 	})
 }
+
+func TestReceipt_DecodesCoupons(t *testing.T) {
+	data := `{
+		"transactionBarcode": "21134300501862509051323",
+		"couponArray": [
+			{"upcnumberCoupon": "0000379938", "voidflagCoupon": "N", "refundflagCoupon": "N", "taxflagCoupon": "Y", "amountCoupon": -3.00, "unitCoupon": 1},
+			{"upcnumberCoupon": "0000412345", "voidflagCoupon": "N", "refundflagCoupon": "N", "amountCoupon": -1.50, "unitCoupon": 1},
+			{"upcnumberCoupon": "0000999999", "voidflagCoupon": "Y", "amountCoupon": -5.00, "unitCoupon": 1}
+		]
+	}`
+
+	var receipt Receipt
+	require.NoError(t, json.Unmarshal([]byte(data), &receipt))
+
+	require.Len(t, receipt.CouponArray, 3)
+	assert.Equal(t, "0000379938", receipt.CouponArray[0].UPCNumber)
+	assert.Equal(t, -3.00, receipt.CouponArray[0].Amount)
+	assert.Equal(t, 1, receipt.CouponArray[0].Unit)
+	assert.True(t, receipt.CouponArray[2].IsVoided())
+
+	assert.Equal(t, 4.50, receipt.CouponSavings(), "voided coupons are excluded")
+}
+
+func TestReceiptDetailQuery_RequestsCouponFields(t *testing.T) {
+	for _, field := range []string{"upcnumberCoupon", "voidflagCoupon", "refundflagCoupon", "taxflagCoupon", "amountCoupon", "unitCoupon"} {
+		assert.Contains(t, ReceiptDetailQuery, field)
+	}
+}
+
+func TestReceipt_DetailDocumentType(t *testing.T) {
+	assert.Equal(t, DocumentTypeWarehouse, (&Receipt{ReceiptType: ReceiptTypeWarehouse}).DetailDocumentType())
+	assert.Equal(t, DocumentTypeFuel, (&Receipt{ReceiptType: ReceiptTypeGasStation}).DetailDocumentType())
+	assert.Equal(t, DocumentTypeFuel, (&Receipt{DocumentType: DocumentTypeFuel}).DetailDocumentType())
+	assert.Equal(t, DocumentTypeCarWash, (&Receipt{ReceiptType: ReceiptTypeCarWash, DocumentType: DocumentTypeFuel}).DetailDocumentType())
+}
+
+func TestReceipt_CarWash(t *testing.T) {
+	receipt := Receipt{
+		ReceiptType: ReceiptTypeCarWash,
+		ItemArray: []ReceiptItem{
+			{ItemNumber: "9001", ItemDescription01: "ULTIMATE WASH", Unit: 2, Amount: 25.98},
+			{ItemNumber: "9002", ItemDescription01: "/9001", Unit: -1, Amount: -3.00},
+		},
+	}
+
+	wash := receipt.CarWash()
+	require.NotNil(t, wash)
+	assert.Equal(t, "ULTIMATE WASH", wash.Package)
+	assert.Equal(t, 2, wash.Quantity)
+	assert.InDelta(t, 22.98, wash.Amount, 0.001)
+
+	assert.Nil(t, (&Receipt{ReceiptType: ReceiptTypeWarehouse}).CarWash())
+}
@@ -0,0 +1,130 @@
+package costco
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+)
+
+// Returns and refunds
+
+// RefundsReport lists the returns in a date range, paired with the purchases they refund.
+// This is returned by GetRefundsReport.
+type RefundsReport struct {
+	Refunds []Refund // Chronological
+	Total   float64  // Total refunded, as a positive amount
+	Matched int      // Returned items paired with an original purchase
+	Orphans int      // Returned items whose original purchase wasn't found in the date range
+}
+
+// Refund is one return transaction.
+type Refund struct {
+	TransactionBarcode string
+	TransactionDate    time.Time
+	WarehouseName      string
+	Amount             float64 // Refunded amount, as a positive value
+	Items              []RefundedItem
+}
+
+// RefundedItem is a returned item and, when found, the purchase it came from.
+type RefundedItem struct {
+	ItemNumber         string
+	ItemDescription    string
+	Units              int     // Units returned, as a positive value
+	Amount             float64 // Refunded amount, as a positive value
+	OriginalBarcode    string  // Receipt the item was bought on ("" if not found)
+	OriginalDate       time.Time
+	OriginalUnitAmount float64 // Unit price paid on the original purchase
+}
+
+// GetRefundsReport finds the returns in a date range and pairs each returned item with
+// the most recent earlier purchase of the same item number. Widen startDate to catch
+// originals bought before the range; unpaired items are counted in Orphans.
+//
+// Refund amounts are already netted out of GetSpendingSummary and GetSpendingReport
+// totals; this report makes them visible.
+//
+// The startDate and endDate should be in YYYY-MM-DD format.
+//
+// Example:
+//
+//	report, err := client.GetRefundsReport(ctx, "2025-01-01", "2025-12-31")
+//	for _, r := range report.Refunds {
+//	    for _, item := range r.Items {
+//	        fmt.Printf("%s returned %s ($%.2f), bought %s\n", r.TransactionDate.Format("2006-01-02"),
+//	            item.ItemDescription, item.Amount, item.OriginalDate.Format("2006-01-02"))
+//	    }
+//	}
+func (c *Client) GetRefundsReport(ctx context.Context, startDate, endDate string) (*RefundsReport, error) {
+	transactions, err := c.GetAllTransactionItems(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(transactions, func(i, j int) bool {
+		return transactions[i].TransactionDate.Before(transactions[j].TransactionDate)
+	})
+
+	report := &RefundsReport{}
+
+	// Purchases seen so far, by item number; later purchases are appended last
+	type purchase struct {
+		tx   *TransactionWithItems
+		item ReceiptItem
+	}
+	purchases := make(map[string][]purchase)
+
+	for i := range transactions {
+		tx := &transactions[i]
+		if !tx.IsRefund() {
+			for _, item := range tx.Items {
+				if item.Unit > 0 && item.Amount > 0 {
+					purchases[item.ItemNumber] = append(purchases[item.ItemNumber], purchase{tx, item})
+				}
+			}
+			continue
+		}
+
+		refund := Refund{
+			TransactionBarcode: tx.TransactionBarcode,
+			TransactionDate:    tx.TransactionDate,
+			WarehouseName:      tx.WarehouseName,
+			Amount:             math.Abs(tx.Total),
+		}
+		for _, item := range tx.Items {
+			if item.Amount >= 0 || item.IsDiscount() {
+				continue
+			}
+			returned := RefundedItem{
+				ItemNumber:      item.ItemNumber,
+				ItemDescription: c.itemName(item),
+				Units:           abs(item.Unit),
+				Amount:          math.Abs(item.Amount),
+			}
+			if history := purchases[item.ItemNumber]; len(history) > 0 {
+				original := history[len(history)-1]
+				returned.OriginalBarcode = original.tx.TransactionBarcode
+				returned.OriginalDate = original.tx.TransactionDate
+				returned.OriginalUnitAmount = roundTo(original.item.Amount/float64(original.item.Unit), 2)
+				report.Matched++
+			} else {
+				report.Orphans++
+			}
+			refund.Items = append(refund.Items, returned)
+		}
+
+		report.Total += refund.Amount
+		report.Refunds = append(report.Refunds, refund)
+	}
+	report.Total = roundTo(report.Total, 2)
+
+	return report, nil
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,95 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func refundsMockClient(t *testing.T) *Client {
+	details := map[string]map[string]interface{}{
+		"A": {
+			"transactionBarcode": "A", "transactionDateTime": "2025-01-10T10:00:00",
+			"transactionType": "Sales", "total": 130.00,
+			"itemArray": []map[string]interface{}{
+				{"itemNumber": "1", "itemDescription01": "VACUUM", "unit": 1, "amount": 100.00},
+				{"itemNumber": "2", "itemDescription01": "EGGS", "unit": 2, "amount": 30.00},
+			},
+		},
+		"B": {
+			"transactionBarcode": "B", "transactionDateTime": "2025-01-20T10:00:00",
+			"transactionType": "Refund", "total": -112.00,
+			"itemArray": []map[string]interface{}{
+				{"itemNumber": "1", "itemDescription01": "VACUUM", "unit": -1, "amount": -100.00},
+				{"itemNumber": "9", "itemDescription01": "TV", "unit": -1, "amount": -12.00},
+			},
+		},
+	}
+
+	return newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if barcode, ok := req.Variables["barcode"].(string); ok {
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{details[barcode]}},
+			})
+			return
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{
+				"receipts": []map[string]interface{}{
+					{"transactionBarcode": "B"}, {"transactionBarcode": "A"},
+				},
+			},
+		})
+	})
+}
+
+func TestGetRefundsReport(t *testing.T) {
+	client := refundsMockClient(t)
+
+	report, err := client.GetRefundsReport(context.Background(), "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+	require.Len(t, report.Refunds, 1)
+	assert.Equal(t, 112.00, report.Total)
+	assert.Equal(t, 1, report.Matched)
+	assert.Equal(t, 1, report.Orphans)
+
+	refund := report.Refunds[0]
+	assert.Equal(t, "B", refund.TransactionBarcode)
+	require.Len(t, refund.Items, 2)
+
+	vacuum := refund.Items[0]
+	assert.Equal(t, "Vacuum", vacuum.ItemDescription)
+	assert.Equal(t, 1, vacuum.Units)
+	assert.Equal(t, 100.00, vacuum.Amount)
+	assert.Equal(t, "A", vacuum.OriginalBarcode)
+	assert.Equal(t, time.Date(2025, 1, 10, 10, 0, 0, 0, time.UTC), vacuum.OriginalDate)
+	assert.Equal(t, 100.00, vacuum.OriginalUnitAmount)
+
+	assert.Empty(t, refund.Items[1].OriginalBarcode, "TV was bought outside the range")
+}
+
+func TestSpendingReportNetsRefunds(t *testing.T) {
+	client := refundsMockClient(t)
+
+	report, err := client.GetSpendingReport(context.Background(), "2025-01-01", "2025-01-31", ReportPeriodMonth)
+	require.NoError(t, err)
+	assert.Equal(t, 18.00, report.Total.Total)
+	assert.Equal(t, 112.00, report.Total.Refunds)
+	assert.Equal(t, 1, report.Total.TripCount, "returns are not trips")
+
+	items, err := client.GetFrequentItems(context.Background(), "2025-01-01", "2025-01-31", 0)
+	require.NoError(t, err)
+	for _, item := range items {
+		if item.ItemNumber == "1" {
+			assert.Equal(t, 1, item.PurchaseCount)
+			assert.Equal(t, 0.00, item.TotalSpent)
+		}
+	}
+}
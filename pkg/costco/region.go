@@ -0,0 +1,107 @@
+package costco
+
+import "strings"
+
+// Region-specific receipt handling (US vs Canada)
+
+// Region identifies the country a receipt was issued in.
+type Region string
+
+// Supported regions
+const (
+	RegionUS Region = "US"
+	RegionCA Region = "CA"
+)
+
+// Canadian sales tax legends as printed in SubTaxes
+const (
+	TaxLegendGST = "GST" // Federal Goods and Services Tax
+	TaxLegendHST = "HST" // Harmonized Sales Tax (federal + provincial)
+	TaxLegendPST = "PST" // Provincial Sales Tax
+	TaxLegendQST = "QST" // Quebec Sales Tax
+)
+
+// Currency returns the currency receipts from the region are billed in, or "" for an unknown region.
+func (r Region) Currency() string {
+	switch r {
+	case RegionUS:
+		return CurrencyUSD
+	case RegionCA:
+		return CurrencyCAD
+	default:
+		return ""
+	}
+}
+
+// Region reports the country the receipt was issued in, based on the warehouse
+// country and, when that is missing, on Canadian tax legends in SubTaxes.
+// Returns "" when the region can't be determined (e.g. list results, which omit
+// the warehouse address).
+//
+// Example:
+//
+//	if receipt.Region() == costco.RegionCA {
+//	    fmt.Printf("GST: $%.2f\n", receipt.TaxByLegend(costco.TaxLegendGST))
+//	}
+func (r *Receipt) Region() Region {
+	switch strings.ToUpper(strings.TrimSpace(r.WarehouseCountry)) {
+	case "US", "USA":
+		return RegionUS
+	case "CA", "CAN", "CANADA":
+		return RegionCA
+	}
+	for _, tax := range r.TaxBreakdown() {
+		switch tax.Legend {
+		case TaxLegendGST, TaxLegendHST, TaxLegendPST, TaxLegendQST:
+			return RegionCA
+		}
+	}
+	return ""
+}
+
+// TaxLine is one tax charged on a receipt, taken from the lettered SubTaxes slots.
+type TaxLine struct {
+	Bucket    string  // SubTaxes slot: "A", "B", "C", "D", or "U"
+	Legend    string  // e.g. "GST", "PST", or a US state tax legend
+	Percent   float64 // Tax rate in percent
+	Amount    float64
+	PrintCode string // Code printed next to taxed items
+}
+
+// TaxBreakdown returns the taxes charged on the receipt, one line per populated
+// SubTaxes slot. On Canadian receipts this separates GST, HST, PST, and QST.
+func (r *Receipt) TaxBreakdown() []TaxLine {
+	t := r.SubTaxes
+	if t == nil {
+		return nil
+	}
+
+	slots := []TaxLine{
+		{Bucket: "A", Legend: t.ATaxLegend, Percent: t.ATaxPercent, Amount: t.ATaxAmount, PrintCode: t.ATaxPrintCode},
+		{Bucket: "B", Legend: t.BTaxLegend, Percent: t.BTaxPercent, Amount: t.BTaxAmount, PrintCode: t.BTaxPrintCode},
+		{Bucket: "C", Legend: t.CTaxLegend, Percent: t.CTaxPercent, Amount: t.CTaxAmount},
+		{Bucket: "D", Legend: t.DTaxLegend, Percent: t.DTaxPercent, Amount: t.DTaxAmount, PrintCode: t.DTaxPrintCode},
+		{Bucket: "U", Legend: t.UTaxLegend, Amount: t.UTaxAmount},
+	}
+
+	var lines []TaxLine
+	for _, line := range slots {
+		line.Legend = strings.ToUpper(strings.TrimSpace(line.Legend))
+		if line.Legend == "" && line.Amount == 0 {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// TaxByLegend returns the total tax charged under the given legend (e.g. TaxLegendGST).
+func (r *Receipt) TaxByLegend(legend string) float64 {
+	var total float64
+	for _, line := range r.TaxBreakdown() {
+		if line.Legend == strings.ToUpper(legend) {
+			total += line.Amount
+		}
+	}
+	return total
+}
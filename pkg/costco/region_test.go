@@ -0,0 +1,67 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testCanadianReceiptJSON mirrors a costco.ca receipt detail response (Ontario, HST)
+const testCanadianReceiptJSON = `{
+	"warehouseName": "TORONTO",
+	"warehouseCountry": "CA",
+	"warehouseState": "ON",
+	"transactionBarcode": "21134300501862509051399",
+	"subTotal": 20.00,
+	"taxes": 2.60,
+	"total": 22.60,
+	"itemArray": [
+		{"itemNumber": "1234", "itemDescription01": "ALM TORTILLA", "frenchItemDescription1": "TORTILLA AMANDE", "amount": 20.00, "unit": 1, "taxFlag": "Y"}
+	],
+	"subTaxes": {
+		"aTaxLegend": "HST",
+		"aTaxPercent": 13,
+		"aTaxAmount": 2.60,
+		"aTaxPrintCode": "Y"
+	}
+}`
+
+func TestReceiptRegion(t *testing.T) {
+	var ca Receipt
+	require.NoError(t, json.Unmarshal([]byte(testCanadianReceiptJSON), &ca))
+	assert.Equal(t, RegionCA, ca.Region())
+	assert.Equal(t, 2.60, ca.TaxByLegend(TaxLegendHST))
+	assert.Equal(t, 0.0, ca.TaxByLegend(TaxLegendGST))
+	assert.Equal(t, "TORTILLA AMANDE", ca.ItemArray[0].Description(LocaleFrCA))
+
+	// Quebec receipt without a warehouse country: GST + QST legends identify it
+	qc := Receipt{SubTaxes: &SubTaxes{
+		ATaxLegend: "GST", ATaxPercent: 5, ATaxAmount: 1.00,
+		BTaxLegend: "QST", BTaxPercent: 9.975, BTaxAmount: 2.00,
+	}}
+	assert.Equal(t, RegionCA, qc.Region())
+	require.Len(t, qc.TaxBreakdown(), 2)
+	assert.Equal(t, 2.00, qc.TaxByLegend("qst"))
+
+	assert.Equal(t, RegionUS, (&Receipt{WarehouseCountry: "US"}).Region())
+	assert.Equal(t, Region(""), (&Receipt{}).Region())
+	assert.Nil(t, (&Receipt{}).TaxBreakdown())
+}
+
+func TestGetReceiptDetail_CurrencyFromRegion(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{
+				"receipts": []json.RawMessage{json.RawMessage(testCanadianReceiptJSON)},
+			},
+		})
+	})
+
+	receipt, err := client.GetReceiptDetail(context.Background(), "21134300501862509051399", "warehouse")
+	require.NoError(t, err)
+	assert.Equal(t, CurrencyCAD, receipt.Currency)
+}
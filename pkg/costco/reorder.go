@@ -0,0 +1,125 @@
+package costco
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// Reorder prediction
+
+const (
+	// ReorderLookbackMonths is how much purchase history PredictReorders reads.
+	ReorderLookbackMonths = 12
+	// DefaultReorderHorizonDays is the look-ahead PredictReorders uses when none is given.
+	DefaultReorderHorizonDays = 14
+
+	reorderMinPurchases = 3 // Purchases on distinct days needed to estimate a cadence
+)
+
+// ReorderPrediction is a recurring item expected to run out soon.
+// This is returned by PredictReorders.
+type ReorderPrediction struct {
+	ItemNumber      string
+	ItemDescription string
+	Purchases       int       // Purchases (on distinct days) in the lookback window
+	LastPurchased   time.Time // Most recent purchase
+	IntervalDays    float64   // Average days between purchases
+	NextPurchase    time.Time // LastPurchased + IntervalDays
+	DaysUntil       int       // Days from today to NextPurchase (negative when overdue)
+	TypicalQuantity int       // Units bought on the most recent purchase
+}
+
+// PredictReorders estimates how often each recurring item is bought from the last
+// ReorderLookbackMonths of purchase history (the same per-item history GetItemHistory
+// returns) and lists the items expected to run out within withinDays, soonest first.
+// Overdue items are included with a negative DaysUntil. Items need at least three
+// purchases on different days to have a cadence. A withinDays of zero or less uses
+// DefaultReorderHorizonDays.
+//
+// Example:
+//
+//	predictions, err := client.PredictReorders(ctx, 7)
+//	for _, p := range predictions {
+//	    fmt.Printf("%s: every %.0f days, due %s\n",
+//	        p.ItemDescription, p.IntervalDays, p.NextPurchase.Format("Jan 2"))
+//	}
+func (c *Client) PredictReorders(ctx context.Context, withinDays int) ([]ReorderPrediction, error) {
+	if withinDays <= 0 {
+		withinDays = DefaultReorderHorizonDays
+	}
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	startDate := today.AddDate(0, -ReorderLookbackMonths, 0).Format("2006-01-02")
+
+	transactions, err := c.GetAllTransactionItems(ctx, startDate, today.Format("2006-01-02"))
+	if err != nil {
+		return nil, err
+	}
+
+	descriptions := make(map[string]string)
+	for _, tx := range transactions {
+		for _, item := range tx.Items {
+			if !item.IsDiscount() {
+				descriptions[item.ItemNumber] = c.itemName(item)
+			}
+		}
+	}
+
+	var predictions []ReorderPrediction
+	for itemNumber, history := range purchasesByItem(transactions) {
+		if itemNumber == "" {
+			continue
+		}
+
+		// One purchase per day, ignoring returns
+		var days []time.Time
+		quantities := make(map[time.Time]int)
+		for _, purchase := range history {
+			if purchase.Quantity <= 0 {
+				continue
+			}
+			day, err := time.Parse("2006-01-02", purchase.Date)
+			if err != nil {
+				continue
+			}
+			if _, seen := quantities[day]; !seen {
+				days = append(days, day)
+			}
+			quantities[day] += purchase.Quantity
+		}
+		if len(days) < reorderMinPurchases {
+			continue
+		}
+		sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+
+		first, last := days[0], days[len(days)-1]
+		interval := last.Sub(first).Hours() / 24 / float64(len(days)-1)
+		next := last.Add(time.Duration(interval * 24 * float64(time.Hour))).Truncate(24 * time.Hour)
+		daysUntil := daysBetween(today, next)
+		if daysUntil > withinDays {
+			continue
+		}
+
+		predictions = append(predictions, ReorderPrediction{
+			ItemNumber:      itemNumber,
+			ItemDescription: descriptions[itemNumber],
+			Purchases:       len(days),
+			LastPurchased:   last,
+			IntervalDays:    roundTo(interval, 1),
+			NextPurchase:    next,
+			DaysUntil:       daysUntil,
+			TypicalQuantity: quantities[last],
+		})
+	}
+
+	sort.Slice(predictions, func(i, j int) bool {
+		if !predictions[i].NextPurchase.Equal(predictions[j].NextPurchase) {
+			return predictions[i].NextPurchase.Before(predictions[j].NextPurchase)
+		}
+		return predictions[i].ItemNumber < predictions[j].ItemNumber
+	})
+
+	return predictions, nil
+}
@@ -0,0 +1,69 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPredictReorders(t *testing.T) {
+	daysAgo := func(n int) string {
+		return time.Now().AddDate(0, 0, -n).Format("2006-01-02") + "T10:00:00"
+	}
+	line := func(number, description string, units int) map[string]interface{} {
+		return map[string]interface{}{"itemNumber": number, "itemDescription01": description, "unit": units, "amount": 10.00}
+	}
+	details := map[string]map[string]interface{}{
+		"A": {"transactionBarcode": "A", "transactionDateTime": daysAgo(100),
+			"itemArray": []map[string]interface{}{line("2", "PAPER TOWELS", 1), line("3", "OLIVE OIL", 1)}},
+		"B": {"transactionBarcode": "B", "transactionDateTime": daysAgo(70),
+			"itemArray": []map[string]interface{}{line("2", "PAPER TOWELS", 1)}},
+		"C": {"transactionBarcode": "C", "transactionDateTime": daysAgo(40),
+			"itemArray": []map[string]interface{}{line("2", "PAPER TOWELS", 1), line("3", "OLIVE OIL", 1)}},
+		"D": {"transactionBarcode": "D", "transactionDateTime": daysAgo(30),
+			"itemArray": []map[string]interface{}{line("1", "MILK", 1), line("4", "BATTERIES", 1)}},
+		"E": {"transactionBarcode": "E", "transactionDateTime": daysAgo(20),
+			"itemArray": []map[string]interface{}{line("1", "MILK", 1)}},
+		"F": {"transactionBarcode": "F", "transactionDateTime": daysAgo(10),
+			"itemArray": []map[string]interface{}{line("1", "MILK", 2), line("4", "BATTERIES", 1)}},
+	}
+
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if barcode, ok := req.Variables["barcode"].(string); ok {
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{details[barcode]}},
+			})
+			return
+		}
+		var receipts []map[string]interface{}
+		for _, barcode := range []string{"F", "E", "D", "C", "B", "A"} {
+			receipts = append(receipts, map[string]interface{}{"transactionBarcode": barcode})
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{"receipts": receipts},
+		})
+	})
+
+	predictions, err := client.PredictReorders(context.Background(), 7)
+	require.NoError(t, err)
+	require.Len(t, predictions, 2, "olive oil and batteries have too few purchases")
+
+	towels := predictions[0]
+	assert.Equal(t, "Paper Towels", towels.ItemDescription)
+	assert.Equal(t, 30.0, towels.IntervalDays)
+	assert.Equal(t, -10, towels.DaysUntil, "overdue")
+
+	milk := predictions[1]
+	assert.Equal(t, "1", milk.ItemNumber)
+	assert.Equal(t, 3, milk.Purchases)
+	assert.Equal(t, 10.0, milk.IntervalDays)
+	assert.Equal(t, 0, milk.DaysUntil)
+	assert.Equal(t, 2, milk.TypicalQuantity)
+}
@@ -0,0 +1,265 @@
+package costco
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Spending reports grouped by day, week, month, quarter, or year
+
+// ReportPeriod selects how GetSpendingReport groups transactions.
+type ReportPeriod string
+
+// Supported report periods
+const (
+	ReportPeriodDay      ReportPeriod = "day"
+	ReportPeriodWeek     ReportPeriod = "week"     // ISO weeks, starting Monday
+	ReportPeriodBiweekly ReportPeriod = "biweekly" // Two weeks from FiscalCalendar.BiweeklyAnchor
+	ReportPeriodMonth    ReportPeriod = "month"
+	ReportPeriodQuarter  ReportPeriod = "quarter"
+	ReportPeriodYear     ReportPeriod = "year"
+)
+
+// spendingReportTopItems is how many top items each report period lists.
+const spendingReportTopItems = 5
+
+// Label returns the calendar period containing t, e.g. "2025-03-14", "2025-W11",
+// "2025-03", "2025-Q1", or "2025". See FiscalCalendar.Label for other calendars.
+func (p ReportPeriod) Label(t time.Time) string {
+	return FiscalCalendar{}.Label(p, t)
+}
+
+// Start returns the first day of the calendar period containing t, at midnight in t's location.
+func (p ReportPeriod) Start(t time.Time) time.Time {
+	return FiscalCalendar{}.Start(p, t)
+}
+
+// validate returns an error for an unsupported period.
+func (p ReportPeriod) validate() error {
+	switch p {
+	case ReportPeriodDay, ReportPeriodWeek, ReportPeriodBiweekly, ReportPeriodMonth, ReportPeriodQuarter, ReportPeriodYear:
+		return nil
+	default:
+		return fmt.Errorf("unknown report period %q", p)
+	}
+}
+
+// SpendingReport summarizes spending per period.
+// This is returned by GetSpendingReport.
+type SpendingReport struct {
+	Period  ReportPeriod
+	Periods []SpendingPeriod // Chronological
+	Total   SpendingPeriod   // Totals across the whole date range (Label is empty)
+}
+
+// SpendingPeriod holds the spending statistics for one day, week, month, quarter, or year.
+type SpendingPeriod struct {
+	Label          string         // e.g. "2025-03-14", "2025-W11", "2025-03", "2025-Q1", "2025"
+	Total          float64        // Amount spent, including tax, net of refunds
+	Tax            float64        // Tax paid
+	InstantSavings float64        // Instant savings reported on receipts
+	Refunds        float64        // Amount refunded for returns, as a positive value
+	TripCount      int            // Number of receipts and orders, excluding returns
+	AverageBasket  float64        // Total / TripCount
+	TopItems       []FrequentItem // Items with the highest spend, at most 5
+}
+
+// GetSpendingReport groups the transactions in a date range by day, week, month, quarter, or year
+// and reports totals, tax, instant savings, trip count, average basket size, and the
+// top items by spend for each period and for the whole range.
+//
+// The startDate and endDate should be in YYYY-MM-DD format.
+//
+// Example:
+//
+//	report, err := client.GetSpendingReport(ctx, "2025-01-01", "2025-12-31", costco.ReportPeriodQuarter)
+//	for _, p := range report.Periods {
+//	    fmt.Printf("%s: $%.2f over %d trips (avg $%.2f), saved $%.2f\n",
+//	        p.Label, p.Total, p.TripCount, p.AverageBasket, p.InstantSavings)
+//	}
+func (c *Client) GetSpendingReport(ctx context.Context, startDate, endDate string, period ReportPeriod) (*SpendingReport, error) {
+	if err := period.validate(); err != nil {
+		return nil, err
+	}
+
+	transactions, err := c.GetAllTransactionItems(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	periods := make(map[string]*spendingAccumulator)
+	total := newSpendingAccumulator("")
+
+	for _, tx := range transactions {
+		label := c.config.Calendar.Label(period, tx.TransactionDate)
+		acc, exists := periods[label]
+		if !exists {
+			acc = newSpendingAccumulator(label)
+			periods[label] = acc
+		}
+		tx.Items = c.analyticsItems(tx.Items)
+		acc.add(tx, c.itemName)
+		total.add(tx, c.itemName)
+	}
+
+	report := &SpendingReport{Period: period, Total: total.result()}
+	for _, acc := range periods {
+		report.Periods = append(report.Periods, acc.result())
+	}
+	sort.Slice(report.Periods, func(i, j int) bool {
+		return report.Periods[i].Label < report.Periods[j].Label
+	})
+
+	return report, nil
+}
+
+// spendingAccumulator collects one SpendingPeriod.
+type spendingAccumulator struct {
+	period SpendingPeriod
+	items  map[string]*FrequentItem
+}
+
+func newSpendingAccumulator(label string) *spendingAccumulator {
+	return &spendingAccumulator{
+		period: SpendingPeriod{Label: label},
+		items:  make(map[string]*FrequentItem),
+	}
+}
+
+func (a *spendingAccumulator) add(tx TransactionWithItems, itemName func(ReceiptItem) string) {
+	a.period.Total += tx.Total
+	a.period.Tax += tx.Taxes
+	a.period.InstantSavings += tx.InstantSavings
+	if tx.IsRefund() {
+		a.period.Refunds -= tx.Total
+	} else {
+		a.period.TripCount++
+	}
+
+	for _, item := range tx.Items {
+		if item.IsDiscount() {
+			continue
+		}
+		stats, exists := a.items[item.ItemNumber]
+		if !exists {
+			stats = &FrequentItem{
+				ItemNumber:      item.ItemNumber,
+				ItemDescription: itemName(item),
+			}
+			a.items[item.ItemNumber] = stats
+		}
+		stats.TotalQuantity += item.Unit
+		stats.TotalSpent += item.Amount
+		if item.Unit > 0 {
+			stats.PurchaseCount++
+		}
+	}
+}
+
+func (a *spendingAccumulator) result() SpendingPeriod {
+	p := a.period
+	p.Total = roundTo(p.Total, 2)
+	p.Tax = roundTo(p.Tax, 2)
+	p.InstantSavings = roundTo(p.InstantSavings, 2)
+	p.Refunds = roundTo(p.Refunds, 2)
+	if p.TripCount > 0 {
+		p.AverageBasket = roundTo(p.Total/float64(p.TripCount), 2)
+	}
+
+	top := make([]FrequentItem, 0, len(a.items))
+	for _, item := range a.items {
+		top = append(top, *item)
+	}
+	sort.Slice(top, func(i, j int) bool {
+		if top[i].TotalSpent != top[j].TotalSpent {
+			return top[i].TotalSpent > top[j].TotalSpent
+		}
+		return top[i].ItemNumber < top[j].ItemNumber
+	})
+	if len(top) > spendingReportTopItems {
+		top = top[:spendingReportTopItems]
+	}
+	p.TopItems = top
+
+	return p
+}
+
+// WarehouseSpending is the spending at one warehouse, gas station, or car wash.
+// This is returned by GetSpendingByWarehouse.
+type WarehouseSpending struct {
+	WarehouseNumber int
+	WarehouseName   string
+	WarehouseCity   string
+	DocumentType    string  // DocumentTypeWarehouse, DocumentTypeFuel, or DocumentTypeCarWash
+	TripCount       int     // Number of receipts, excluding returns
+	Total           float64 // Amount spent, net of refunds
+	AverageBasket   float64 // Total / TripCount
+	FirstVisit      time.Time
+	LastVisit       time.Time
+}
+
+// GetSpendingByWarehouse totals the receipts in a date range per warehouse, with
+// trip count and average basket size. A warehouse's gas station and car wash are
+// listed separately from the warehouse itself. Results are sorted by total, largest first.
+//
+// The startDate and endDate should be in YYYY-MM-DD format.
+//
+// Example:
+//
+//	locations, err := client.GetSpendingByWarehouse(ctx, "2025-01-01", "2025-12-31")
+//	for _, w := range locations {
+//	    fmt.Printf("%s (%s): $%.2f over %d trips, avg $%.2f\n",
+//	        w.WarehouseName, w.DocumentType, w.Total, w.TripCount, w.AverageBasket)
+//	}
+func (c *Client) GetSpendingByWarehouse(ctx context.Context, startDate, endDate string) ([]WarehouseSpending, error) {
+	transactions, err := c.GetAllTransactionItems(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	locations := make(map[string]*WarehouseSpending)
+	for _, tx := range transactions {
+		key := fmt.Sprintf("%d|%s|%s|%s", tx.WarehouseNumber, tx.WarehouseName, tx.DocumentType, tx.Source)
+		location, exists := locations[key]
+		if !exists {
+			location = &WarehouseSpending{
+				WarehouseNumber: tx.WarehouseNumber,
+				WarehouseName:   tx.WarehouseName,
+				WarehouseCity:   tx.WarehouseCity,
+				DocumentType:    tx.DocumentType,
+				FirstVisit:      tx.TransactionDate,
+				LastVisit:       tx.TransactionDate,
+			}
+			locations[key] = location
+		}
+		location.Total += tx.Total
+		if !tx.IsRefund() {
+			location.TripCount++
+		}
+		if tx.TransactionDate.Before(location.FirstVisit) {
+			location.FirstVisit = tx.TransactionDate
+		}
+		if tx.TransactionDate.After(location.LastVisit) {
+			location.LastVisit = tx.TransactionDate
+		}
+	}
+
+	result := make([]WarehouseSpending, 0, len(locations))
+	for _, location := range locations {
+		location.Total = roundTo(location.Total, 2)
+		if location.TripCount > 0 {
+			location.AverageBasket = roundTo(location.Total/float64(location.TripCount), 2)
+		}
+		result = append(result, *location)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Total != result[j].Total {
+			return result[i].Total > result[j].Total
+		}
+		return result[i].WarehouseName < result[j].WarehouseName
+	})
+
+	return result, nil
+}
@@ -0,0 +1,153 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportPeriodLabel(t *testing.T) {
+	date := time.Date(2025, 5, 20, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, "2025-05", ReportPeriodMonth.Label(date))
+	assert.Equal(t, "2025-Q2", ReportPeriodQuarter.Label(date))
+	assert.Equal(t, "2025", ReportPeriodYear.Label(date))
+}
+
+func TestGetSpendingReport(t *testing.T) {
+	details := map[string]map[string]interface{}{
+		"A": {
+			"transactionBarcode": "A", "transactionDateTime": "2025-01-10T10:00:00",
+			"total": 110.00, "taxes": 10.00, "instantSavings": 5.00,
+			"itemArray": []map[string]interface{}{
+				{"itemNumber": "1", "itemDescription01": "COFFEE", "unit": 1, "amount": 60.00},
+				{"itemNumber": "2", "itemDescription01": "EGGS", "unit": 1, "amount": 45.00},
+				{"itemNumber": "3", "itemDescription01": "/1", "unit": -1, "amount": -5.00},
+			},
+		},
+		"B": {
+			"transactionBarcode": "B", "transactionDateTime": "2025-02-10T10:00:00",
+			"total": 50.00, "taxes": 2.00,
+			"itemArray": []map[string]interface{}{
+				{"itemNumber": "2", "itemDescription01": "EGGS", "unit": 2, "amount": 48.00},
+			},
+		},
+		"C": {
+			"transactionBarcode": "C", "transactionDateTime": "2025-04-02T10:00:00",
+			"total": 20.00,
+			"itemArray": []map[string]interface{}{
+				{"itemNumber": "4", "itemDescription01": "BREAD", "unit": 1, "amount": 20.00},
+			},
+		},
+	}
+
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if barcode, ok := req.Variables["barcode"].(string); ok {
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{details[barcode]}},
+			})
+			return
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{
+				"receipts": []map[string]interface{}{
+					{"transactionBarcode": "A"}, {"transactionBarcode": "B"}, {"transactionBarcode": "C"},
+				},
+			},
+		})
+	})
+
+	report, err := client.GetSpendingReport(context.Background(), "2025-01-01", "2025-12-31", ReportPeriodQuarter)
+	require.NoError(t, err)
+	require.Len(t, report.Periods, 2)
+
+	q1 := report.Periods[0]
+	assert.Equal(t, "2025-Q1", q1.Label)
+	assert.Equal(t, 160.00, q1.Total)
+	assert.Equal(t, 12.00, q1.Tax)
+	assert.Equal(t, 5.00, q1.InstantSavings)
+	assert.Equal(t, 2, q1.TripCount)
+	assert.Equal(t, 80.00, q1.AverageBasket)
+	require.Len(t, q1.TopItems, 2, "discount lines are not items")
+	assert.Equal(t, "Eggs", q1.TopItems[0].ItemDescription)
+	assert.Equal(t, 93.00, q1.TopItems[0].TotalSpent)
+
+	assert.Equal(t, "2025-Q2", report.Periods[1].Label)
+	assert.Equal(t, 3, report.Total.TripCount)
+	assert.Equal(t, 180.00, report.Total.Total)
+
+	_, err = client.GetSpendingReport(context.Background(), "2025-01-01", "2025-12-31", "hourly")
+	assert.Error(t, err)
+}
+
+func TestGetSpendingByWarehouse(t *testing.T) {
+	details := map[string]map[string]interface{}{
+		"A": {"transactionBarcode": "A", "transactionDateTime": "2025-01-10T10:00:00", "total": 200.00,
+			"warehouseNumber": 847, "warehouseName": "ISSAQUAH", "warehouseCity": "ISSAQUAH"},
+		"B": {"transactionBarcode": "B", "transactionDateTime": "2025-02-10T10:00:00", "total": 100.00,
+			"warehouseNumber": 847, "warehouseName": "ISSAQUAH", "warehouseCity": "ISSAQUAH"},
+		"C": {"transactionBarcode": "C", "transactionDateTime": "2025-02-11T10:00:00", "total": 45.00,
+			"warehouseNumber": 847, "warehouseName": "ISSAQUAH", "receiptType": "Gas Station"},
+		"D": {"transactionBarcode": "D", "transactionDateTime": "2025-03-01T10:00:00", "total": 120.00,
+			"warehouseNumber": 1, "warehouseName": "SEATTLE"},
+	}
+
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if barcode, ok := req.Variables["barcode"].(string); ok {
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{details[barcode]}},
+			})
+			return
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{
+				"receipts": []map[string]interface{}{
+					{"transactionBarcode": "A"}, {"transactionBarcode": "B"},
+					{"transactionBarcode": "C", "receiptType": "Gas Station"}, {"transactionBarcode": "D"},
+				},
+			},
+		})
+	})
+
+	locations, err := client.GetSpendingByWarehouse(context.Background(), "2025-01-01", "2025-12-31")
+	require.NoError(t, err)
+	require.Len(t, locations, 3)
+
+	issaquah := locations[0]
+	assert.Equal(t, 847, issaquah.WarehouseNumber)
+	assert.Equal(t, DocumentTypeWarehouse, issaquah.DocumentType)
+	assert.Equal(t, 300.00, issaquah.Total)
+	assert.Equal(t, 2, issaquah.TripCount)
+	assert.Equal(t, 150.00, issaquah.AverageBasket)
+	assert.Equal(t, time.Date(2025, 1, 10, 10, 0, 0, 0, time.UTC), issaquah.FirstVisit)
+	assert.Equal(t, time.Date(2025, 2, 10, 10, 0, 0, 0, time.UTC), issaquah.LastVisit)
+
+	assert.Equal(t, "SEATTLE", locations[1].WarehouseName)
+
+	gas := locations[2]
+	assert.Equal(t, DocumentTypeFuel, gas.DocumentType)
+	assert.Equal(t, 45.00, gas.Total)
+}
+
+func TestReportPeriodStart(t *testing.T) {
+	date := time.Date(2025, 5, 14, 15, 30, 0, 0, time.UTC) // a Wednesday
+	assert.Equal(t, "2025-05-14", ReportPeriodDay.Label(date))
+	assert.Equal(t, "2025-W20", ReportPeriodWeek.Label(date))
+
+	assert.Equal(t, time.Date(2025, 5, 14, 0, 0, 0, 0, time.UTC), ReportPeriodDay.Start(date))
+	assert.Equal(t, time.Date(2025, 5, 12, 0, 0, 0, 0, time.UTC), ReportPeriodWeek.Start(date))
+	assert.Equal(t, time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC), ReportPeriodMonth.Start(date))
+	assert.Equal(t, time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC), ReportPeriodQuarter.Start(date))
+	assert.Equal(t, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), ReportPeriodYear.Start(date))
+
+	sunday := time.Date(2025, 5, 18, 9, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2025, 5, 12, 0, 0, 0, 0, time.UTC), ReportPeriodWeek.Start(sunday))
+}
@@ -0,0 +1,120 @@
+package costco
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+// executeREST sends a request to one of Costco's JSON REST services and decodes
+// the response body into result. When authenticated is true the request carries
+// the same token headers as GraphQL requests; public services (search, warehouse
+// locator) are called anonymously so they work without imported tokens.
+// A *[]byte result receives the raw response body instead, for binary downloads.
+func (c *Client) executeREST(ctx context.Context, method, endpoint string, params url.Values, body interface{}, authenticated bool, result interface{}) error {
+	if c.configErr != nil {
+		return c.configErr
+	}
+
+	if authenticated {
+		if err := c.refreshTokenIfNeeded(); err != nil {
+			return fmt.Errorf("token refresh failed: %w", err)
+		}
+	}
+
+	if len(params) > 0 {
+		endpoint += "?" + params.Encode()
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			c.getLogger().Error("failed to marshal rest request", slog.String("error", err.Error()))
+			return fmt.Errorf("marshaling request: %w", err)
+		}
+		reqBody = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reqBody)
+	if err != nil {
+		c.getLogger().Error("failed to create rest request", slog.String("error", err.Error()))
+		return fmt.Errorf("creating request: %w", err)
+	}
+
+	if _, raw := result.(*[]byte); raw {
+		req.Header.Set("Accept", "*/*")
+	} else {
+		req.Header.Set("Accept", "application/json")
+	}
+	req.Header.Set("Accept-Language", c.acceptLanguage())
+	req.Header.Set("Origin", "https://www.costco.com")
+	req.Header.Set("Referer", "https://www.costco.com/")
+	req.Header.Set("User-Agent", HeaderUserAgent)
+	if body != nil {
+		req.Header.Set(HeaderContentType, "application/json")
+	}
+	if authenticated {
+		c.mu.RLock()
+		token := c.token.IDToken
+		c.mu.RUnlock()
+
+		req.Header.Set(HeaderClientIdentifier, ClientIdentifier)
+		req.Header.Set(HeaderAuthorization, "Bearer "+token)
+		req.Header.Set(HeaderWCSClientID, WCSClientID)
+	}
+
+	c.getLogger().Debug("sending rest request", slog.String("endpoint", endpoint), slog.String("method", method))
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		c.getLogger().Error("rest request failed", slog.String("error", err.Error()))
+		return fmt.Errorf("executing request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	c.getLogger().Debug("rest response received", slog.Int("status_code", resp.StatusCode))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		respBody, _ := io.ReadAll(resp.Body)
+		c.getLogger().Error("rest request failed", slog.Int("status_code", resp.StatusCode))
+		return fmt.Errorf("request failed with status %d: %s", resp.StatusCode, string(respBody))
+	}
+
+	if result == nil {
+		return nil
+	}
+	if raw, ok := result.(*[]byte); ok {
+		data, err := io.ReadAll(resp.Body)
+		if err != nil {
+			c.getLogger().Debug("failed to read rest response", slog.String("error", err.Error()))
+			return fmt.Errorf("reading response: %w", err)
+		}
+		*raw = data
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		c.getLogger().Debug("failed to decode rest response", slog.String("error", err.Error()))
+		return fmt.Errorf("decoding response: %w", err)
+	}
+
+	return nil
+}
+
+// DoJSON sends an authenticated JSON request to a Costco REST service and decodes
+// the response into result (which may be nil). It refreshes the token as needed and
+// is intended for service packages built on top of the client, such as travel.
+//
+// Example:
+//
+//	var result struct {
+//	    Bookings []travel.Booking `json:"bookings"`
+//	}
+//	err := client.DoJSON(ctx, http.MethodGet, travel.DefaultBaseURL+"/bookings", nil, nil, &result)
+func (c *Client) DoJSON(ctx context.Context, method, endpoint string, params url.Values, body, result interface{}) error {
+	return c.executeREST(ctx, method, endpoint, params, body, true, result)
+}
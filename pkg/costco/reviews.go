@@ -0,0 +1,91 @@
+package costco
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Item reviews and ratings
+
+// reviewsPageSize is the number of reviews returned per page by GetItemReviews
+const reviewsPageSize = 10
+
+// ItemReviews represents an item's ratings summary and a page of reviews
+type ItemReviews struct {
+	ItemNumber         string      `json:"itemNumber"`
+	AverageRating      float64     `json:"averageRating"` // 1-5 stars, 0 when there are no reviews
+	ReviewCount        int         `json:"reviewCount"`
+	RecommendedPercent int         `json:"recommendedPercent"` // Share of reviewers who would recommend the item
+	RatingDistribution map[int]int `json:"ratingDistribution"` // Star rating -> number of reviews
+	Page               int         `json:"page"`
+	TotalPages         int         `json:"totalPages"`
+	Reviews            []Review    `json:"reviews"`
+}
+
+// Review represents a single member review
+type Review struct {
+	ReviewID     string `json:"reviewId"`
+	Rating       int    `json:"rating"` // 1-5 stars
+	Title        string `json:"title"`
+	Text         string `json:"text"`
+	Author       string `json:"author"`
+	SubmittedAt  string `json:"submittedAt"`
+	Recommended  bool   `json:"isRecommended"`
+	HelpfulVotes int    `json:"helpfulVotes"`
+}
+
+// GetItemReviews retrieves an item's ratings summary and a page of its most recent reviews.
+// Pages are 1-based; page 0 is treated as 1.
+// The reviews service is public, so this works without imported tokens.
+//
+// Example:
+//
+//	frequent, _ := client.GetFrequentItems(ctx, start, end, 10)
+//	for _, item := range frequent {
+//	    reviews, err := client.GetItemReviews(ctx, item.ItemNumber, 1)
+//	    if err != nil {
+//	        continue
+//	    }
+//	    fmt.Printf("%s: %.1f stars (%d reviews)\n", item.ItemDescription, reviews.AverageRating, reviews.ReviewCount)
+//	}
+func (c *Client) GetItemReviews(ctx context.Context, itemNumber string, page int) (*ItemReviews, error) {
+	if itemNumber == "" {
+		return nil, fmt.Errorf("item number is required")
+	}
+	if page < 1 {
+		page = 1
+	}
+
+	c.getLogger().Info("fetching item reviews",
+		slog.String("item_number", itemNumber),
+		slog.Int("page", page))
+
+	params := url.Values{}
+	params.Set("itemNumber", itemNumber)
+	params.Set("page", strconv.Itoa(page))
+	params.Set("pageSize", strconv.Itoa(reviewsPageSize))
+	params.Set("sort", "submissionTime:desc")
+
+	var reviews ItemReviews
+	if err := c.executeREST(ctx, http.MethodGet, ReviewsEndpoint, params, nil, false, &reviews); err != nil {
+		return nil, err
+	}
+
+	if reviews.ItemNumber == "" {
+		reviews.ItemNumber = itemNumber
+	}
+	if reviews.Page == 0 {
+		reviews.Page = page
+	}
+
+	c.getLogger().Info("fetched item reviews",
+		slog.String("item_number", itemNumber),
+		slog.Float64("average_rating", reviews.AverageRating),
+		slog.Int("review_count", reviews.ReviewCount))
+
+	return &reviews, nil
+}
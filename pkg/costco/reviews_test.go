@@ -0,0 +1,53 @@
+package costco
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetItemReviews(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/AjaxGetItemReviews", r.URL.Path)
+		assert.Equal(t, "1529345", r.URL.Query().Get("itemNumber"))
+		assert.Equal(t, "2", r.URL.Query().Get("page"))
+		w.Write([]byte(`{
+			"averageRating": 4.6,
+			"reviewCount": 1203,
+			"recommendedPercent": 94,
+			"ratingDistribution": {"5": 900, "4": 200, "3": 50, "2": 23, "1": 30},
+			"totalPages": 121,
+			"reviews": [
+				{"reviewId": "r1", "rating": 5, "title": "Great value", "isRecommended": true, "helpfulVotes": 12}
+			]
+		}`))
+	})
+
+	reviews, err := client.GetItemReviews(context.Background(), "1529345", 2)
+	require.NoError(t, err)
+
+	assert.Equal(t, "1529345", reviews.ItemNumber)
+	assert.Equal(t, 2, reviews.Page)
+	assert.Equal(t, 4.6, reviews.AverageRating)
+	assert.Equal(t, 1203, reviews.ReviewCount)
+	assert.Equal(t, 900, reviews.RatingDistribution[5])
+	require.Len(t, reviews.Reviews, 1)
+	assert.True(t, reviews.Reviews[0].Recommended)
+}
+
+func TestGetItemReviews_Defaults(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "1", r.URL.Query().Get("page"))
+		w.Write([]byte(`{}`))
+	})
+
+	reviews, err := client.GetItemReviews(context.Background(), "1529345", 0)
+	require.NoError(t, err)
+	assert.Zero(t, reviews.ReviewCount)
+
+	_, err = client.GetItemReviews(context.Background(), "", 1)
+	assert.Error(t, err)
+}
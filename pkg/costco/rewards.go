@@ -0,0 +1,204 @@
+package costco
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+)
+
+// Executive membership 2% reward
+
+// Executive reward terms used by ExpectedExecutiveReward
+const (
+	ExecutiveRewardRate      = 0.02   // 2% of eligible purchases
+	ExecutiveRewardAnnualCap = 1250.0 // Maximum reward per membership year
+	ExecutiveUpgradeCost     = 65.0   // Executive fee ($130) minus the Gold Star fee ($65), US pricing
+)
+
+// ExecutiveRewards represents the Executive 2% reward accrual and certificate history
+type ExecutiveRewards struct {
+	MembershipNumber string              `json:"membershipNumber"`
+	CurrentAccrual   float64             `json:"currentAccrual"`  // Reward earned so far this membership year
+	EligibleSpend    float64             `json:"eligibleSpend"`   // Purchases counted toward the reward this year
+	PeriodStartDate  string              `json:"periodStartDate"` // YYYY-MM-DD
+	PeriodEndDate    string              `json:"periodEndDate"`   // YYYY-MM-DD
+	Certificates     []RewardCertificate `json:"certificates"`
+}
+
+// RewardCertificate represents an annual 2% reward certificate issued at renewal
+type RewardCertificate struct {
+	CertificateNumber string  `json:"certificateNumber"`
+	Amount            float64 `json:"amount"`
+	IssueDate         string  `json:"issueDate"`
+	ExpirationDate    string  `json:"expirationDate"`
+	PeriodStartDate   string  `json:"periodStartDate"`
+	PeriodEndDate     string  `json:"periodEndDate"`
+	Status            string  `json:"status"` // e.g. "Issued", "Redeemed", "Expired"
+}
+
+// TotalIssued returns the sum of all certificates issued.
+func (r *ExecutiveRewards) TotalIssued() float64 {
+	var total float64
+	for _, cert := range r.Certificates {
+		total += cert.Amount
+	}
+	return math.Round(total*100) / 100
+}
+
+// ExpectedExecutiveReward returns the reward expected for the given eligible spend:
+// 2% rounded to the cent and capped at ExecutiveRewardAnnualCap.
+// Compare it against ExecutiveRewards.CurrentAccrual to reconcile computed spending.
+//
+// Example:
+//
+//	summary, _ := client.GetSpendingSummary(ctx, start, end)
+//	var spend float64
+//	for _, dept := range summary {
+//	    spend += dept.Total
+//	}
+//	fmt.Printf("expected $%.2f, reported $%.2f\n",
+//	    costco.ExpectedExecutiveReward(spend), rewards.CurrentAccrual)
+func ExpectedExecutiveReward(eligibleSpend float64) float64 {
+	if eligibleSpend <= 0 {
+		return 0
+	}
+	reward := math.Round(eligibleSpend*ExecutiveRewardRate*100) / 100
+	return math.Min(reward, ExecutiveRewardAnnualCap)
+}
+
+// GetExecutiveRewards retrieves the current 2% reward accrual and the annual certificate history.
+// Returns an error for non-Executive memberships, which have no reward data.
+//
+// Example:
+//
+//	rewards, err := client.GetExecutiveRewards(ctx)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("Accrued $%.2f on $%.2f eligible spend\n", rewards.CurrentAccrual, rewards.EligibleSpend)
+//	for _, cert := range rewards.Certificates {
+//	    fmt.Printf("%s: $%.2f (%s)\n", cert.IssueDate, cert.Amount, cert.Status)
+//	}
+func (c *Client) GetExecutiveRewards(ctx context.Context) (*ExecutiveRewards, error) {
+	c.getLogger().Info("fetching executive rewards")
+
+	c.getLogger().Debug("executing graphql query", slog.String("operation", "getExecutiveRewards"))
+
+	var result struct {
+		ExecutiveRewards *ExecutiveRewards `json:"executiveRewards"`
+	}
+
+	if err := c.executeGraphQL(ctx, ExecutiveRewardsQuery, map[string]interface{}{}, &result); err != nil {
+		return nil, err
+	}
+
+	if result.ExecutiveRewards == nil {
+		return nil, fmt.Errorf("no executive rewards returned (is this an Executive membership?)")
+	}
+
+	c.getLogger().Info("fetched executive rewards",
+		slog.Float64("current_accrual", result.ExecutiveRewards.CurrentAccrual),
+		slog.Int("certificate_count", len(result.ExecutiveRewards.Certificates)))
+
+	return result.ExecutiveRewards, nil
+}
+
+// ExecutiveValue compares the 2% reward earned on past spending with the cost of
+// upgrading to an Executive membership. This is returned by GetExecutiveValue.
+type ExecutiveValue struct {
+	Years          []ExecutiveYear // Most recent year first
+	AverageReward  float64         // Average reward per year
+	UpgradeCost    float64         // ExecutiveUpgradeCost
+	NetValue       float64         // AverageReward - UpgradeCost
+	PaysForItself  bool            // NetValue >= 0
+	BreakEvenSpend float64         // Yearly eligible spend at which the reward covers the upgrade
+}
+
+// ExecutiveYear is the eligible spend and reward for one 12-month period.
+type ExecutiveYear struct {
+	StartDate     string  // YYYY-MM-DD
+	EndDate       string  // YYYY-MM-DD
+	EligibleSpend float64 // Warehouse receipts before tax, plus online orders
+	Reward        float64 // ExpectedExecutiveReward(EligibleSpend)
+}
+
+// GetExecutiveValue computes the 2% reward the last yearsBack years of spending
+// would have earned and whether that covers the Executive upgrade cost. Eligible
+// spend is warehouse receipts net of tax and returns, plus online order totals;
+// gas station receipts don't earn the reward and are excluded.
+//
+// Example:
+//
+//	value, err := client.GetExecutiveValue(ctx, 2)
+//	if value.PaysForItself {
+//	    fmt.Printf("Upgrade: earns $%.2f/yr net\n", value.NetValue)
+//	} else {
+//	    fmt.Printf("Skip it: need $%.2f/yr eligible spend\n", value.BreakEvenSpend)
+//	}
+func (c *Client) GetExecutiveValue(ctx context.Context, yearsBack int) (*ExecutiveValue, error) {
+	if yearsBack <= 0 {
+		return nil, fmt.Errorf("years back must be positive")
+	}
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	value := &ExecutiveValue{
+		UpgradeCost:    ExecutiveUpgradeCost,
+		BreakEvenSpend: math.Round(ExecutiveUpgradeCost/ExecutiveRewardRate*100) / 100,
+	}
+	for i := 0; i < yearsBack; i++ {
+		end := today.AddDate(-i, 0, 0)
+		value.Years = append(value.Years, ExecutiveYear{
+			StartDate: end.AddDate(-1, 0, 1).Format("2006-01-02"),
+			EndDate:   end.Format("2006-01-02"),
+		})
+	}
+	startDate := value.Years[yearsBack-1].StartDate
+	endDate := value.Years[0].EndDate
+
+	transactions, err := c.GetAllTransactionItems(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	orders, err := c.getAllOnlineOrders(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Dates compare as YYYY-MM-DD strings
+	addSpend := func(date string, amount float64) {
+		for i := range value.Years {
+			if date >= value.Years[i].StartDate && date <= value.Years[i].EndDate {
+				value.Years[i].EligibleSpend += amount
+				return
+			}
+		}
+	}
+	for _, tx := range transactions {
+		if tx.DocumentType == DocumentTypeFuel {
+			continue
+		}
+		addSpend(tx.TransactionDate.Format("2006-01-02"), tx.Total-tx.Taxes)
+	}
+	for _, order := range orders {
+		if len(order.OrderPlacedDate) >= 10 {
+			addSpend(order.OrderPlacedDate[:10], order.OrderTotal)
+		}
+	}
+
+	var rewards float64
+	for i := range value.Years {
+		year := &value.Years[i]
+		year.EligibleSpend = math.Round(year.EligibleSpend*100) / 100
+		year.Reward = ExpectedExecutiveReward(year.EligibleSpend)
+		rewards += year.Reward
+	}
+	value.AverageReward = math.Round(rewards/float64(yearsBack)*100) / 100
+	value.NetValue = math.Round((value.AverageReward-value.UpgradeCost)*100) / 100
+	value.PaysForItself = value.NetValue >= 0
+
+	return value, nil
+}
@@ -0,0 +1,122 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetExecutiveRewards(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		writeGraphQLData(w, map[string]interface{}{
+			"executiveRewards": map[string]interface{}{
+				"membershipNumber": "111222333",
+				"currentAccrual":   84.12,
+				"eligibleSpend":    4206.0,
+				"periodStartDate":  "2026-04-01",
+				"periodEndDate":    "2027-03-31",
+				"certificates": []map[string]interface{}{
+					{"certificateNumber": "C1", "amount": 151.20, "issueDate": "2026-04-01", "status": "Redeemed"},
+					{"certificateNumber": "C2", "amount": 132.45, "issueDate": "2025-04-01", "status": "Redeemed"},
+				},
+			},
+		})
+	})
+
+	rewards, err := client.GetExecutiveRewards(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 84.12, rewards.CurrentAccrual)
+	assert.Equal(t, 4206.0, rewards.EligibleSpend)
+	assert.Equal(t, "2027-03-31", rewards.PeriodEndDate)
+	require.Len(t, rewards.Certificates, 2)
+	assert.Equal(t, "Redeemed", rewards.Certificates[0].Status)
+	assert.Equal(t, 283.65, rewards.TotalIssued())
+}
+
+func TestGetExecutiveRewards_NotExecutive(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		writeGraphQLData(w, map[string]interface{}{"executiveRewards": nil})
+	})
+
+	_, err := client.GetExecutiveRewards(context.Background())
+	assert.ErrorContains(t, err, "Executive membership")
+}
+
+func TestExpectedExecutiveReward(t *testing.T) {
+	tests := []struct {
+		spend    float64
+		expected float64
+	}{
+		{0, 0},
+		{-10, 0},
+		{4206, 84.12},
+		{100.555, 2.01},
+		{100000, ExecutiveRewardAnnualCap},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, ExpectedExecutiveReward(tt.spend), "spend %.3f", tt.spend)
+	}
+}
+
+func TestGetExecutiveValue(t *testing.T) {
+	day := func(yearsAgo, daysAgo int) string {
+		return time.Now().AddDate(-yearsAgo, 0, -daysAgo).Format("2006-01-02")
+	}
+	details := map[string]map[string]interface{}{
+		"W1": {"transactionBarcode": "W1", "transactionDateTime": day(0, 10) + "T10:00:00", "total": 3108.00, "taxes": 108.00},
+		"G1": {"transactionBarcode": "G1", "transactionDateTime": day(0, 12) + "T10:00:00", "total": 900.00},
+		"W2": {"transactionBarcode": "W2", "transactionDateTime": day(1, 10) + "T10:00:00", "total": 2000.00},
+	}
+
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		switch {
+		case req.Query == OnlineOrdersQuery:
+			writeGraphQLData(w, map[string]interface{}{
+				"getOnlineOrders": []interface{}{map[string]interface{}{
+					"pageNumber": 1, "totalNumberOfRecords": 1,
+					"bcOrders": []map[string]interface{}{
+						{"orderNumber": "O1", "orderPlacedDate": day(0, 30) + "T08:00:00", "orderTotal": 1000.00},
+					},
+				}},
+			})
+		case req.Variables["barcode"] != nil:
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{details[req.Variables["barcode"].(string)]}},
+			})
+		default:
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{
+					"receipts": []map[string]interface{}{
+						{"transactionBarcode": "W1"},
+						{"transactionBarcode": "G1", "receiptType": "Gas Station"},
+						{"transactionBarcode": "W2"},
+					},
+				},
+			})
+		}
+	})
+
+	value, err := client.GetExecutiveValue(context.Background(), 2)
+	require.NoError(t, err)
+	require.Len(t, value.Years, 2)
+
+	assert.Equal(t, 4000.00, value.Years[0].EligibleSpend, "tax and gas excluded, online orders included")
+	assert.Equal(t, 80.00, value.Years[0].Reward)
+	assert.Equal(t, 40.00, value.Years[1].Reward)
+	assert.Equal(t, 60.00, value.AverageReward)
+	assert.Equal(t, -5.00, value.NetValue)
+	assert.False(t, value.PaysForItself)
+	assert.Equal(t, 3250.00, value.BreakEvenSpend)
+
+	_, err = client.GetExecutiveValue(context.Background(), 0)
+	assert.Error(t, err)
+}
@@ -0,0 +1,156 @@
+package costco
+
+import (
+	"context"
+	"math"
+	"sort"
+)
+
+// Savings from instant savings, discount lines, and coupons
+
+// SavingsSummary totals what instant savings and coupons saved over a date range.
+// This is returned by GetSavingsSummary. All amounts are positive.
+type SavingsSummary struct {
+	Savings
+	ByMonth []MonthlySavings // Chronological
+	ByItem  []ItemSavings    // Largest savings first
+}
+
+// Savings breaks down the savings for a date range or month.
+type Savings struct {
+	InstantSavings float64 // Instant savings reported in the receipt totals
+	ItemDiscounts  float64 // Sum of the "/parent" discount lines on receipts
+	CouponSavings  float64 // Coupon redemptions, excluding voided and refunded coupons
+	Total          float64 // Per receipt the larger of InstantSavings and ItemDiscounts, plus coupons
+}
+
+// MonthlySavings is the savings for one month.
+type MonthlySavings struct {
+	Month string // YYYY-MM
+	Savings
+}
+
+// ItemSavings is the discount-line savings on a single item.
+type ItemSavings struct {
+	ItemNumber      string
+	ItemDescription string
+	Savings         float64 // Total discount applied to the item
+	DiscountCount   int     // Number of purchases that were discounted
+}
+
+// GetSavingsSummary totals instant savings, per-item discount lines (see IsDiscount),
+// and coupon redemptions, overall, by month, and by item.
+//
+// Receipts usually report their discount lines again in InstantSavings, so Total
+// counts the larger of the two per receipt rather than adding them.
+//
+// The startDate and endDate should be in YYYY-MM-DD format.
+//
+// Example:
+//
+//	summary, err := client.GetSavingsSummary(ctx, "2025-01-01", "2025-12-31")
+//	fmt.Printf("Saved $%.2f ($%.2f coupons)\n", summary.Total, summary.CouponSavings)
+//	for _, item := range summary.ByItem[:min(5, len(summary.ByItem))] {
+//	    fmt.Printf("  %s: $%.2f\n", item.ItemDescription, item.Savings)
+//	}
+func (c *Client) GetSavingsSummary(ctx context.Context, startDate, endDate string) (*SavingsSummary, error) {
+	transactions, err := c.GetAllTransactionItems(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	return c.savingsSummary(transactions), nil
+}
+
+// savingsSummary totals the savings in transactions already fetched for GetSavingsSummary.
+func (c *Client) savingsSummary(transactions []TransactionWithItems) *SavingsSummary {
+	summary := &SavingsSummary{}
+	months := make(map[string]*Savings)
+	items := make(map[string]*ItemSavings)
+
+	for _, tx := range transactions {
+		netted, _ := NetDiscounts(tx.Items)
+		i := 0
+		for _, item := range tx.Items {
+			if item.IsDiscount() {
+				continue
+			}
+			saved := item.Amount - netted[i].Amount
+			i++
+			if saved <= 0 {
+				continue
+			}
+			stats, exists := items[item.ItemNumber]
+			if !exists {
+				stats = &ItemSavings{
+					ItemNumber:      item.ItemNumber,
+					ItemDescription: c.itemName(item),
+				}
+				items[item.ItemNumber] = stats
+			}
+			stats.Savings += saved
+			stats.DiscountCount++
+		}
+
+		saved := transactionSavings(tx)
+		month := tx.TransactionDate.Format("2006-01")
+		if months[month] == nil {
+			months[month] = &Savings{}
+		}
+		months[month].add(saved)
+		summary.add(saved)
+	}
+
+	summary.Savings = summary.rounded()
+	for month, savings := range months {
+		summary.ByMonth = append(summary.ByMonth, MonthlySavings{Month: month, Savings: savings.rounded()})
+	}
+	sort.Slice(summary.ByMonth, func(i, j int) bool {
+		return summary.ByMonth[i].Month < summary.ByMonth[j].Month
+	})
+
+	for _, item := range items {
+		item.Savings = roundTo(item.Savings, 2)
+		summary.ByItem = append(summary.ByItem, *item)
+	}
+	sort.Slice(summary.ByItem, func(i, j int) bool {
+		if summary.ByItem[i].Savings != summary.ByItem[j].Savings {
+			return summary.ByItem[i].Savings > summary.ByItem[j].Savings
+		}
+		return summary.ByItem[i].ItemNumber < summary.ByItem[j].ItemNumber
+	})
+
+	return summary
+}
+
+// transactionSavings returns the savings on one receipt.
+func transactionSavings(tx TransactionWithItems) Savings {
+	var discounts float64
+	for _, item := range tx.Items {
+		if item.IsDiscount() {
+			discounts += math.Abs(item.Amount)
+		}
+	}
+	saved := Savings{
+		InstantSavings: math.Abs(tx.InstantSavings),
+		ItemDiscounts:  discounts,
+		CouponSavings:  tx.CouponSavings,
+	}
+	saved.Total = math.Max(saved.InstantSavings, saved.ItemDiscounts) + saved.CouponSavings
+	return saved
+}
+
+func (s *Savings) add(other Savings) {
+	s.InstantSavings += other.InstantSavings
+	s.ItemDiscounts += other.ItemDiscounts
+	s.CouponSavings += other.CouponSavings
+	s.Total += other.Total
+}
+
+func (s Savings) rounded() Savings {
+	return Savings{
+		InstantSavings: roundTo(s.InstantSavings, 2),
+		ItemDiscounts:  roundTo(s.ItemDiscounts, 2),
+		CouponSavings:  roundTo(s.CouponSavings, 2),
+		Total:          roundTo(s.Total, 2),
+	}
+}
@@ -0,0 +1,72 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSavingsSummary(t *testing.T) {
+	details := map[string]map[string]interface{}{
+		"A": {
+			"transactionBarcode": "A", "transactionDateTime": "2025-01-10T10:00:00", "instantSavings": 7.00,
+			"itemArray": []map[string]interface{}{
+				{"itemNumber": "1", "itemDescription01": "COFFEE", "unit": 1, "amount": 20.00},
+				{"itemNumber": "9", "itemDescription01": "/1", "unit": -1, "amount": -4.00},
+				{"itemNumber": "2", "itemDescription01": "BATTERIES", "unit": 1, "amount": 18.00},
+				{"itemNumber": "8", "itemDescription01": "/2", "unit": -1, "amount": -3.00},
+			},
+			"couponArray": []map[string]interface{}{
+				{"upcnumberCoupon": "111", "amountCoupon": -2.50},
+				{"upcnumberCoupon": "222", "amountCoupon": -9.00, "voidflagCoupon": "Y"},
+			},
+		},
+		"B": {
+			"transactionBarcode": "B", "transactionDateTime": "2025-02-10T10:00:00",
+			"itemArray": []map[string]interface{}{
+				{"itemNumber": "1", "itemDescription01": "COFFEE", "unit": 1, "amount": 20.00},
+				{"itemNumber": "9", "itemDescription01": "/1", "unit": -1, "amount": -4.00},
+			},
+		},
+	}
+
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if barcode, ok := req.Variables["barcode"].(string); ok {
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{details[barcode]}},
+			})
+			return
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{
+				"receipts": []map[string]interface{}{{"transactionBarcode": "A"}, {"transactionBarcode": "B"}},
+			},
+		})
+	})
+
+	summary, err := client.GetSavingsSummary(context.Background(), "2025-01-01", "2025-02-28")
+	require.NoError(t, err)
+
+	assert.Equal(t, 7.00, summary.InstantSavings)
+	assert.Equal(t, 11.00, summary.ItemDiscounts)
+	assert.Equal(t, 2.50, summary.CouponSavings, "voided coupons are excluded")
+	// A: max(7, 7) + 2.50; B: receipt reports no instant savings, so its discount line counts
+	assert.Equal(t, 13.50, summary.Total)
+
+	require.Len(t, summary.ByMonth, 2)
+	assert.Equal(t, "2025-01", summary.ByMonth[0].Month)
+	assert.Equal(t, 9.50, summary.ByMonth[0].Total)
+	assert.Equal(t, 4.00, summary.ByMonth[1].Total)
+
+	require.Len(t, summary.ByItem, 2)
+	assert.Equal(t, "Coffee", summary.ByItem[0].ItemDescription)
+	assert.Equal(t, 8.00, summary.ByItem[0].Savings)
+	assert.Equal(t, 2, summary.ByItem[0].DiscountCount)
+	assert.Equal(t, 3.00, summary.ByItem[1].Savings)
+}
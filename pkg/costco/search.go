@@ -0,0 +1,100 @@
+package costco
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+// Searching purchase history by item description
+
+// PurchaseMatch is one purchase of an item matching a SearchPurchases query.
+type PurchaseMatch struct {
+	ItemPurchase
+	ItemNumber         string
+	Description        string // Item description in the client's locale, as in the other analytics helpers
+	ReceiptDescription string // Description as printed on the receipt
+}
+
+// SearchPurchases finds every purchase whose item matches query and returns them
+// chronologically. Every word of query must appear, ignoring case, in the printed
+// receipt description, its FriendlyName expansion, the second description line, or
+// the French description; a query equal to an item number matches that item too.
+// So "paper towel" finds "KS PAPER TWL" through the abbreviation dictionary.
+//
+// Prices follow the other analytics helpers: Price is the line amount and Discount
+// the instant savings applied to it. Refunds appear with negative quantities.
+//
+// The startDate and endDate should be in YYYY-MM-DD format. With
+// Config.UseLocalStore the search runs against the synced store.
+//
+// Example:
+//
+//	matches, err := client.SearchPurchases(ctx, "paper towel", "2024-01-01", "2025-12-31")
+//	for _, m := range matches {
+//	    fmt.Printf("%s %s $%.2f at %s\n", m.Date, m.Description, m.Price+m.Discount, m.Warehouse)
+//	}
+func (c *Client) SearchPurchases(ctx context.Context, query, startDate, endDate string) ([]PurchaseMatch, error) {
+	words := strings.Fields(strings.ToLower(query))
+	if len(words) == 0 {
+		return nil, nil
+	}
+
+	transactions, err := c.GetAllTransactionItems(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Match each item number once, then take its purchases
+	items := make(map[string]ReceiptItem)
+	for _, tx := range transactions {
+		for _, item := range tx.Items {
+			if !item.IsDiscount() {
+				items[item.ItemNumber] = item
+			}
+		}
+	}
+
+	var matches []PurchaseMatch
+	histories := purchasesByItem(transactions)
+	for itemNumber, item := range items {
+		if !matchesQuery(item, query, words) {
+			continue
+		}
+		for _, purchase := range histories[itemNumber] {
+			matches = append(matches, PurchaseMatch{
+				ItemPurchase:       purchase,
+				ItemNumber:         itemNumber,
+				Description:        c.itemName(item),
+				ReceiptDescription: item.ItemDescription01,
+			})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Date != matches[j].Date {
+			return matches[i].Date < matches[j].Date
+		}
+		if matches[i].Barcode != matches[j].Barcode {
+			return matches[i].Barcode < matches[j].Barcode
+		}
+		return matches[i].ItemNumber < matches[j].ItemNumber
+	})
+	return matches, nil
+}
+
+// matchesQuery reports whether every word appears somewhere in the item's descriptions.
+func matchesQuery(item ReceiptItem, query string, words []string) bool {
+	if strings.TrimSpace(query) == item.ItemNumber {
+		return true
+	}
+	haystack := strings.ToLower(strings.Join([]string{
+		item.ItemDescription01, item.FriendlyName(), item.ItemDescription02, item.FrenchItemDescription1,
+	}, " "))
+	for _, word := range words {
+		if !strings.Contains(haystack, word) {
+			return false
+		}
+	}
+	return true
+}
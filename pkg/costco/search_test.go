@@ -0,0 +1,47 @@
+package costco
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchPurchases(t *testing.T) {
+	client := newStreamTestClient(t, 3)
+	ctx := context.Background()
+
+	matches, err := client.SearchPurchases(ctx, "eggs", "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+	require.Len(t, matches, 3)
+	assert.Equal(t, "2025-01-01", matches[0].Date)
+	assert.Equal(t, "2025-01-03", matches[2].Date)
+	assert.Equal(t, "1", matches[0].ItemNumber)
+	assert.Equal(t, "Eggs", matches[0].Description)
+	assert.Equal(t, "EGGS", matches[0].ReceiptDescription)
+	assert.Equal(t, 6.00, matches[0].Price)
+	assert.Equal(t, "R1", matches[0].Barcode)
+
+	matches, err = client.SearchPurchases(ctx, "2", "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+	require.Len(t, matches, 3, "item number")
+	assert.Equal(t, "MILK", matches[0].ReceiptDescription)
+
+	matches, err = client.SearchPurchases(ctx, "eggs milk", "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+	assert.Empty(t, matches, "every word must match the same item")
+
+	matches, err = client.SearchPurchases(ctx, "  ", "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+	assert.Empty(t, matches)
+}
+
+func TestMatchesQuery(t *testing.T) {
+	towels := ReceiptItem{ItemNumber: "1234", ItemDescription01: "KS PPR TWL", ItemDescription02: "12 ROLLS"}
+	assert.True(t, matchesQuery(towels, "paper towel", []string{"paper", "towel"}), "abbreviations expand")
+	assert.True(t, matchesQuery(towels, "twl", []string{"twl"}), "printed description")
+	assert.True(t, matchesQuery(towels, "rolls", []string{"rolls"}), "second line")
+	assert.True(t, matchesQuery(towels, "1234", []string{"1234"}))
+	assert.False(t, matchesQuery(towels, "tissue", []string{"tissue"}))
+}
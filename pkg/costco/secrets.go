@@ -0,0 +1,140 @@
+package costco
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// Secret storage backends for sensitive config fields
+
+// SecretBackend selects where sensitive config fields (currently the account email) are stored.
+type SecretBackend string
+
+const (
+	// SecretBackendFile keeps secrets inline in ~/.costco/config.json (default).
+	SecretBackendFile SecretBackend = "file"
+	// SecretBackendKeychain stores secrets in the OS keychain and leaves only a
+	// pointer in config.json. Uses `security` on macOS and `secret-tool` on Linux.
+	SecretBackendKeychain SecretBackend = "keychain"
+)
+
+// keychainService is the service name secrets are filed under in the OS keychain.
+const keychainService = "costco-go"
+
+// keychainRefPrefix marks a config value as a pointer into the keychain.
+const keychainRefPrefix = "keychain:"
+
+// SecretStore reads and writes named secrets.
+type SecretStore interface {
+	Get(key string) (string, error)
+	Set(key, value string) error
+	Delete(key string) error
+}
+
+// newSecretStore returns the store for the given backend.
+// It is a variable so tests can substitute an in-memory store.
+var newSecretStore = func(backend SecretBackend) (SecretStore, error) {
+	switch backend {
+	case "", SecretBackendFile:
+		return nil, nil
+	case SecretBackendKeychain:
+		return &keychainStore{goos: runtime.GOOS}, nil
+	default:
+		return nil, fmt.Errorf("unknown secret backend %q", backend)
+	}
+}
+
+// keychainStore shells out to the platform keychain CLI so no cgo or extra
+// dependencies are needed.
+type keychainStore struct {
+	goos string
+}
+
+func (k *keychainStore) Get(key string) (string, error) {
+	var cmd *exec.Cmd
+	switch k.goos {
+	case "darwin":
+		cmd = exec.Command("security", "find-generic-password", "-s", keychainService, "-a", key, "-w")
+	case "linux":
+		cmd = exec.Command("secret-tool", "lookup", "service", keychainService, "account", key)
+	default:
+		return "", fmt.Errorf("keychain backend is not supported on %s", k.goos)
+	}
+
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("reading %q from keychain: %w", key, err)
+	}
+	return strings.TrimRight(string(out), "\n"), nil
+}
+
+func (k *keychainStore) Set(key, value string) error {
+	var cmd *exec.Cmd
+	switch k.goos {
+	case "darwin":
+		// -w as the last argument prompts for the secret, keeping it out of argv;
+		// security asks for it twice, so answer both prompts on stdin
+		cmd = exec.Command("security", "add-generic-password", "-U", "-s", keychainService, "-a", key, "-w")
+		cmd.Stdin = strings.NewReader(value + "\n" + value + "\n")
+	case "linux":
+		cmd = exec.Command("secret-tool", "store", "--label=costco-go "+key, "service", keychainService, "account", key)
+		cmd.Stdin = bytes.NewBufferString(value)
+	default:
+		return fmt.Errorf("keychain backend is not supported on %s", k.goos)
+	}
+
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("writing %q to keychain: %w: %s", key, err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+func (k *keychainStore) Delete(key string) error {
+	var cmd *exec.Cmd
+	switch k.goos {
+	case "darwin":
+		cmd = exec.Command("security", "delete-generic-password", "-s", keychainService, "-a", key)
+	case "linux":
+		cmd = exec.Command("secret-tool", "clear", "service", keychainService, "account", key)
+	default:
+		return fmt.Errorf("keychain backend is not supported on %s", k.goos)
+	}
+
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("deleting %q from keychain: %w: %s", key, err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// storeSecret moves value into the backend's store and returns the pointer
+// to persist in its place. With the file backend the value is returned unchanged.
+func storeSecret(backend SecretBackend, key, value string) (string, error) {
+	store, err := newSecretStore(backend)
+	if err != nil || store == nil || value == "" {
+		return value, err
+	}
+	if err := store.Set(key, value); err != nil {
+		return "", err
+	}
+	return keychainRefPrefix + key, nil
+}
+
+// resolveSecret returns the secret a persisted value points to.
+// Values that aren't keychain pointers are returned unchanged.
+func resolveSecret(backend SecretBackend, value string) (string, error) {
+	key, ok := strings.CutPrefix(value, keychainRefPrefix)
+	if !ok {
+		return value, nil
+	}
+	store, err := newSecretStore(backend)
+	if err != nil {
+		return "", err
+	}
+	if store == nil {
+		return "", fmt.Errorf("config references keychain secret %q but secret backend is %q", key, backend)
+	}
+	return store.Get(key)
+}
@@ -0,0 +1,158 @@
+package costco
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memorySecretStore is an in-memory SecretStore used in place of the OS keychain.
+type memorySecretStore map[string]string
+
+func (m memorySecretStore) Get(key string) (string, error) {
+	value, ok := m[key]
+	if !ok {
+		return "", fmt.Errorf("secret %q not found", key)
+	}
+	return value, nil
+}
+
+func (m memorySecretStore) Set(key, value string) error {
+	m[key] = value
+	return nil
+}
+
+func (m memorySecretStore) Delete(key string) error {
+	delete(m, key)
+	return nil
+}
+
+// useMemorySecretStore swaps the keychain backend for an in-memory store for the duration of the test.
+func useMemorySecretStore(t *testing.T) memorySecretStore {
+	t.Helper()
+	store := memorySecretStore{}
+	original := newSecretStore
+	newSecretStore = func(backend SecretBackend) (SecretStore, error) {
+		if backend == SecretBackendKeychain {
+			return store, nil
+		}
+		return original(backend)
+	}
+	t.Cleanup(func() { newSecretStore = original })
+	return store
+}
+
+func TestSaveConfig_KeychainBackend(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+	store := useMemorySecretStore(t)
+
+	config := &StoredConfig{
+		Email:           "test@example.com",
+		WarehouseNumber: "847",
+		SecretBackend:   SecretBackendKeychain,
+	}
+	require.NoError(t, SaveConfig(config))
+
+	// Email lives in the keychain, config.json only has a pointer
+	assert.Equal(t, "test@example.com", store["email"])
+
+	configPath, err := getConfigPath()
+	require.NoError(t, err)
+	data, err := os.ReadFile(filepath.Join(configPath, configFile))
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "test@example.com")
+
+	var raw StoredConfig
+	require.NoError(t, json.Unmarshal(data, &raw))
+	assert.Equal(t, "keychain:email", raw.Email)
+
+	// Caller's struct is not modified
+	assert.Equal(t, "test@example.com", config.Email)
+
+	// LoadConfig resolves the pointer
+	loaded, err := LoadConfig()
+	require.NoError(t, err)
+	require.NotNil(t, loaded)
+	assert.Equal(t, "test@example.com", loaded.Email)
+	assert.Equal(t, SecretBackendKeychain, loaded.SecretBackend)
+}
+
+func TestSaveConfig_FileBackendKeepsEmailInline(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+	store := useMemorySecretStore(t)
+
+	require.NoError(t, SaveConfig(&StoredConfig{Email: "test@example.com"}))
+	assert.Empty(t, store)
+
+	loaded, err := LoadConfig()
+	require.NoError(t, err)
+	assert.Equal(t, "test@example.com", loaded.Email)
+}
+
+func TestLoadConfig_KeychainPointerWithoutBackend(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
+	configPath, err := getConfigPath()
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(configPath, configFile), []byte(`{"email":"keychain:email"}`), 0600)
+	require.NoError(t, err)
+
+	_, err = LoadConfig()
+	assert.ErrorContains(t, err, "secret backend")
+}
+
+func TestNewClient_ResolvesKeychainEmail(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+	store := useMemorySecretStore(t)
+	store["email"] = "test@example.com"
+
+	client := NewClient(Config{
+		Email:         "keychain:email",
+		SecretBackend: SecretBackendKeychain,
+	})
+	assert.Equal(t, "test@example.com", client.config.Email)
+}
+
+func TestNewSecretStore_UnknownBackend(t *testing.T) {
+	_, err := newSecretStore("vault")
+	assert.ErrorContains(t, err, "unknown secret backend")
+}
+
+func TestKeychainStore_UnsupportedPlatform(t *testing.T) {
+	store := &keychainStore{goos: "plan9"}
+	_, err := store.Get("email")
+	assert.ErrorContains(t, err, "not supported")
+	assert.ErrorContains(t, store.Set("email", "x"), "not supported")
+	assert.ErrorContains(t, store.Delete("email"), "not supported")
+}
+
+func TestKeychainStore_DarwinSetKeepsSecretOutOfArgs(t *testing.T) {
+	if _, err := os.Stat("/bin/sh"); err != nil {
+		t.Skip("needs /bin/sh for the fake security command")
+	}
+	dir := t.TempDir()
+	script := "#!/bin/sh\necho \"$@\" > \"$(dirname \"$0\")/args\"\ncat > \"$(dirname \"$0\")/stdin\"\n"
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "security"), []byte(script), 0o755))
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	store := &keychainStore{goos: "darwin"}
+	require.NoError(t, store.Set("email", "s3cret"))
+
+	args, err := os.ReadFile(filepath.Join(dir, "args"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(args), "s3cret")
+	assert.Equal(t, "add-generic-password -U -s costco-go -a email -w\n", string(args))
+
+	stdin, err := os.ReadFile(filepath.Join(dir, "stdin"))
+	require.NoError(t, err)
+	assert.Equal(t, "s3cret\ns3cret\n", string(stdin))
+}
@@ -0,0 +1,98 @@
+package costco
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Evenly bucketed time series for charting
+
+// SpendSeries is spending over a date range in evenly spaced buckets.
+// This is returned by GetSpendSeries.
+type SpendSeries struct {
+	Interval ReportPeriod
+	Points   []SeriesPoint // Chronological, one per bucket, including empty buckets
+}
+
+// SeriesPoint is one bucket of a SpendSeries. Empty buckets have zero values.
+type SeriesPoint struct {
+	Start      time.Time // First day of the bucket
+	Label      string    // ReportPeriod.Label of the bucket, e.g. "2025-W11"
+	Spend      float64   // Amount spent, including tax, net of refunds
+	Savings    float64   // Instant savings plus coupon savings
+	FuelVolume float64   // Fuel bought: gallons in the US, liters in Canada
+	Trips      int       // Number of receipts and orders, excluding returns
+}
+
+// GetSpendSeries buckets the transactions in a date range by day, week, month, quarter,
+// or year, returning one point per bucket from startDate through endDate. Buckets with
+// no purchases are filled with zeros, so the points can be fed straight to a chart.
+//
+// The startDate and endDate should be in YYYY-MM-DD format.
+//
+// Example:
+//
+//	series, err := client.GetSpendSeries(ctx, "2025-01-01", "2025-12-31", costco.ReportPeriodWeek)
+//	for _, p := range series.Points {
+//	    fmt.Printf("%s,%.2f,%.2f,%.3f,%d\n", p.Start.Format("2006-01-02"), p.Spend, p.Savings, p.FuelVolume, p.Trips)
+//	}
+func (c *Client) GetSpendSeries(ctx context.Context, startDate, endDate string, interval ReportPeriod) (*SpendSeries, error) {
+	if err := interval.validate(); err != nil {
+		return nil, err
+	}
+	start, err := time.Parse("2006-01-02", startDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid start date %q: %w", startDate, err)
+	}
+	end, err := time.Parse("2006-01-02", endDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid end date %q: %w", endDate, err)
+	}
+	if end.Before(start) {
+		return nil, fmt.Errorf("end date %s is before start date %s", endDate, startDate)
+	}
+
+	transactions, err := c.GetAllTransactionItems(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	return c.spendSeries(transactions, start, end, interval), nil
+}
+
+// spendSeries buckets transactions already fetched for GetSpendSeries.
+func (c *Client) spendSeries(transactions []TransactionWithItems, start, end time.Time, interval ReportPeriod) *SpendSeries {
+	series := &SpendSeries{Interval: interval}
+	index := make(map[string]int)
+	calendar := c.config.Calendar
+	for bucket := calendar.Start(interval, start); !bucket.After(end); bucket = calendar.next(interval, bucket) {
+		label := calendar.Label(interval, bucket)
+		index[label] = len(series.Points)
+		series.Points = append(series.Points, SeriesPoint{Start: bucket, Label: label})
+	}
+
+	for _, tx := range transactions {
+		i, ok := index[calendar.Label(interval, tx.TransactionDate)]
+		if !ok {
+			continue
+		}
+		point := &series.Points[i]
+		point.Spend += tx.Total
+		point.Savings += tx.InstantSavings + tx.CouponSavings
+		if !tx.IsRefund() {
+			point.Trips++
+		}
+		for _, item := range tx.Items {
+			point.FuelVolume += item.FuelUnitQuantity
+		}
+	}
+
+	for i := range series.Points {
+		point := &series.Points[i]
+		point.Spend = roundTo(point.Spend, 2)
+		point.Savings = roundTo(point.Savings, 2)
+		point.FuelVolume = roundTo(point.FuelVolume, 3)
+	}
+
+	return series
+}
@@ -0,0 +1,69 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSpendSeries(t *testing.T) {
+	details := map[string]map[string]interface{}{
+		"A": {"transactionBarcode": "A", "transactionDateTime": "2025-01-02T10:00:00", "total": 100.00, "instantSavings": 5.00},
+		"G": {
+			"transactionBarcode": "G", "transactionDateTime": "2025-01-03T08:00:00", "total": 40.00,
+			"itemArray": []map[string]interface{}{
+				{"itemNumber": "900", "itemDescription01": "REGULAR", "unit": 1, "amount": 40.00, "fuelUnitQuantity": 12.5},
+			},
+		},
+		"R": {"transactionBarcode": "R", "transactionDateTime": "2025-01-22T12:00:00", "total": -20.00, "transactionType": "Refund"},
+	}
+
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if barcode, ok := req.Variables["barcode"].(string); ok {
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{details[barcode]}},
+			})
+			return
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{
+				"receipts": []map[string]interface{}{
+					{"transactionBarcode": "R"}, {"transactionBarcode": "G"}, {"transactionBarcode": "A"},
+				},
+			},
+		})
+	})
+
+	series, err := client.GetSpendSeries(context.Background(), "2025-01-01", "2025-01-31", ReportPeriodWeek)
+	require.NoError(t, err)
+	assert.Equal(t, ReportPeriodWeek, series.Interval)
+
+	var labels []string
+	for _, p := range series.Points {
+		labels = append(labels, p.Label)
+	}
+	assert.Equal(t, []string{"2025-W01", "2025-W02", "2025-W03", "2025-W04", "2025-W05"}, labels, "empty weeks are filled")
+
+	first := series.Points[0]
+	assert.Equal(t, time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC), first.Start, "ISO weeks start on Monday")
+	assert.Equal(t, 140.00, first.Spend)
+	assert.Equal(t, 5.00, first.Savings)
+	assert.Equal(t, 12.5, first.FuelVolume)
+	assert.Equal(t, 2, first.Trips)
+
+	assert.Equal(t, SeriesPoint{Start: time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC), Label: "2025-W02"}, series.Points[1])
+	assert.Equal(t, -20.00, series.Points[3].Spend)
+	assert.Equal(t, 0, series.Points[3].Trips, "returns are not trips")
+
+	_, err = client.GetSpendSeries(context.Background(), "2025-01-01", "2025-01-31", "hourly")
+	assert.Error(t, err)
+	_, err = client.GetSpendSeries(context.Background(), "2025-02-01", "2025-01-01", ReportPeriodDay)
+	assert.Error(t, err)
+}
@@ -0,0 +1,176 @@
+package costco
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Costco Shop Card (gift card) balances
+
+// ShopCardBalance represents the remaining balance on a Costco Shop Card
+type ShopCardBalance struct {
+	LastFour string  `json:"lastFourDigits"` // Only the last four digits are ever returned or stored
+	Balance  float64 `json:"balance"`
+	Status   string  `json:"cardStatus"` // e.g. "ACTIVE", "INACTIVE"
+	Currency string  `json:"currency"`
+}
+
+// MatchesTender reports whether a receipt tender was paid with this shop card.
+func (b *ShopCardBalance) MatchesTender(t *Tender) bool {
+	return t.IsShopCard() && b.LastFour != "" && strings.HasSuffix(t.DisplayAccountNumber, b.LastFour)
+}
+
+// IsShopCard reports whether the tender is a Costco Shop Card (stored-value) payment.
+func (t *Tender) IsShopCard() bool {
+	if t.StoredValueBucket != "" {
+		return true
+	}
+	name := strings.ToUpper(t.TenderTypeName + " " + t.TenderDescription)
+	return strings.Contains(name, "SHOP CARD") || strings.Contains(name, "CASH CARD")
+}
+
+// GetShopCardBalance checks the remaining balance on a Costco Shop Card.
+// The card number and PIN are sent only to Costco and are never logged.
+//
+// Example:
+//
+//	balance, err := client.GetShopCardBalance(ctx, cardNumber, pin)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, tender := range receipt.TenderArray {
+//	    if balance.MatchesTender(&tender) {
+//	        fmt.Printf("paid $%.2f with card ending %s, $%.2f left\n",
+//	            tender.AmountTender, balance.LastFour, balance.Balance)
+//	    }
+//	}
+func (c *Client) GetShopCardBalance(ctx context.Context, cardNumber, pin string) (*ShopCardBalance, error) {
+	cardNumber = strings.ReplaceAll(strings.TrimSpace(cardNumber), " ", "")
+	if !isDigits(cardNumber) || len(cardNumber) < 4 {
+		return nil, fmt.Errorf("card number must contain only digits")
+	}
+	if !isDigits(pin) {
+		return nil, fmt.Errorf("PIN must contain only digits")
+	}
+
+	lastFour := cardNumber[len(cardNumber)-4:]
+	c.getLogger().Info("checking shop card balance", slog.String("card_last_four", lastFour))
+
+	body := map[string]string{
+		"cardNumber": cardNumber,
+		"pin":        pin,
+	}
+
+	var balance ShopCardBalance
+	if err := c.executeREST(ctx, http.MethodPost, ShopCardBalanceEndpoint, nil, body, true, &balance); err != nil {
+		return nil, err
+	}
+
+	if balance.LastFour == "" {
+		balance.LastFour = lastFour
+	}
+	if balance.Currency == "" {
+		balance.Currency = c.currency()
+	}
+
+	c.getLogger().Info("checked shop card balance",
+		slog.String("card_last_four", balance.LastFour),
+		slog.String("status", balance.Status))
+
+	return &balance, nil
+}
+
+// Shop card activity types
+const (
+	ShopCardActivityLoad   = "LOAD"   // Card purchased or reloaded
+	ShopCardActivitySpend  = "SPEND"  // Balance used as a tender
+	ShopCardActivityRefund = "REFUND" // Return credited back to the card
+)
+
+// ShopCardActivity represents a single load or spend on a shop card tied to the membership
+type ShopCardActivity struct {
+	Date               string  `json:"date"` // YYYY-MM-DD
+	Type               string  `json:"type"` // One of the ShopCardActivity constants
+	LastFour           string  `json:"lastFourDigits"`
+	Amount             float64 `json:"amount"` // Always positive; Type gives the direction
+	BalanceAfter       float64 `json:"balanceAfter"`
+	Location           string  `json:"location"`           // Warehouse name or "Costco.com"
+	TransactionBarcode string  `json:"transactionBarcode"` // Receipt barcode when used in a warehouse
+}
+
+// ShopCardSummary totals shop card activity for spending reports
+type ShopCardSummary struct {
+	Loaded   float64 // Money put onto cards
+	Spent    float64 // Money spent from cards
+	Refunded float64 // Returns credited to cards
+}
+
+// SummarizeShopCardActivity totals loads, spends, and refunds.
+// Loads are real spending; spends are already counted by the receipts they paid for.
+func SummarizeShopCardActivity(activity []ShopCardActivity) ShopCardSummary {
+	var summary ShopCardSummary
+	for _, a := range activity {
+		switch a.Type {
+		case ShopCardActivityLoad:
+			summary.Loaded += a.Amount
+		case ShopCardActivitySpend:
+			summary.Spent += a.Amount
+		case ShopCardActivityRefund:
+			summary.Refunded += a.Amount
+		}
+	}
+	summary.Loaded = math.Round(summary.Loaded*100) / 100
+	summary.Spent = math.Round(summary.Spent*100) / 100
+	summary.Refunded = math.Round(summary.Refunded*100) / 100
+	return summary
+}
+
+// GetShopCardHistory retrieves load and spend activity for shop cards registered to the membership.
+// The startDate and endDate should be in YYYY-MM-DD format.
+//
+// Example:
+//
+//	activity, err := client.GetShopCardHistory(ctx, "2025-01-01", "2025-12-31")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	summary := costco.SummarizeShopCardActivity(activity)
+//	fmt.Printf("Loaded $%.2f, spent $%.2f\n", summary.Loaded, summary.Spent)
+func (c *Client) GetShopCardHistory(ctx context.Context, startDate, endDate string) ([]ShopCardActivity, error) {
+	c.getLogger().Info("fetching shop card history",
+		slog.String("start_date", startDate),
+		slog.String("end_date", endDate))
+
+	params := url.Values{}
+	params.Set("startDate", startDate)
+	params.Set("endDate", endDate)
+
+	var result struct {
+		Activity []ShopCardActivity `json:"activity"`
+	}
+	if err := c.executeREST(ctx, http.MethodGet, ShopCardHistoryEndpoint, params, nil, true, &result); err != nil {
+		return nil, err
+	}
+
+	c.getLogger().Info("fetched shop card history", slog.Int("activity_count", len(result.Activity)))
+
+	return result.Activity, nil
+}
+
+// isDigits reports whether s is non-empty and contains only ASCII digits.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
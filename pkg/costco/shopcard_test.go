@@ -0,0 +1,82 @@
+package costco
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetShopCardBalance(t *testing.T) {
+	var logs bytes.Buffer
+	client := newMockClient(t, Config{Logger: slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug}))},
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/AjaxCheckShopCardBalance", r.URL.Path)
+
+			var body map[string]string
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			assert.Equal(t, "6006491234567890", body["cardNumber"])
+			assert.Equal(t, "4321", body["pin"])
+
+			w.Write([]byte(`{"balance": 42.17, "cardStatus": "ACTIVE", "currency": "USD"}`))
+		})
+
+	balance, err := client.GetShopCardBalance(context.Background(), "6006 4912 3456 7890", "4321")
+	require.NoError(t, err)
+
+	assert.Equal(t, "7890", balance.LastFour)
+	assert.Equal(t, 42.17, balance.Balance)
+	assert.Equal(t, "ACTIVE", balance.Status)
+
+	assert.NotContains(t, logs.String(), "6006491234567890", "card number must not be logged")
+	assert.NotContains(t, logs.String(), "4321", "PIN must not be logged")
+}
+
+func TestGetShopCardBalance_InvalidInput(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("no request expected")
+	})
+
+	_, err := client.GetShopCardBalance(context.Background(), "abc", "1234")
+	assert.Error(t, err)
+	_, err = client.GetShopCardBalance(context.Background(), "6006491234567890", "")
+	assert.Error(t, err)
+}
+
+func TestShopCardBalance_MatchesTender(t *testing.T) {
+	balance := &ShopCardBalance{LastFour: "7890"}
+
+	assert.True(t, balance.MatchesTender(&Tender{TenderTypeName: "Costco Shop Card", DisplayAccountNumber: "************7890"}))
+	assert.True(t, balance.MatchesTender(&Tender{StoredValueBucket: "1", DisplayAccountNumber: "7890"}))
+	assert.False(t, balance.MatchesTender(&Tender{TenderTypeName: "Costco Shop Card", DisplayAccountNumber: "1111"}))
+	assert.False(t, balance.MatchesTender(&Tender{TenderTypeName: "VISA", DisplayAccountNumber: "7890"}))
+}
+
+func TestGetShopCardHistory(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/ebusiness/shopcard/v1/history", r.URL.Path)
+		assert.Equal(t, "2025-01-01", r.URL.Query().Get("startDate"))
+		assert.Equal(t, "2025-12-31", r.URL.Query().Get("endDate"))
+		w.Write([]byte(`{"activity": [
+			{"date": "2025-02-01", "type": "LOAD", "lastFourDigits": "7890", "amount": 100.00, "balanceAfter": 100.00},
+			{"date": "2025-02-10", "type": "SPEND", "lastFourDigits": "7890", "amount": 62.35, "balanceAfter": 37.65, "transactionBarcode": "21134300501862509051323"},
+			{"date": "2025-02-12", "type": "REFUND", "lastFourDigits": "7890", "amount": 9.99, "balanceAfter": 47.64}
+		]}`))
+	})
+
+	activity, err := client.GetShopCardHistory(context.Background(), "2025-01-01", "2025-12-31")
+	require.NoError(t, err)
+	require.Len(t, activity, 3)
+	assert.Equal(t, "21134300501862509051323", activity[1].TransactionBarcode)
+
+	summary := SummarizeShopCardActivity(activity)
+	assert.Equal(t, 100.00, summary.Loaded)
+	assert.Equal(t, 62.35, summary.Spent)
+	assert.Equal(t, 9.99, summary.Refunded)
+}
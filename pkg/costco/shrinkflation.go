@@ -0,0 +1,236 @@
+package costco
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Unit-size parsing and shrinkflation detection
+
+// ItemSize is the pack size encoded in an item description, e.g. "2/64OZ" or "20CT".
+type ItemSize struct {
+	Packs    int     // Number of packs (2 in "2/64OZ"); 1 when not given
+	Quantity float64 // Size of each pack (64 in "2/64OZ")
+	Unit     string  // Normalized unit: CT, OZ, FLOZ, LB, G, KG, ML, L, GAL, QT, SHEET, ROLL
+}
+
+// Total returns Packs x Quantity, in Unit.
+func (s ItemSize) Total() float64 {
+	return float64(s.Packs) * s.Quantity
+}
+
+// String formats the size the way receipts print it.
+func (s ItemSize) String() string {
+	quantity := strconv.FormatFloat(s.Quantity, 'f', -1, 64)
+	if s.Packs > 1 {
+		return strconv.Itoa(s.Packs) + "/" + quantity + s.Unit
+	}
+	return quantity + s.Unit
+}
+
+var sizePattern = regexp.MustCompile(`\b(?:(\d+)\s*[/X]\s*)?(\d+(?:\.\d+)?)\s*-?\s*(FL\s?OZ|OZ|CT|COUNT|PK|PACK|LBS?|KG|G|ML|LTR|L|GAL|QT|SHEETS?|SHT|RL|ROLLS?)\b`)
+
+var sizeUnits = map[string]string{
+	"FLOZ": "FLOZ", "OZ": "OZ",
+	"CT": "CT", "COUNT": "CT", "PK": "CT", "PACK": "CT",
+	"LB": "LB", "LBS": "LB", "KG": "KG", "G": "G",
+	"ML": "ML", "L": "L", "LTR": "L", "GAL": "GAL", "QT": "QT",
+	"SHEET": "SHEET", "SHEETS": "SHEET", "SHT": "SHEET",
+	"RL": "ROLL", "ROLL": "ROLL", "ROLLS": "ROLL",
+}
+
+// ParseItemSize extracts the first pack size from an item description.
+// Returns false when the description has no size token.
+//
+// Example:
+//
+//	size, ok := costco.ParseItemSize("KS OJ 2/64OZ") // {Packs: 2, Quantity: 64, Unit: "OZ"}, true
+func ParseItemSize(description string) (ItemSize, bool) {
+	match := sizePattern.FindStringSubmatch(strings.ToUpper(description))
+	if match == nil {
+		return ItemSize{}, false
+	}
+	size := ItemSize{Packs: 1, Unit: sizeUnits[strings.ReplaceAll(match[3], " ", "")]}
+	if match[1] != "" {
+		size.Packs, _ = strconv.Atoi(match[1])
+	}
+	size.Quantity, _ = strconv.ParseFloat(match[2], 64)
+	if size.Packs == 0 || size.Quantity == 0 {
+		return ItemSize{}, false
+	}
+	return size, true
+}
+
+// sizeConversions converts weight units to ounces and volume units to fluid ounces.
+var sizeConversions = map[string]struct {
+	unit   string
+	factor float64
+}{
+	"LB": {"OZ", 16}, "G": {"OZ", 1 / 28.3495}, "KG": {"OZ", 35.274},
+	"ML": {"FLOZ", 1 / 29.5735}, "L": {"FLOZ", 33.814}, "GAL": {"FLOZ", 128}, "QT": {"FLOZ", 32},
+}
+
+// Normalized returns the size with weights in OZ and volumes in FLOZ, so sizes printed
+// in different units can be compared. Counts, sheets, and rolls are unchanged.
+func (s ItemSize) Normalized() ItemSize {
+	if conversion, ok := sizeConversions[s.Unit]; ok {
+		s.Quantity = roundTo(s.Quantity*conversion.factor, 4)
+		s.Unit = conversion.unit
+	}
+	return s
+}
+
+// Size returns the item's pack size, parsed from ItemDescription02 or, when that has
+// none, from ItemDescription01. Returns false when neither description has a size.
+func (item *ReceiptItem) Size() (ItemSize, bool) {
+	if size, ok := ParseItemSize(item.ItemDescription02); ok {
+		return size, true
+	}
+	return ParseItemSize(item.ItemDescription01)
+}
+
+// SizePrice is a price per unit of size, e.g. $0.11 per OZ.
+type SizePrice struct {
+	Price float64 // Price per Unit, rounded to 4 decimals
+	Unit  string  // OZ, FLOZ, CT, SHEET, or ROLL (see ItemSize.Normalized)
+}
+
+// UnitPrice returns the shelf price per unit of size, e.g. per ounce or per count, from
+// the line amount, the number of items bought, and the pack size in the description.
+// Returns false for discount lines, returns, and items without a size.
+//
+// Example:
+//
+//	// "KS OJ 2/64OZ", one bought for $9.99
+//	price, ok := item.UnitPrice() // {Price: 0.078, Unit: "OZ"}, true
+func (item *ReceiptItem) UnitPrice() (SizePrice, bool) {
+	if item.Unit <= 0 || item.Amount <= 0 {
+		return SizePrice{}, false
+	}
+	size, ok := item.Size()
+	if !ok {
+		return SizePrice{}, false
+	}
+	return size.unitPrice(item.Amount / float64(item.Unit)), true
+}
+
+// unitPrice divides the price of one item by its normalized size.
+func (s ItemSize) unitPrice(price float64) SizePrice {
+	normalized := s.Normalized()
+	return SizePrice{Price: roundTo(price/normalized.Total(), 4), Unit: normalized.Unit}
+}
+
+// SizeChange is an item that got smaller while its price stayed flat or rose.
+// This is returned by FindShrinkflation.
+type SizeChange struct {
+	ItemNumber    string
+	Before        SizedPurchase
+	After         SizedPurchase
+	SizeChange    float64 // Percent change in total size (negative)
+	PriceChange   float64 // Percent change in unit price paid (zero or positive)
+	PerUnitChange float64 // Percent change in price per unit of size, e.g. per ounce
+}
+
+// SizedPurchase is one purchase of an item along with its parsed size.
+type SizedPurchase struct {
+	Date        time.Time
+	Description string
+	Size        ItemSize
+	UnitPrice   float64   // Net price paid per item
+	SizePrice   SizePrice // UnitPrice per unit of size, e.g. per ounce
+}
+
+// FindShrinkflation looks for items whose pack size, parsed from the receipt
+// description, shrank between purchases while the price paid stayed flat or rose.
+// Prices are net of discounts unless Config.GrossPrices is set. Sizes are compared
+// after ItemSize.Normalized, so weights and volumes in different units still match.
+// Results are sorted by the rise in price per unit of size, largest first.
+//
+// The startDate and endDate should be in YYYY-MM-DD format.
+//
+// Example:
+//
+//	changes, err := client.FindShrinkflation(ctx, "2023-01-01", "2025-12-31")
+//	for _, ch := range changes {
+//	    fmt.Printf("%s: %s -> %s, price %+.1f%%, per unit %+.1f%%\n", ch.After.Description,
+//	        ch.Before.Size, ch.After.Size, ch.PriceChange, ch.PerUnitChange)
+//	}
+func (c *Client) FindShrinkflation(ctx context.Context, startDate, endDate string) ([]SizeChange, error) {
+	transactions, err := c.GetAllTransactionItems(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(transactions, func(i, j int) bool {
+		return transactions[i].TransactionDate.Before(transactions[j].TransactionDate)
+	})
+
+	last := make(map[string]SizedPurchase)
+	var changes []SizeChange
+
+	for _, tx := range transactions {
+		// NetDiscounts keeps regular items in order, so netted[i] is the i-th non-discount item
+		netted, _ := NetDiscounts(tx.Items)
+		i := 0
+		for _, item := range tx.Items {
+			if item.IsDiscount() {
+				continue
+			}
+			net := netted[i]
+			i++
+			if item.Unit <= 0 || net.Amount <= 0 || item.ItemNumber == "" {
+				continue
+			}
+			size, ok := item.Size()
+			if !ok {
+				continue
+			}
+			price := net.Amount
+			if c.config.GrossPrices {
+				price = item.Amount
+			}
+			purchase := SizedPurchase{
+				Date:        tx.TransactionDate,
+				Description: c.itemName(item),
+				Size:        size,
+				UnitPrice:   roundTo(price/float64(item.Unit), 2),
+				SizePrice:   size.unitPrice(price / float64(item.Unit)),
+			}
+
+			before, seen := last[item.ItemNumber]
+			last[item.ItemNumber] = purchase
+			if !seen {
+				continue
+			}
+			// Normalized sizes let a 2LB bag be compared with a 30OZ one
+			oldSize, newSize := before.Size.Normalized(), size.Normalized()
+			if oldSize.Unit != newSize.Unit || newSize.Total() >= oldSize.Total() ||
+				purchase.UnitPrice < before.UnitPrice {
+				continue
+			}
+
+			sizeChange := (newSize.Total() - oldSize.Total()) / oldSize.Total() * 100
+			priceChange := (purchase.UnitPrice - before.UnitPrice) / before.UnitPrice * 100
+			perUnitBefore := before.UnitPrice / oldSize.Total()
+			perUnitAfter := purchase.UnitPrice / newSize.Total()
+			changes = append(changes, SizeChange{
+				ItemNumber:    item.ItemNumber,
+				Before:        before,
+				After:         purchase,
+				SizeChange:    roundTo(sizeChange, 1),
+				PriceChange:   roundTo(priceChange, 1),
+				PerUnitChange: roundTo((perUnitAfter-perUnitBefore)/perUnitBefore*100, 1),
+			})
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].PerUnitChange > changes[j].PerUnitChange
+	})
+
+	return changes, nil
+}
@@ -0,0 +1,108 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseItemSize(t *testing.T) {
+	tests := []struct {
+		description string
+		want        ItemSize
+		ok          bool
+	}{
+		{"KS OJ 2/64OZ", ItemSize{Packs: 2, Quantity: 64, Unit: "OZ"}, true},
+		{"KS BATH TISSUE 30 RL", ItemSize{Packs: 1, Quantity: 30, Unit: "ROLL"}, true},
+		{"CASCADE PODS 20CT", ItemSize{Packs: 1, Quantity: 20, Unit: "CT"}, true},
+		{"OLIVE OIL 2L", ItemSize{Packs: 1, Quantity: 2, Unit: "L"}, true},
+		{"coffee 2.5 lb", ItemSize{Packs: 1, Quantity: 2.5, Unit: "LB"}, true},
+		{"SPARKLING 12 FL OZ", ItemSize{Packs: 1, Quantity: 12, Unit: "FLOZ"}, true},
+		{"ROTISSERIE CHICKEN", ItemSize{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := ParseItemSize(tt.description)
+		assert.Equal(t, tt.ok, ok, tt.description)
+		assert.Equal(t, tt.want, got, tt.description)
+	}
+
+	assert.Equal(t, 128.0, ItemSize{Packs: 2, Quantity: 64, Unit: "OZ"}.Total())
+	assert.Equal(t, "2/64OZ", ItemSize{Packs: 2, Quantity: 64, Unit: "OZ"}.String())
+}
+
+func TestFindShrinkflation(t *testing.T) {
+	receipt := func(barcode, date string, items ...map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{"transactionBarcode": barcode, "transactionDateTime": date + "T10:00:00", "itemArray": items}
+	}
+	line := func(number, description string, amount float64) map[string]interface{} {
+		return map[string]interface{}{"itemNumber": number, "itemDescription01": description, "unit": 1, "amount": amount}
+	}
+	details := map[string]map[string]interface{}{
+		"A": receipt("A", "2025-01-10", line("1", "KS PODS 120CT", 20.00), line("2", "KS OJ 2/64OZ", 8.00), line("3", "COFFEE 2LB", 15.00)),
+		"B": receipt("B", "2025-03-10", line("1", "KS PODS 110CT", 20.00), line("2", "KS OJ 2/59OZ", 7.00), line("3", "COFFEE 30OZ", 15.00)),
+	}
+
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if barcode, ok := req.Variables["barcode"].(string); ok {
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{details[barcode]}},
+			})
+			return
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{
+				"receipts": []map[string]interface{}{{"transactionBarcode": "B"}, {"transactionBarcode": "A"}},
+			},
+		})
+	})
+
+	changes, err := client.FindShrinkflation(context.Background(), "2025-01-01", "2025-12-31")
+	require.NoError(t, err)
+	require.Len(t, changes, 2, "OJ got smaller but also cheaper")
+
+	pods := changes[0]
+	assert.Equal(t, "1", pods.ItemNumber)
+	assert.Equal(t, 120.0, pods.Before.Size.Total())
+	assert.Equal(t, 110.0, pods.After.Size.Total())
+	assert.Equal(t, -8.3, pods.SizeChange)
+	assert.Equal(t, 0.0, pods.PriceChange)
+	assert.Equal(t, 9.1, pods.PerUnitChange)
+	assert.Equal(t, SizePrice{Price: 0.1818, Unit: "CT"}, pods.After.SizePrice)
+
+	coffee := changes[1]
+	assert.Equal(t, "3", coffee.ItemNumber, "2LB and 30OZ are compared as ounces")
+	assert.Equal(t, -6.3, coffee.SizeChange)
+	assert.Equal(t, 6.7, coffee.PerUnitChange)
+}
+
+func TestReceiptItemUnitPrice(t *testing.T) {
+	tests := []struct {
+		name string
+		item ReceiptItem
+		want SizePrice
+		ok   bool
+	}{
+		{"size in second description", ReceiptItem{ItemDescription01: "KS OJ", ItemDescription02: "2/64OZ", Unit: 1, Amount: 9.99}, SizePrice{Price: 0.078, Unit: "OZ"}, true},
+		{"size in first description", ReceiptItem{ItemDescription01: "CASCADE PODS 20CT", Unit: 2, Amount: 30.00}, SizePrice{Price: 0.75, Unit: "CT"}, true},
+		{"pounds become ounces", ReceiptItem{ItemDescription01: "COFFEE 2.5LB", Unit: 1, Amount: 20.00}, SizePrice{Price: 0.5, Unit: "OZ"}, true},
+		{"liters become fluid ounces", ReceiptItem{ItemDescription01: "OLIVE OIL 2L", Unit: 1, Amount: 16.00}, SizePrice{Price: 0.2366, Unit: "FLOZ"}, true},
+		{"no size", ReceiptItem{ItemDescription01: "ROTISSERIE CHICKEN", Unit: 1, Amount: 4.99}, SizePrice{}, false},
+		{"return", ReceiptItem{ItemDescription01: "CASCADE PODS 20CT", Unit: -1, Amount: -15.00}, SizePrice{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.item.UnitPrice()
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	assert.Equal(t, ItemSize{Packs: 1, Quantity: 40, Unit: "OZ"}, ItemSize{Packs: 1, Quantity: 2.5, Unit: "LB"}.Normalized())
+	assert.Equal(t, ItemSize{Packs: 2, Quantity: 20, Unit: "CT"}, ItemSize{Packs: 2, Quantity: 20, Unit: "CT"}.Normalized())
+}
@@ -0,0 +1,95 @@
+package costco
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+// Special orders (furniture, HVAC, and other big-ticket kiosk orders)
+
+// SpecialOrder represents a big-ticket order placed at a warehouse kiosk or by phone.
+// These go through a separate order system and never appear on warehouse receipts
+// or in GetOnlineOrders.
+type SpecialOrder struct {
+	OrderNumber     string                `json:"orderNumber"`
+	OrderDate       string                `json:"orderDate"` // YYYY-MM-DD
+	Status          string                `json:"status"`    // e.g. "Ordered", "Scheduled for Delivery", "Delivered"
+	Channel         string                `json:"channel"`   // e.g. "Kiosk", "Phone"
+	WarehouseNumber string                `json:"warehouseNumber"`
+	DeliveryDate    string                `json:"deliveryDate,omitempty"`
+	SubTotal        float64               `json:"subTotal"`
+	Tax             float64               `json:"tax"`
+	DeliveryFee     float64               `json:"deliveryFee"`
+	Total           float64               `json:"total"`
+	Items           []SpecialOrderItem    `json:"items"`
+	Payments        []SpecialOrderPayment `json:"payments"`
+	Currency        string                `json:"currency,omitempty"` // Set by the client from the configured locale
+}
+
+// SpecialOrderItem represents a product on a special order
+type SpecialOrderItem struct {
+	ItemNumber  string  `json:"itemNumber"`
+	Description string  `json:"description"`
+	Quantity    int     `json:"quantity"`
+	Amount      float64 `json:"amount"`
+}
+
+// SpecialOrderPayment represents a single charge against a special order.
+// Large orders are often charged in parts (deposit at order time, balance on delivery),
+// so each payment shows up as its own credit card transaction.
+type SpecialOrderPayment struct {
+	ChargeDate string  `json:"chargeDate"` // YYYY-MM-DD
+	Amount     float64 `json:"amount"`
+	CardType   string  `json:"cardType"`  // e.g. "Visa", "Costco Shop Card"
+	CardLast4  string  `json:"cardLast4"` // Last four digits of the card
+}
+
+// Charged returns the sum of all payments posted so far. It equals Total once the
+// order has been fully paid.
+func (o *SpecialOrder) Charged() float64 {
+	var charged float64
+	for _, p := range o.Payments {
+		charged += p.Amount
+	}
+	return charged
+}
+
+// GetSpecialOrders retrieves special orders placed within a date range.
+// The startDate and endDate should be in YYYY-MM-DD format.
+//
+// Example:
+//
+//	orders, err := client.GetSpecialOrders(ctx, "2025-01-01", "2025-12-31")
+//	for _, order := range orders {
+//	    for _, p := range order.Payments {
+//	        fmt.Printf("%s %s ****%s $%.2f (order %s)\n",
+//	            p.ChargeDate, p.CardType, p.CardLast4, p.Amount, order.OrderNumber)
+//	    }
+//	}
+func (c *Client) GetSpecialOrders(ctx context.Context, startDate, endDate string) ([]SpecialOrder, error) {
+	c.getLogger().Info("fetching special orders",
+		slog.String("start_date", startDate),
+		slog.String("end_date", endDate))
+
+	params := url.Values{}
+	params.Set("startDate", startDate)
+	params.Set("endDate", endDate)
+
+	var result struct {
+		Orders []SpecialOrder `json:"orders"`
+	}
+	if err := c.executeREST(ctx, http.MethodGet, SpecialOrderEndpoint, params, nil, true, &result); err != nil {
+		return nil, err
+	}
+
+	currency := c.currency()
+	for i := range result.Orders {
+		result.Orders[i].Currency = currency
+	}
+
+	c.getLogger().Info("fetched special orders", slog.Int("order_count", len(result.Orders)))
+
+	return result.Orders, nil
+}
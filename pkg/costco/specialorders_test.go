@@ -0,0 +1,45 @@
+package costco
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSpecialOrders(t *testing.T) {
+	client := newMockClient(t, Config{Locale: LocaleEnUS}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/ebusiness/special-order/v1/orders", r.URL.Path)
+		assert.Equal(t, "2025-01-01", r.URL.Query().Get("startDate"))
+		assert.Equal(t, "2025-12-31", r.URL.Query().Get("endDate"))
+		w.Write([]byte(`{"orders": [{
+			"orderNumber": "SO-5521",
+			"orderDate": "2025-03-02",
+			"status": "Delivered",
+			"channel": "Kiosk",
+			"warehouseNumber": "847",
+			"subTotal": 2499.99,
+			"tax": 249.99,
+			"deliveryFee": 0,
+			"total": 2749.98,
+			"items": [{"itemNumber": "1712300", "description": "Sectional Sofa", "quantity": 1, "amount": 2499.99}],
+			"payments": [
+				{"chargeDate": "2025-03-02", "amount": 500.00, "cardType": "Visa", "cardLast4": "4242"},
+				{"chargeDate": "2025-04-10", "amount": 2249.98, "cardType": "Visa", "cardLast4": "4242"}
+			]
+		}]}`))
+	})
+
+	orders, err := client.GetSpecialOrders(context.Background(), "2025-01-01", "2025-12-31")
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+
+	order := orders[0]
+	assert.Equal(t, "Kiosk", order.Channel)
+	assert.Equal(t, "USD", order.Currency)
+	require.Len(t, order.Payments, 2)
+	assert.Equal(t, "4242", order.Payments[1].CardLast4)
+	assert.InDelta(t, order.Total, order.Charged(), 0.001)
+}
@@ -0,0 +1,217 @@
+package costco
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+)
+
+// Shared-expense split allocation
+
+// SplitPartySelf is the party unmatched spending is assigned to when no default split is set.
+const SplitPartySelf = "me"
+
+// Allocation assigns shares of an amount to parties, e.g. {"me": 1, "roommate": 1}
+// for 50/50 or {"business": 1} for 100% business. Shares are weights and are
+// normalized, so {"me": 50, "roommate": 50} means the same as {"me": 1, "roommate": 1}.
+type Allocation map[string]float64
+
+// SplitRules decide how each receipt item is shared. An item rule wins over a
+// department rule, which wins over Default. Rules can be loaded from JSON with
+// LoadSplitRules:
+//
+//	{
+//	  "default": {"me": 1},
+//	  "departments": {"14": {"me": 1, "roommate": 1}},
+//	  "items": {"1234567": {"business": 1}}
+//	}
+type SplitRules struct {
+	Items       map[string]Allocation `json:"items,omitempty"`       // By item number
+	Departments map[int]Allocation    `json:"departments,omitempty"` // By department number
+	Default     Allocation            `json:"default,omitempty"`     // Everything else (empty = all SplitPartySelf)
+}
+
+// LoadSplitRules reads split rules from a JSON file.
+func LoadSplitRules(path string) (*SplitRules, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var rules SplitRules
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return &rules, nil
+}
+
+// AllocationFor returns the split that applies to an item.
+func (r *SplitRules) AllocationFor(item ReceiptItem) Allocation {
+	if a, ok := r.Items[item.ItemNumber]; ok && a.total() > 0 {
+		return a
+	}
+	if a, ok := r.Departments[item.ItemDepartmentNumber]; ok && a.total() > 0 {
+		return a
+	}
+	if r.Default.total() > 0 {
+		return r.Default
+	}
+	return Allocation{SplitPartySelf: 1}
+}
+
+// total returns the sum of the positive shares.
+func (a Allocation) total() float64 {
+	var total float64
+	for _, share := range a {
+		if share > 0 {
+			total += share
+		}
+	}
+	return total
+}
+
+// split adds amount to parties in proportion to the allocation.
+func (a Allocation) split(amount float64, parties map[string]float64) {
+	total := a.total()
+	for party, share := range a {
+		if share > 0 {
+			parties[party] += amount * share / total
+		}
+	}
+}
+
+// SplitReport holds per-party totals for shared spending.
+// This is returned by GetSplitReport.
+type SplitReport struct {
+	Period  ReportPeriod
+	Periods []SplitPeriod      // Chronological
+	Totals  map[string]float64 // Per party, across the whole date range
+}
+
+// SplitPeriod holds the per-party totals for one month, quarter, or year.
+type SplitPeriod struct {
+	Label   string             // e.g. "2025-03"
+	Parties map[string]float64 // Amount assigned to each party
+}
+
+// OwedAmount is what one party owes the payer for a period.
+type OwedAmount struct {
+	Period string
+	Party  string
+	Amount float64
+}
+
+// Owed lists, for each period, what every party other than payer owes payer.
+func (r *SplitReport) Owed(payer string) []OwedAmount {
+	var owed []OwedAmount
+	for _, period := range r.Periods {
+		parties := make([]string, 0, len(period.Parties))
+		for party := range period.Parties {
+			if party != payer {
+				parties = append(parties, party)
+			}
+		}
+		sort.Strings(parties)
+		for _, party := range parties {
+			owed = append(owed, OwedAmount{Period: period.Label, Party: party, Amount: period.Parties[party]})
+		}
+	}
+	return owed
+}
+
+// WriteOwedCSV writes Owed(payer) as CSV with a period,party,amount header.
+func (r *SplitReport) WriteOwedCSV(w io.Writer, payer string) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"period", "party", "amount"}); err != nil {
+		return err
+	}
+	for _, o := range r.Owed(payer) {
+		if err := writer.Write([]string{o.Period, o.Party, strconv.FormatFloat(o.Amount, 'f', 2, 64)}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// GetSplitReport assigns the spending in a date range to parties using rules and
+// totals it per party by month, quarter, or year. Items are split by their rule;
+// the rest of each receipt (tax, unmatched discounts) follows the receipt's item
+// split, so party totals add up to what was spent. Amounts are net of discounts
+// unless Config.GrossPrices is set.
+//
+// The startDate and endDate should be in YYYY-MM-DD format.
+//
+// Example:
+//
+//	rules, _ := costco.LoadSplitRules("splits.json")
+//	report, err := client.GetSplitReport(ctx, "2025-01-01", "2025-12-31", rules, costco.ReportPeriodMonth)
+//	for _, o := range report.Owed(costco.SplitPartySelf) {
+//	    fmt.Printf("%s: %s owes $%.2f\n", o.Period, o.Party, o.Amount)
+//	}
+func (c *Client) GetSplitReport(ctx context.Context, startDate, endDate string, rules *SplitRules, period ReportPeriod) (*SplitReport, error) {
+	if err := period.validate(); err != nil {
+		return nil, err
+	}
+	if rules == nil {
+		rules = &SplitRules{}
+	}
+
+	transactions, err := c.GetAllTransactionItems(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	periods := make(map[string]map[string]float64)
+	report := &SplitReport{Period: period, Totals: make(map[string]float64)}
+
+	for _, tx := range transactions {
+		parties := make(map[string]float64)
+		var itemTotal float64
+		for _, item := range c.analyticsItems(tx.Items) {
+			if item.IsDiscount() {
+				continue
+			}
+			rules.AllocationFor(item).split(item.Amount, parties)
+			itemTotal += item.Amount
+		}
+
+		// Spread the remainder of the receipt over the parties by their item share
+		remainder := tx.Total - itemTotal
+		if itemTotal != 0 {
+			for party, amount := range parties {
+				parties[party] += remainder * amount / itemTotal
+			}
+		} else {
+			rules.AllocationFor(ReceiptItem{}).split(remainder, parties)
+		}
+
+		label := c.config.Calendar.Label(period, tx.TransactionDate)
+		if periods[label] == nil {
+			periods[label] = make(map[string]float64)
+		}
+		for party, amount := range parties {
+			periods[label][party] += amount
+			report.Totals[party] += amount
+		}
+	}
+
+	for label, parties := range periods {
+		for party, amount := range parties {
+			parties[party] = roundTo(amount, 2)
+		}
+		report.Periods = append(report.Periods, SplitPeriod{Label: label, Parties: parties})
+	}
+	sort.Slice(report.Periods, func(i, j int) bool {
+		return report.Periods[i].Label < report.Periods[j].Label
+	})
+	for party, amount := range report.Totals {
+		report.Totals[party] = roundTo(amount, 2)
+	}
+
+	return report, nil
+}
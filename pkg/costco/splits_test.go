@@ -0,0 +1,78 @@
+package costco
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadSplitRules(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "splits.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+		"departments": {"14": {"me": 50, "roommate": 50}},
+		"items": {"7": {"business": 1}}
+	}`), 0600))
+
+	rules, err := LoadSplitRules(path)
+	require.NoError(t, err)
+	assert.Equal(t, Allocation{"business": 1}, rules.AllocationFor(ReceiptItem{ItemNumber: "7", ItemDepartmentNumber: 14}))
+	assert.Equal(t, Allocation{"me": 50, "roommate": 50}, rules.AllocationFor(ReceiptItem{ItemDepartmentNumber: 14}))
+	assert.Equal(t, Allocation{SplitPartySelf: 1}, rules.AllocationFor(ReceiptItem{ItemDepartmentNumber: 20}))
+}
+
+func TestGetSplitReport(t *testing.T) {
+	details := map[string]map[string]interface{}{
+		"A": {"transactionBarcode": "A", "transactionDateTime": "2025-01-10T10:00:00", "total": 110.00,
+			"itemArray": []map[string]interface{}{
+				{"itemNumber": "1", "itemDescription01": "GROCERIES", "unit": 1, "amount": 60.00, "itemDepartmentNumber": 14},
+				{"itemNumber": "7", "itemDescription01": "PRINTER INK", "unit": 1, "amount": 40.00, "itemDepartmentNumber": 20},
+			}},
+		"B": {"transactionBarcode": "B", "transactionDateTime": "2025-02-10T10:00:00", "total": 30.00,
+			"itemArray": []map[string]interface{}{
+				{"itemNumber": "2", "itemDescription01": "EGGS", "unit": 1, "amount": 30.00, "itemDepartmentNumber": 14},
+			}},
+	}
+
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if barcode, ok := req.Variables["barcode"].(string); ok {
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{details[barcode]}},
+			})
+			return
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{
+				"receipts": []map[string]interface{}{{"transactionBarcode": "A"}, {"transactionBarcode": "B"}},
+			},
+		})
+	})
+
+	rules := &SplitRules{
+		Departments: map[int]Allocation{14: {"me": 1, "roommate": 1}},
+		Items:       map[string]Allocation{"7": {"business": 1}},
+	}
+	report, err := client.GetSplitReport(context.Background(), "2025-01-01", "2025-12-31", rules, ReportPeriodMonth)
+	require.NoError(t, err)
+	require.Len(t, report.Periods, 2)
+
+	// $10 of tax on receipt A follows the item split: 60% shared, 40% business
+	assert.Equal(t, map[string]float64{"me": 33.00, "roommate": 33.00, "business": 44.00}, report.Periods[0].Parties)
+	assert.Equal(t, map[string]float64{"me": 15.00, "roommate": 15.00}, report.Periods[1].Parties)
+	assert.Equal(t, 48.00, report.Totals["roommate"])
+
+	var buf bytes.Buffer
+	require.NoError(t, report.WriteOwedCSV(&buf, SplitPartySelf))
+	assert.Equal(t, "period,party,amount\n2025-01,business,44.00\n2025-01,roommate,33.00\n2025-02,roommate,15.00\n", buf.String())
+
+	_, err = client.GetSplitReport(context.Background(), "2025-01-01", "2025-12-31", rules, "hourly")
+	assert.Error(t, err)
+}
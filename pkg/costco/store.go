@@ -0,0 +1,422 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// Local transaction store used by the analytics helpers when Config.UseLocalStore is set
+
+const transactionsFile = "transactions.json"
+
+// storeDateLayout is the day format used for store ranges and API date arguments.
+const storeDateLayout = "2006-01-02"
+
+// transactionStore is the on-disk layout of ~/.costco/transactions.json. Each document
+// filter ("all/all", "fuel/gas", ...) keeps its own synced range.
+type transactionStore struct {
+	Segments map[string]*storeSegment `json:"segments"`
+	Orders   *orderSegment            `json:"orders,omitempty"` // Online orders, synced by SyncStore
+}
+
+// storeSegment holds the transactions synced for one document filter. Every receipt
+// dated from Start through Watermark has been fetched; later days still come from the API.
+type storeSegment struct {
+	Start        string                 `json:"start"`
+	Watermark    string                 `json:"watermark"`
+	Transactions []TransactionWithItems `json:"transactions"`
+}
+
+// storedTransactions returns the transactions in a date range from the local store,
+// fetching only the days outside the synced range from the API. The range after the
+// watermark is always refetched from the API, so receipts added today show up on the
+// next call. Dates that don't parse as YYYY-MM-DD fall back to a live fetch.
+func (c *Client) storedTransactions(ctx context.Context, startDate, endDate string) ([]TransactionWithItems, error) {
+	documentType, documentSubType := c.documentFilters()
+
+	start, startErr := time.Parse(storeDateLayout, startDate)
+	end, endErr := time.Parse(storeDateLayout, endDate)
+	if startErr != nil || endErr != nil || end.Before(start) {
+		transactions, _, err := c.fetchTransactions(ctx, startDate, endDate, documentType, documentSubType)
+		return transactions, err
+	}
+
+	store, err := loadTransactionStore()
+	if err != nil {
+		return nil, fmt.Errorf("loading transaction store: %w", err)
+	}
+
+	key := documentType + "/" + documentSubType
+	segment := store.Segments[key]
+	if segment == nil {
+		segment = &storeSegment{}
+		store.Segments[key] = segment
+	}
+
+	fetched, failed := 0, 0
+	// fetch merges a range into the segment and returns the first day with a receipt whose
+	// details failed, or "" when every receipt was fetched
+	fetch := func(from, to time.Time) (string, error) {
+		c.getLogger().Info("syncing transaction store",
+			slog.String("filter", key),
+			slog.String("start_date", from.Format(storeDateLayout)),
+			slog.String("end_date", to.Format(storeDateLayout)))
+		transactions, missing, err := c.fetchTransactions(ctx, from.Format(storeDateLayout), to.Format(storeDateLayout), documentType, documentSubType)
+		if err != nil {
+			return "", err
+		}
+		segment.merge(transactions)
+		fetched++
+		failed += len(missing)
+		return firstReceiptDay(missing, from), nil
+	}
+
+	if segment.Start == "" {
+		failedDay, err := fetch(start, end)
+		if err != nil {
+			return nil, err
+		}
+		segment.Start = startDate
+		segment.Watermark = syncedThrough(endDate, failedDay)
+	} else {
+		syncedStart, _ := time.Parse(storeDateLayout, segment.Start)
+		watermark, _ := time.Parse(storeDateLayout, segment.Watermark)
+		if start.Before(syncedStart) {
+			failedDay, err := fetch(start, syncedStart.AddDate(0, 0, -1))
+			if err != nil {
+				return nil, err
+			}
+			if failedDay == "" {
+				segment.Start = startDate
+			}
+		}
+		if end.After(watermark) {
+			failedDay, err := fetch(watermark.AddDate(0, 0, 1), end)
+			if err != nil {
+				return nil, err
+			}
+			segment.Watermark = syncedThrough(endDate, failedDay)
+		}
+	}
+
+	// Today's receipts may still be incomplete, so never mark today as synced
+	yesterday := time.Now().AddDate(0, 0, -1).Format(storeDateLayout)
+	if segment.Watermark > yesterday {
+		segment.Watermark = yesterday
+	}
+
+	// A receipt that failed would never be fetched again once its day is synced, so keep
+	// the stored copy as it was and let the next call retry
+	if failed > 0 {
+		c.getLogger().Warn("not saving transaction store: receipt details failed",
+			slog.String("filter", key),
+			slog.Int("failed", failed))
+	} else if fetched > 0 && !c.config.ReadOnly {
+		if err := saveTransactionStore(store); err != nil {
+			return nil, fmt.Errorf("saving transaction store: %w", err)
+		}
+	}
+
+	var transactions []TransactionWithItems
+	for _, tx := range segment.Transactions {
+		day := tx.TransactionDate.Format(storeDateLayout)
+		if day >= startDate && day <= endDate {
+			transactions = append(transactions, tx)
+		}
+	}
+
+	return transactions, nil
+}
+
+// firstReceiptDay returns the earliest day, as YYYY-MM-DD, of the given receipts, or ""
+// when there are none. Receipts without a readable date count as from.
+func firstReceiptDay(receipts []Receipt, from time.Time) string {
+	first := ""
+	for _, receipt := range receipts {
+		day := from.Format(storeDateLayout)
+		if date, err := time.Parse("2006-01-02T15:04:05", receipt.TransactionDateTime); err == nil {
+			day = date.Format(storeDateLayout)
+		}
+		if first == "" || day < first {
+			first = day
+		}
+	}
+	return first
+}
+
+// syncedThrough returns the watermark after syncing through day: the day itself, or the
+// day before failedDay when a receipt's details couldn't be fetched.
+func syncedThrough(day, failedDay string) string {
+	if failedDay == "" {
+		return day
+	}
+	failed, _ := time.Parse(storeDateLayout, failedDay)
+	if before := failed.AddDate(0, 0, -1).Format(storeDateLayout); before < day {
+		return before
+	}
+	return day
+}
+
+// merge adds transactions to the segment, replacing stored copies with the same Key,
+// and keeps the segment sorted by date. It returns the transactions that weren't stored yet.
+func (s *storeSegment) merge(transactions []TransactionWithItems) []TransactionWithItems {
+	var added []TransactionWithItems
+	index := make(map[string]int, len(s.Transactions))
+	for i, tx := range s.Transactions {
+		index[tx.Key()] = i
+	}
+	for _, tx := range transactions {
+		if i, exists := index[tx.Key()]; exists {
+			s.Transactions[i] = tx
+			continue
+		}
+		index[tx.Key()] = len(s.Transactions)
+		s.Transactions = append(s.Transactions, tx)
+		added = append(added, tx)
+	}
+	sort.SliceStable(s.Transactions, func(i, j int) bool {
+		return s.Transactions[i].TransactionDate.Before(s.Transactions[j].TransactionDate)
+	})
+	return added
+}
+
+// loadTransactionStore reads ~/.costco/transactions.json; a missing file is an empty store.
+func loadTransactionStore() (*transactionStore, error) {
+	store := &transactionStore{}
+
+	configPath, err := getConfigPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(filepath.Join(configPath, transactionsFile))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if err == nil {
+		if err := json.Unmarshal(data, store); err != nil {
+			return nil, err
+		}
+	}
+	if store.Segments == nil {
+		store.Segments = make(map[string]*storeSegment)
+	}
+
+	return store, nil
+}
+
+// saveTransactionStore writes ~/.costco/transactions.json.
+func saveTransactionStore(store *transactionStore) error {
+	if err := ensureConfigDir(); err != nil {
+		return err
+	}
+
+	configPath, err := getConfigPath()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(store)
+	if err != nil {
+		return err
+	}
+
+	filePath := filepath.Join(configPath, transactionsFile)
+	return os.WriteFile(filePath, data, 0600) // Only user can read/write
+}
+
+// ClearTransactionStore deletes the local transaction store used with Config.UseLocalStore,
+// so the next analytics call resyncs from the API. A missing store is not an error.
+func ClearTransactionStore() error {
+	configPath, err := getConfigPath()
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(filepath.Join(configPath, transactionsFile))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// StoreStats describes the local transaction store. This is returned by
+// TransactionStoreStats.
+type StoreStats struct {
+	Path     string              // Location of transactions.json
+	Size     int64               // File size in bytes; 0 when there is no store
+	Segments []StoreSegmentStats // Receipts by document filter, sorted by filter
+	Orders   *StoreSegmentStats  // Online orders synced by SyncStore, or nil
+}
+
+// StoreSegmentStats describes one synced range of the local store.
+type StoreSegmentStats struct {
+	Filter    string // Document filter such as "all/all", or "orders"
+	Start     string // First synced day (YYYY-MM-DD)
+	Watermark string // Last synced day (YYYY-MM-DD)
+	Count     int    // Receipts or orders stored
+	Oldest    string // Date of the oldest stored record, "" when empty
+	Newest    string // Date of the newest stored record, "" when empty
+}
+
+// TransactionStoreStats reports what the local transaction store holds without
+// calling the API. Without a store it returns the path with no segments.
+//
+// Example:
+//
+//	stats, err := costco.TransactionStoreStats()
+//	for _, segment := range stats.Segments {
+//	    fmt.Printf("%s: %d receipts, %s to %s\n", segment.Filter, segment.Count, segment.Start, segment.Watermark)
+//	}
+func TransactionStoreStats() (*StoreStats, error) {
+	configPath, err := getConfigPath()
+	if err != nil {
+		return nil, err
+	}
+	stats := &StoreStats{Path: filepath.Join(configPath, transactionsFile)}
+	if info, err := os.Stat(stats.Path); err == nil {
+		stats.Size = info.Size()
+	}
+
+	store, err := loadTransactionStore()
+	if err != nil {
+		return nil, err
+	}
+	for filter, segment := range store.Segments {
+		segmentStats := StoreSegmentStats{Filter: filter, Start: segment.Start, Watermark: segment.Watermark, Count: len(segment.Transactions)}
+		if n := len(segment.Transactions); n > 0 {
+			segmentStats.Oldest = segment.Transactions[0].TransactionDate.Format(storeDateLayout)
+			segmentStats.Newest = segment.Transactions[n-1].TransactionDate.Format(storeDateLayout)
+		}
+		stats.Segments = append(stats.Segments, segmentStats)
+	}
+	sort.Slice(stats.Segments, func(i, j int) bool { return stats.Segments[i].Filter < stats.Segments[j].Filter })
+
+	if store.Orders != nil {
+		orders := store.Orders.Orders
+		stats.Orders = &StoreSegmentStats{Filter: "orders", Start: store.Orders.Start, Watermark: store.Orders.Watermark, Count: len(orders)}
+		if n := len(orders); n > 0 {
+			stats.Orders.Oldest = orderDay(orders[0])
+			stats.Orders.Newest = orderDay(orders[n-1])
+		}
+	}
+	return stats, nil
+}
+
+// PruneTransactionStore removes receipts and online orders dated before cutoff from
+// the local store and moves each synced range to start at cutoff, so analytics for
+// earlier dates fetch from the API again. It returns the number of records removed.
+//
+// Example:
+//
+//	removed, err := costco.PruneTransactionStore(time.Now().AddDate(-2, 0, 0))
+func PruneTransactionStore(cutoff time.Time) (int, error) {
+	store, err := loadTransactionStore()
+	if err != nil {
+		return 0, err
+	}
+	day := cutoff.Format(storeDateLayout)
+
+	removed, changed := 0, false
+	for filter, segment := range store.Segments {
+		kept := segment.Transactions[:0]
+		for _, tx := range segment.Transactions {
+			if tx.TransactionDate.Format(storeDateLayout) >= day {
+				kept = append(kept, tx)
+			}
+		}
+		if dropped := len(segment.Transactions) - len(kept); dropped > 0 {
+			removed += dropped
+			changed = true
+		}
+		segment.Transactions = kept
+		switch {
+		case segment.Start == "":
+			// Only imported history; there is no synced range to trim
+		case segment.Watermark < day:
+			delete(store.Segments, filter) // Nothing synced is left
+			changed = true
+		case segment.Start < day:
+			segment.Start = day
+			changed = true
+		}
+	}
+
+	if store.Orders != nil {
+		kept := store.Orders.Orders[:0]
+		for _, order := range store.Orders.Orders {
+			if orderDay(order) >= day {
+				kept = append(kept, order)
+			}
+		}
+		removed += len(store.Orders.Orders) - len(kept)
+		store.Orders.Orders = kept
+		switch {
+		case store.Orders.Watermark < day:
+			store.Orders = nil
+			changed = true
+		case store.Orders.Start < day:
+			store.Orders.Start = day
+			changed = true
+		}
+	}
+
+	if !changed {
+		return 0, nil
+	}
+	if err := saveTransactionStore(store); err != nil {
+		return 0, err
+	}
+	return removed, nil
+}
+
+// orderDay returns the YYYY-MM-DD date an online order was placed.
+func orderDay(order OnlineOrder) string {
+	return order.OrderPlacedDate[:min(len(storeDateLayout), len(order.OrderPlacedDate))]
+}
+
+// RecentStoredBarcodes returns the barcodes of the newest receipts in the local
+// transaction store, newest first and without duplicates, up to limit (0 for all).
+// It never calls the API, which makes it cheap enough for shell completion; without
+// a store it returns nothing.
+func RecentStoredBarcodes(limit int) ([]string, error) {
+	store, err := loadTransactionStore()
+	if err != nil {
+		return nil, err
+	}
+
+	var receipts []TransactionWithItems
+	for _, segment := range store.Segments {
+		for _, tx := range segment.Transactions {
+			if tx.Source != TransactionSourceBusinessDelivery && tx.TransactionBarcode != "" {
+				receipts = append(receipts, tx)
+			}
+		}
+	}
+	sort.SliceStable(receipts, func(i, j int) bool {
+		if !receipts[i].TransactionDate.Equal(receipts[j].TransactionDate) {
+			return receipts[i].TransactionDate.After(receipts[j].TransactionDate)
+		}
+		return receipts[i].TransactionBarcode < receipts[j].TransactionBarcode
+	})
+
+	var barcodes []string
+	seen := make(map[string]bool)
+	for _, tx := range receipts {
+		if seen[tx.TransactionBarcode] {
+			continue
+		}
+		seen[tx.TransactionBarcode] = true
+		barcodes = append(barcodes, tx.TransactionBarcode)
+		if len(barcodes) == limit {
+			break
+		}
+	}
+	return barcodes, nil
+}
@@ -0,0 +1,273 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetAllTransactionItems_LocalStore(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
+	dates := map[string]string{
+		"DEC": "2024-12-20T10:00:00",
+		"JAN": "2025-01-15T10:00:00",
+		"FEB": "2025-02-10T10:00:00",
+	}
+
+	var mu sync.Mutex
+	var listed [][2]string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if barcode, ok := req.Variables["barcode"].(string); ok {
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{
+					"receipts": []map[string]interface{}{{
+						"transactionBarcode":  barcode,
+						"transactionDateTime": dates[barcode],
+						"total":               10.00,
+					}},
+				},
+			})
+			return
+		}
+
+		start, end := receiptsRequestDate(t, req, "startDate"), receiptsRequestDate(t, req, "endDate")
+		mu.Lock()
+		listed = append(listed, [2]string{start, end})
+		mu.Unlock()
+		var receipts []map[string]interface{}
+		for barcode, date := range dates {
+			if day := date[:10]; day >= start && day <= end {
+				receipts = append(receipts, map[string]interface{}{"transactionBarcode": barcode})
+			}
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{"receipts": receipts},
+		})
+	}
+	client := newMockClient(t, Config{UseLocalStore: true}, handler)
+	ctx := context.Background()
+	barcodes := func(transactions []TransactionWithItems) []string {
+		var result []string
+		for _, tx := range transactions {
+			result = append(result, tx.TransactionBarcode)
+		}
+		return result
+	}
+
+	transactions, err := client.GetAllTransactionItems(ctx, "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"JAN"}, barcodes(transactions))
+
+	// Already synced: served entirely from the store
+	transactions, err = client.GetAllTransactionItems(ctx, "2025-01-10", "2025-01-20")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"JAN"}, barcodes(transactions))
+
+	// Only the days after the watermark and before the synced range are fetched
+	transactions, err = client.GetAllTransactionItems(ctx, "2024-12-01", "2025-02-28")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"DEC", "JAN", "FEB"}, barcodes(transactions))
+
+	assert.ElementsMatch(t, [][2]string{
+		{"2025-01-01", "2025-01-31"},
+		{"2024-12-01", "2024-12-31"},
+		{"2025-02-01", "2025-02-28"},
+	}, listed)
+
+	// A new client reads the persisted store
+	listed = nil
+	client = newMockClient(t, Config{UseLocalStore: true}, handler)
+	transactions, err = client.GetAllTransactionItems(ctx, "2024-12-15", "2025-01-31")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"DEC", "JAN"}, barcodes(transactions))
+	assert.Empty(t, listed)
+
+	// Other document filters are synced separately
+	client = newMockClient(t, Config{UseLocalStore: true, DocumentType: DocumentTypeFuel}, handler)
+	_, err = client.GetAllTransactionItems(ctx, "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+	assert.Equal(t, [][2]string{{"2025-01-01", "2025-01-31"}}, listed)
+
+	require.NoError(t, ClearTransactionStore())
+	configPath, err := getConfigPath()
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(configPath, transactionsFile))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestGetAllTransactionItems_LocalStoreRetriesFailedDetails(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
+	var mu sync.Mutex
+	failures := 1
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if barcode, ok := req.Variables["barcode"].(string); ok {
+			mu.Lock()
+			fail := barcode == "JAN20" && failures > 0
+			if fail {
+				failures--
+			}
+			mu.Unlock()
+			if fail {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{
+					"receipts": []map[string]interface{}{{
+						"transactionBarcode":  barcode,
+						"transactionDateTime": "2025-01-" + barcode[3:] + "T10:00:00",
+						"total":               10.00,
+					}},
+				},
+			})
+			return
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{
+				"receipts": []map[string]interface{}{
+					{"transactionBarcode": "JAN05", "transactionDateTime": "2025-01-05T10:00:00"},
+					{"transactionBarcode": "JAN20", "transactionDateTime": "2025-01-20T10:00:00"},
+				},
+			},
+		})
+	}
+	client := newMockClient(t, Config{UseLocalStore: true}, handler)
+
+	transactions, err := client.GetAllTransactionItems(context.Background(), "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+	assert.Len(t, transactions, 1, "the failed receipt is missing from this call")
+	store, err := loadTransactionStore()
+	require.NoError(t, err)
+	assert.Empty(t, store.Segments, "the store isn't saved while a receipt is missing")
+
+	transactions, err = client.GetAllTransactionItems(context.Background(), "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+	require.Len(t, transactions, 2, "the next call fetches the receipt again")
+	assert.Equal(t, "JAN20", transactions[1].TransactionBarcode)
+	store, err = loadTransactionStore()
+	require.NoError(t, err)
+	assert.Equal(t, "2025-01-31", store.Segments["all/all"].Watermark)
+}
+
+func TestSyncedThrough(t *testing.T) {
+	assert.Equal(t, "2025-01-31", syncedThrough("2025-01-31", ""))
+	assert.Equal(t, "2025-01-19", syncedThrough("2025-01-31", "2025-01-20"))
+	assert.Equal(t, "2024-12-31", syncedThrough("2025-01-31", "2025-01-01"))
+	assert.Equal(t, "2025-01-31", syncedThrough("2025-01-31", "2025-02-03"))
+}
+
+func TestGetAllTransactionItems_LocalStoreReadOnly(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
+	client := newMockClient(t, Config{UseLocalStore: true, ReadOnly: true}, func(w http.ResponseWriter, r *http.Request) {
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{}},
+		})
+	})
+
+	_, err := client.GetAllTransactionItems(context.Background(), "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+
+	configPath, err := getConfigPath()
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(configPath, transactionsFile))
+	assert.True(t, os.IsNotExist(err), "read-only clients must not write the store")
+}
+
+func TestRecentStoredBarcodes(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
+	barcodes, err := RecentStoredBarcodes(10)
+	require.NoError(t, err)
+	assert.Empty(t, barcodes, "no store yet")
+
+	day := func(d int) time.Time { return time.Date(2025, 1, d, 0, 0, 0, 0, time.UTC) }
+	require.NoError(t, saveTransactionStore(&transactionStore{Segments: map[string]*storeSegment{
+		"all/all": {Transactions: []TransactionWithItems{
+			{TransactionBarcode: "A", TransactionDate: day(1)},
+			{TransactionBarcode: "C", TransactionDate: day(3)},
+			{TransactionBarcode: "BD", TransactionDate: day(4), Source: TransactionSourceBusinessDelivery},
+		}},
+		"fuel/gas": {Transactions: []TransactionWithItems{
+			{TransactionBarcode: "B", TransactionDate: day(2)},
+			{TransactionBarcode: "C", TransactionDate: day(3)},
+		}},
+	}}))
+
+	barcodes, err = RecentStoredBarcodes(0)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"C", "B", "A"}, barcodes)
+
+	barcodes, err = RecentStoredBarcodes(2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"C", "B"}, barcodes)
+}
+
+func TestTransactionStoreStatsAndPrune(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
+	stats, err := TransactionStoreStats()
+	require.NoError(t, err)
+	assert.Zero(t, stats.Size)
+	assert.Empty(t, stats.Segments)
+	assert.Nil(t, stats.Orders)
+
+	day := func(y, m, d int) time.Time { return time.Date(y, time.Month(m), d, 0, 0, 0, 0, time.UTC) }
+	require.NoError(t, saveTransactionStore(&transactionStore{
+		Segments: map[string]*storeSegment{
+			"all/all": {Start: "2022-01-01", Watermark: "2025-03-01", Transactions: []TransactionWithItems{
+				{TransactionBarcode: "OLD", TransactionDate: day(2022, 5, 1)},
+				{TransactionBarcode: "NEW", TransactionDate: day(2025, 2, 1)},
+			}},
+			"fuel/gas": {Start: "2022-01-01", Watermark: "2022-12-31", Transactions: []TransactionWithItems{
+				{TransactionBarcode: "GAS", TransactionDate: day(2022, 6, 1)},
+			}},
+		},
+		Orders: &orderSegment{Start: "2022-01-01", Watermark: "2025-03-01", Orders: []OnlineOrder{
+			{OrderNumber: "O1", OrderPlacedDate: "2022-02-01T10:00:00"},
+			{OrderNumber: "O2", OrderPlacedDate: "2025-01-15T10:00:00"},
+		}},
+	}))
+
+	stats, err = TransactionStoreStats()
+	require.NoError(t, err)
+	assert.Positive(t, stats.Size)
+	require.Len(t, stats.Segments, 2)
+	assert.Equal(t, StoreSegmentStats{Filter: "all/all", Start: "2022-01-01", Watermark: "2025-03-01", Count: 2, Oldest: "2022-05-01", Newest: "2025-02-01"}, stats.Segments[0])
+	assert.Equal(t, "fuel/gas", stats.Segments[1].Filter)
+	assert.Equal(t, &StoreSegmentStats{Filter: "orders", Start: "2022-01-01", Watermark: "2025-03-01", Count: 2, Oldest: "2022-02-01", Newest: "2025-01-15"}, stats.Orders)
+
+	removed, err := PruneTransactionStore(day(2023, 3, 1))
+	require.NoError(t, err)
+	assert.Equal(t, 3, removed)
+
+	stats, err = TransactionStoreStats()
+	require.NoError(t, err)
+	require.Len(t, stats.Segments, 1, "fuel range ended before the cutoff")
+	assert.Equal(t, StoreSegmentStats{Filter: "all/all", Start: "2023-03-01", Watermark: "2025-03-01", Count: 1, Oldest: "2025-02-01", Newest: "2025-02-01"}, stats.Segments[0])
+	assert.Equal(t, "2023-03-01", stats.Orders.Start)
+	assert.Equal(t, 1, stats.Orders.Count)
+
+	removed, err = PruneTransactionStore(day(2023, 3, 1))
+	require.NoError(t, err)
+	assert.Zero(t, removed)
+}
@@ -0,0 +1,284 @@
+package costco
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+)
+
+// Streaming transactions and incremental aggregation for long date ranges
+
+// StreamTransactionItems is the streaming form of GetAllTransactionItems. Transactions
+// are sent as their details arrive, so only the receipts being fetched are held in
+// memory; they are not in receipt order. Repeats of the same transaction (see
+// TransactionWithItems.Key) are sent once. The error channel yields at most one error
+// after the transaction channel closes. Cancel ctx to stop early.
+//
+// With Config.UseLocalStore the range is synced and read from the store first, as
+// GetAllTransactionItems does, and then streamed.
+//
+// Example:
+//
+//	txs, errs := client.StreamTransactionItems(ctx, "2020-01-01", "2025-12-31")
+//	for tx := range txs {
+//	    total += tx.Total
+//	}
+//	if err := <-errs; err != nil {
+//	    return err
+//	}
+func (c *Client) StreamTransactionItems(ctx context.Context, startDate, endDate string) (<-chan TransactionWithItems, <-chan error) {
+	out := make(chan TransactionWithItems)
+	errc := make(chan error, 1)
+
+	go func() {
+		defer close(errc)
+		defer close(out)
+		if err := c.streamTransactions(ctx, startDate, endDate, out); err != nil {
+			errc <- err
+		}
+	}()
+
+	return out, errc
+}
+
+func (c *Client) streamTransactions(ctx context.Context, startDate, endDate string, out chan<- TransactionWithItems) error {
+	// Tags and notes are attached, and Config.Tags applied, as GetAllTransactionItems does
+	keep, err := c.tagFilter()
+	if err != nil {
+		return err
+	}
+	fillNotes := c.noteFiller()
+
+	// Only keys are kept, so deduplication stays small next to the transactions themselves
+	var mu sync.Mutex
+	seen := make(map[string]bool)
+	send := func(tx TransactionWithItems) {
+		mu.Lock()
+		repeat := seen[tx.Key()]
+		seen[tx.Key()] = true
+		mu.Unlock()
+		if repeat || !keep(&tx) {
+			return
+		}
+		fillNotes(&tx)
+		select {
+		case out <- tx:
+		case <-ctx.Done():
+		}
+	}
+
+	if c.config.UseLocalStore {
+		transactions, err := c.storedTransactions(ctx, startDate, endDate)
+		if err != nil {
+			return err
+		}
+		for _, tx := range transactions {
+			send(tx)
+		}
+	} else {
+		filterType, filterSubType := c.documentFilters()
+		pending, err := c.detailReceipts(ctx, startDate, endDate, filterType, filterSubType)
+		if err != nil {
+			return err
+		}
+		c.fetchDetails(ctx, pending, func(_ int, tx TransactionWithItems) {
+			send(tx)
+		})
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if c.config.IncludeBusinessDelivery {
+		orders, err := c.GetBusinessDeliveryOrders(ctx, startDate, endDate)
+		if err != nil {
+			return fmt.Errorf("getting business delivery orders: %w", err)
+		}
+		for _, order := range orders {
+			send(order.Transaction())
+		}
+	}
+	if c.config.IncludeServiceOrders {
+		for _, tx := range c.serviceOrderTransactions(ctx, startDate, endDate) {
+			send(tx)
+		}
+	}
+
+	return ctx.Err()
+}
+
+// Aggregator folds transactions into a result one at a time. Transactions arrive in no
+// particular order. See Client.Aggregate.
+type Aggregator interface {
+	Add(tx TransactionWithItems)
+}
+
+// Aggregate streams the transactions in a date range into each aggregator, so results
+// for multi-year ranges are built without holding every transaction in memory.
+//
+// The startDate and endDate should be in YYYY-MM-DD format.
+//
+// Example:
+//
+//	frequent := client.NewFrequencyAggregator()
+//	departments := client.NewDepartmentAggregator()
+//	prices := costco.NewPriceHistoryAggregator("12345")
+//	err := client.Aggregate(ctx, "2015-01-01", "2025-12-31", frequent, departments, prices)
+//	top := frequent.Result(10)
+func (c *Client) Aggregate(ctx context.Context, startDate, endDate string, aggregators ...Aggregator) error {
+	transactions, errs := c.StreamTransactionItems(ctx, startDate, endDate)
+	for tx := range transactions {
+		for _, aggregator := range aggregators {
+			aggregator.Add(tx)
+		}
+	}
+	return <-errs
+}
+
+// FrequencyAggregator counts purchases per item, as GetFrequentItems reports them.
+type FrequencyAggregator struct {
+	client *Client
+	items  map[string]*FrequentItem
+}
+
+// NewFrequencyAggregator returns a FrequencyAggregator that honors the client's
+// Locale and GrossPrices settings.
+func (c *Client) NewFrequencyAggregator() *FrequencyAggregator {
+	return &FrequencyAggregator{client: c, items: make(map[string]*FrequentItem)}
+}
+
+// Add counts the items on one transaction.
+func (a *FrequencyAggregator) Add(tx TransactionWithItems) {
+	for _, item := range a.client.analyticsItems(tx.Items) {
+		if item.IsDiscount() {
+			continue
+		}
+		stats, exists := a.items[item.ItemNumber]
+		if !exists {
+			stats = &FrequentItem{
+				ItemNumber:      item.ItemNumber,
+				ItemDescription: a.client.itemName(item),
+			}
+			a.items[item.ItemNumber] = stats
+		}
+		stats.TotalQuantity += item.Unit
+		stats.TotalSpent += item.Amount
+		if item.Unit > 0 {
+			stats.PurchaseCount++
+		}
+	}
+}
+
+// Result returns the items sorted by purchase count, at most limit of them (0 = all).
+func (a *FrequencyAggregator) Result(limit int) []FrequentItem {
+	items := make([]FrequentItem, 0, len(a.items))
+	for _, stats := range a.items {
+		items = append(items, *stats)
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].PurchaseCount > items[j].PurchaseCount
+	})
+
+	if limit > 0 && limit < len(items) {
+		return items[:limit]
+	}
+	return items
+}
+
+// DepartmentAggregator totals receipt spending per department, as GetSpendingSummary
+// reports it. Photo Center and optical orders are not receipts and are not included.
+type DepartmentAggregator struct {
+	client  *Client
+	summary map[int]SpendingByDepartment
+}
+
+// NewDepartmentAggregator returns a DepartmentAggregator that honors the client's
+// GrossPrices setting.
+func (c *Client) NewDepartmentAggregator() *DepartmentAggregator {
+	return &DepartmentAggregator{client: c, summary: make(map[int]SpendingByDepartment)}
+}
+
+// Add totals the items on one transaction.
+func (a *DepartmentAggregator) Add(tx TransactionWithItems) {
+	for _, item := range a.client.analyticsItems(tx.Items) {
+		dept := item.ItemDepartmentNumber
+		current := a.summary[dept]
+		current.Department = DepartmentName(dept)
+		current.Total += item.Amount
+		current.ItemCount += item.Unit
+		a.summary[dept] = current
+	}
+}
+
+// Result returns the spending keyed by department number.
+func (a *DepartmentAggregator) Result() map[int]SpendingByDepartment {
+	return a.summary
+}
+
+// PriceHistoryAggregator collects the unit prices paid for one item, as
+// GetItemPriceHistory reports them.
+type PriceHistoryAggregator struct {
+	history ItemPriceHistory
+	spent   float64
+	units   int
+}
+
+// NewPriceHistoryAggregator returns a PriceHistoryAggregator for itemNumber.
+func NewPriceHistoryAggregator(itemNumber string) *PriceHistoryAggregator {
+	return &PriceHistoryAggregator{history: ItemPriceHistory{ItemNumber: itemNumber}}
+}
+
+// Add records the item's purchases on one transaction.
+func (a *PriceHistoryAggregator) Add(tx TransactionWithItems) {
+	for _, purchase := range purchasesByItem([]TransactionWithItems{tx})[a.history.ItemNumber] {
+		a.addPurchase(purchase)
+	}
+}
+
+// addPurchase records one purchase; returns and other non-positive quantities are skipped.
+func (a *PriceHistoryAggregator) addPurchase(purchase ItemPurchase) {
+	if purchase.Quantity <= 0 {
+		return
+	}
+	paid := purchase.Price + purchase.Discount
+	point := ItemPricePoint{
+		Date:      purchase.Date,
+		UnitPrice: math.Round(paid/float64(purchase.Quantity)*100) / 100,
+		Quantity:  purchase.Quantity,
+		Warehouse: purchase.Warehouse,
+		Barcode:   purchase.Barcode,
+	}
+	if purchase.Size.Packs > 0 {
+		point.SizePrice = purchase.Size.unitPrice(paid / float64(purchase.Quantity))
+	}
+	if len(a.history.Points) == 0 || point.UnitPrice < a.history.Min {
+		a.history.Min = point.UnitPrice
+	}
+	if point.UnitPrice > a.history.Max {
+		a.history.Max = point.UnitPrice
+	}
+	a.spent += paid
+	a.units += purchase.Quantity
+	a.history.Points = append(a.history.Points, point)
+}
+
+// Result returns the price history with points in chronological order.
+func (a *PriceHistoryAggregator) Result() *ItemPriceHistory {
+	history := a.history
+	history.Points = append([]ItemPricePoint(nil), a.history.Points...)
+	if a.units > 0 {
+		history.Average = math.Round(a.spent/float64(a.units)*100) / 100
+	}
+
+	sort.SliceStable(history.Points, func(i, j int) bool {
+		if history.Points[i].Date != history.Points[j].Date {
+			return history.Points[i].Date < history.Points[j].Date
+		}
+		return history.Points[i].Barcode < history.Points[j].Barcode
+	})
+
+	return &history
+}
@@ -0,0 +1,137 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newStreamTestClient(t *testing.T, receipts int) *Client {
+	t.Helper()
+	return newMockClient(t, Config{DetailWorkers: 3}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if barcode, ok := req.Variables["barcode"].(string); ok {
+			var day int
+			fmt.Sscanf(barcode, "R%d", &day)
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{"receipts": []map[string]interface{}{{
+					"transactionBarcode":  barcode,
+					"transactionDateTime": fmt.Sprintf("2025-01-%02dT10:00:00", day),
+					"total":               15.00,
+					"itemArray": []map[string]interface{}{
+						{"itemNumber": "1", "itemDescription01": "EGGS", "itemDepartmentNumber": 17, "unit": 1, "amount": 5.00 + float64(day)},
+						{"itemNumber": "2", "itemDescription01": "MILK", "itemDepartmentNumber": 17, "unit": 2, "amount": 10.00},
+					},
+				}}},
+			})
+			return
+		}
+		var list []map[string]interface{}
+		for day := 1; day <= receipts; day++ {
+			list = append(list, map[string]interface{}{"transactionBarcode": fmt.Sprintf("R%d", day)})
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{"receipts": list},
+		})
+	})
+}
+
+func TestStreamTransactionItems(t *testing.T) {
+	client := newStreamTestClient(t, 10)
+
+	txs, errs := client.StreamTransactionItems(context.Background(), "2025-01-01", "2025-01-31")
+	seen := make(map[string]bool)
+	for tx := range txs {
+		seen[tx.TransactionBarcode] = true
+	}
+	require.NoError(t, <-errs)
+	assert.Len(t, seen, 10)
+}
+
+func TestStreamTransactionItems_Cancel(t *testing.T) {
+	client := newStreamTestClient(t, 10)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	txs, errs := client.StreamTransactionItems(ctx, "2025-01-01", "2025-01-31")
+	<-txs
+	cancel()
+	for range txs {
+	}
+	assert.ErrorIs(t, <-errs, context.Canceled)
+}
+
+func TestAggregate(t *testing.T) {
+	client := newStreamTestClient(t, 4)
+	ctx := context.Background()
+
+	frequent := client.NewFrequencyAggregator()
+	departments := client.NewDepartmentAggregator()
+	prices := NewPriceHistoryAggregator("1")
+	require.NoError(t, client.Aggregate(ctx, "2025-01-01", "2025-01-31", frequent, departments, prices))
+
+	items := frequent.Result(0)
+	require.Len(t, items, 2)
+	assert.Equal(t, 4, items[0].PurchaseCount)
+	assert.Equal(t, 4, items[1].PurchaseCount)
+	assert.Len(t, frequent.Result(1), 1)
+
+	assert.InDelta(t, 70.00, departments.Result()[17].Total, 0.001)
+	assert.Equal(t, 12, departments.Result()[17].ItemCount)
+
+	history := prices.Result()
+	require.Len(t, history.Points, 4)
+	assert.Equal(t, "2025-01-01", history.Points[0].Date)
+	assert.Equal(t, "2025-01-04", history.Points[3].Date)
+	assert.Equal(t, 6.00, history.Min)
+	assert.Equal(t, 9.00, history.Max)
+	assert.Equal(t, 7.50, history.Average)
+
+	// The slice-based helpers agree with the streamed results
+	want, err := client.GetItemPriceHistory(ctx, "1", "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+	assert.Equal(t, want, history)
+}
+
+func TestStreamTransactionItems_TagsAndNotes(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
+	_, err := TagTransaction("R2", "trip")
+	require.NoError(t, err)
+	_, err = TagTransaction("R5", "trip", "party")
+	require.NoError(t, err)
+	require.NoError(t, SetNote("R5", "", "cake"))
+
+	client := newStreamTestClient(t, 6)
+	client.config.Tags = []string{"Trip"}
+	ctx := context.Background()
+
+	batch, err := client.GetAllTransactionItems(ctx, "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+	require.Len(t, batch, 2)
+
+	txs, errs := client.StreamTransactionItems(ctx, "2025-01-01", "2025-01-31")
+	streamed := make(map[string]TransactionWithItems)
+	for tx := range txs {
+		streamed[tx.TransactionBarcode] = tx
+	}
+	require.NoError(t, <-errs)
+	require.Len(t, streamed, len(batch))
+	for _, tx := range batch {
+		assert.Equal(t, tx, streamed[tx.TransactionBarcode])
+	}
+	assert.Equal(t, []string{"party", "trip"}, streamed["R5"].Tags)
+	assert.Equal(t, "cake", streamed["R5"].Note)
+
+	departments := client.NewDepartmentAggregator()
+	require.NoError(t, client.Aggregate(ctx, "2025-01-01", "2025-01-31", departments))
+	want, err := client.GetSpendingSummary(ctx, "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+	assert.Equal(t, want, departments.Result())
+}
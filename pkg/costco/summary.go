@@ -0,0 +1,72 @@
+package costco
+
+import (
+	"context"
+	"sort"
+)
+
+// One-call overview of a date range
+
+// Summary is an overview of the spending in a date range.
+// This is returned by GetSummary.
+type Summary struct {
+	StartDate string
+	EndDate   string
+	SpendingPeriod
+	Savings     Savings                // Instant savings, discount lines, and coupons
+	Departments []SpendingByDepartment // Largest total first
+}
+
+// GetSummary reports the totals, trips, top items, savings, and spend by department
+// for a date range, fetching the transactions once. The figures match
+// GetSpendingReport's Total, GetSavingsSummary, and GetSpendingSummary for the same
+// range, except that Departments only covers receipts (not Photo Center or optical orders).
+//
+// The startDate and endDate should be in YYYY-MM-DD format.
+//
+// Example:
+//
+//	summary, err := client.GetSummary(ctx, "2025-01-01", "2025-01-31")
+//	fmt.Printf("$%.2f over %d trips, saved $%.2f\n", summary.Total, summary.TripCount, summary.Savings.Total)
+//	for _, d := range summary.Departments {
+//	    fmt.Printf("  %s: $%.2f\n", d.Department, d.Total)
+//	}
+func (c *Client) GetSummary(ctx context.Context, startDate, endDate string) (*Summary, error) {
+	transactions, err := c.GetAllTransactionItems(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	return c.summarize(startDate, endDate, transactions), nil
+}
+
+// summarize builds GetSummary's overview from transactions already fetched.
+func (c *Client) summarize(startDate, endDate string, transactions []TransactionWithItems) *Summary {
+	totals := newSpendingAccumulator("")
+	departments := c.NewDepartmentAggregator()
+	var savings Savings
+	for _, tx := range transactions {
+		savings.add(transactionSavings(tx))
+		departments.Add(tx)
+		tx.Items = c.analyticsItems(tx.Items)
+		totals.add(tx, c.itemName)
+	}
+
+	summary := &Summary{
+		StartDate:      startDate,
+		EndDate:        endDate,
+		SpendingPeriod: totals.result(),
+		Savings:        savings.rounded(),
+	}
+	for _, dept := range departments.Result() {
+		dept.Total = roundTo(dept.Total, 2)
+		summary.Departments = append(summary.Departments, dept)
+	}
+	sort.Slice(summary.Departments, func(i, j int) bool {
+		if summary.Departments[i].Total != summary.Departments[j].Total {
+			return summary.Departments[i].Total > summary.Departments[j].Total
+		}
+		return summary.Departments[i].Department < summary.Departments[j].Department
+	})
+
+	return summary
+}
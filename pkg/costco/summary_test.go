@@ -0,0 +1,29 @@
+package costco
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSummary(t *testing.T) {
+	client := newStreamTestClient(t, 2)
+
+	summary, err := client.GetSummary(context.Background(), "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+
+	assert.Equal(t, "2025-01-01", summary.StartDate)
+	assert.Equal(t, 30.00, summary.Total)
+	assert.Equal(t, 2, summary.TripCount)
+	assert.Equal(t, 15.00, summary.AverageBasket)
+
+	require.Len(t, summary.TopItems, 2)
+	assert.Equal(t, "2", summary.TopItems[0].ItemNumber)
+	assert.Equal(t, 20.00, summary.TopItems[0].TotalSpent)
+	assert.Equal(t, "Eggs", summary.TopItems[1].ItemDescription)
+
+	assert.Equal(t, []SpendingByDepartment{{Department: DepartmentName(17), Total: 33.00, ItemCount: 6}}, summary.Departments)
+	assert.Equal(t, Savings{}, summary.Savings)
+}
@@ -0,0 +1,184 @@
+package costco
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+)
+
+// Explicit syncing of the local transaction store
+
+// orderSegment holds the online orders synced into the local store. Every order placed
+// from Start through Watermark has been fetched.
+type orderSegment struct {
+	Start     string        `json:"start"`
+	Watermark string        `json:"watermark"`
+	Orders    []OnlineOrder `json:"orders"`
+}
+
+// merge adds orders to the segment, replacing stored copies with the same order number
+// so status changes are picked up, and keeps the segment sorted by placed date. It
+// returns the orders that weren't stored yet.
+func (s *orderSegment) merge(orders []OnlineOrder) []OnlineOrder {
+	var added []OnlineOrder
+	index := make(map[string]int, len(s.Orders))
+	for i, order := range s.Orders {
+		index[order.OrderNumber] = i
+	}
+	for _, order := range orders {
+		if i, exists := index[order.OrderNumber]; exists {
+			s.Orders[i] = order
+			continue
+		}
+		index[order.OrderNumber] = len(s.Orders)
+		s.Orders = append(s.Orders, order)
+		added = append(added, order)
+	}
+	sort.SliceStable(s.Orders, func(i, j int) bool {
+		return s.Orders[i].OrderPlacedDate < s.Orders[j].OrderPlacedDate
+	})
+	return added
+}
+
+// SyncResult reports what SyncStore added to the local transaction store.
+type SyncResult struct {
+	StartDate     string                 // First day fetched from the API
+	EndDate       string                 // Last day fetched (today)
+	NewReceipts   []TransactionWithItems // Receipts that weren't stored yet, chronological
+	NewOrders     []OnlineOrder          // Online orders that weren't stored yet, by placed date
+	TotalReceipts int                    // Receipts in the store after the sync
+	TotalOrders   int                    // Online orders in the store after the sync
+
+	// FailedReceipts counts receipts whose details couldn't be fetched. The store is
+	// synced only up to the day before the first of them, so the next sync retries them.
+	FailedReceipts int
+}
+
+// SyncStore brings the local transaction store (~/.costco/transactions.json) up to date
+// with the API. Receipts matching the client's document filters and online orders
+// are fetched from the day after the last sync through today; the first sync starts
+// at startDate. With full set, everything from startDate (or from the start of the
+// store when startDate is "") is fetched again, which backfills older history and
+// refreshes receipts already stored.
+//
+// Analytics helpers read the same store when Config.UseLocalStore is set, so a
+// scheduled SyncStore keeps them fast and available offline.
+//
+// The startDate should be in YYYY-MM-DD format.
+//
+// Example:
+//
+//	result, err := client.SyncStore(ctx, "2024-01-01", false)
+//	fmt.Printf("%d new receipts, %d new orders\n", len(result.NewReceipts), len(result.NewOrders))
+func (c *Client) SyncStore(ctx context.Context, startDate string, full bool) (*SyncResult, error) {
+	if c.config.ReadOnly {
+		return nil, errors.New("cannot sync the transaction store with a read-only client")
+	}
+	if startDate != "" {
+		if _, err := time.Parse(storeDateLayout, startDate); err != nil {
+			return nil, fmt.Errorf("invalid start date %q: %w", startDate, err)
+		}
+	}
+
+	store, err := loadTransactionStore()
+	if err != nil {
+		return nil, fmt.Errorf("loading transaction store: %w", err)
+	}
+
+	documentType, documentSubType := c.documentFilters()
+	key := documentType + "/" + documentSubType
+	segment := store.Segments[key]
+	if segment == nil {
+		segment = &storeSegment{}
+		store.Segments[key] = segment
+	}
+	if store.Orders == nil {
+		store.Orders = &orderSegment{}
+	}
+
+	now := time.Now()
+	today := now.Format(storeDateLayout)
+	// Today's purchases may still be incomplete, so never mark today as synced
+	yesterday := now.AddDate(0, 0, -1).Format(storeDateLayout)
+	result := &SyncResult{EndDate: today}
+
+	receiptsFrom, err := syncFrom(segment.Start, segment.Watermark, startDate, full)
+	if err != nil {
+		return nil, err
+	}
+	ordersFrom, err := syncFrom(store.Orders.Start, store.Orders.Watermark, startDate, full)
+	if err != nil {
+		return nil, err
+	}
+	result.StartDate = min(receiptsFrom, ordersFrom)
+
+	if receiptsFrom <= today {
+		c.getLogger().Info("syncing transaction store",
+			slog.String("filter", key),
+			slog.String("start_date", receiptsFrom),
+			slog.String("end_date", today))
+		transactions, failed, err := c.fetchTransactions(ctx, receiptsFrom, today, documentType, documentSubType)
+		if err != nil {
+			return nil, err
+		}
+		result.FailedReceipts = len(failed)
+		result.NewReceipts = segment.merge(transactions)
+		sort.SliceStable(result.NewReceipts, func(i, j int) bool {
+			return result.NewReceipts[i].TransactionDate.Before(result.NewReceipts[j].TransactionDate)
+		})
+		if segment.Start == "" || receiptsFrom < segment.Start {
+			segment.Start = receiptsFrom
+		}
+		from, _ := time.Parse(storeDateLayout, receiptsFrom)
+		segment.Watermark = syncedThrough(yesterday, firstReceiptDay(failed, from))
+	}
+
+	if ordersFrom <= today {
+		c.getLogger().Info("syncing online orders",
+			slog.String("start_date", ordersFrom),
+			slog.String("end_date", today))
+		orders, err := c.getAllOnlineOrders(ctx, ordersFrom, today)
+		if err != nil {
+			return nil, err
+		}
+		result.NewOrders = store.Orders.merge(orders)
+		sort.SliceStable(result.NewOrders, func(i, j int) bool {
+			return result.NewOrders[i].OrderPlacedDate < result.NewOrders[j].OrderPlacedDate
+		})
+		if store.Orders.Start == "" || ordersFrom < store.Orders.Start {
+			store.Orders.Start = ordersFrom
+		}
+		store.Orders.Watermark = yesterday
+	}
+
+	if err := saveTransactionStore(store); err != nil {
+		return nil, fmt.Errorf("saving transaction store: %w", err)
+	}
+
+	result.TotalReceipts = len(segment.Transactions)
+	result.TotalOrders = len(store.Orders.Orders)
+	return result, nil
+}
+
+// syncFrom returns the first day a sync fetches for a segment synced from start
+// through watermark ("" when never synced).
+func syncFrom(start, watermark, startDate string, full bool) (string, error) {
+	switch {
+	case start == "" && startDate == "":
+		return "", errors.New("a start date is required for the first sync")
+	case start == "":
+		return startDate, nil
+	case full && startDate != "":
+		return startDate, nil
+	case full:
+		return start, nil
+	}
+	last, err := time.Parse(storeDateLayout, watermark)
+	if err != nil {
+		return "", fmt.Errorf("invalid store watermark %q: %w", watermark, err)
+	}
+	return last.AddDate(0, 0, 1).Format(storeDateLayout), nil
+}
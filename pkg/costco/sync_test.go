@@ -0,0 +1,177 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncStore(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
+	dates := map[string]string{"R1": "2025-01-10T10:00:00", "R2": "2025-02-10T10:00:00"}
+	orders := []map[string]interface{}{{"orderNumber": "O1", "orderPlacedDate": "2025-01-20", "status": "Ordered"}}
+
+	var mu sync.Mutex
+	var receiptRanges, orderRanges [][2]string
+	client := newMockClient(t, Config{UseLocalStore: true}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		mu.Lock()
+		defer mu.Unlock()
+
+		if req.Query == OnlineOrdersQuery {
+			orderRanges = append(orderRanges, [2]string{req.Variables["startDate"].(string), req.Variables["endDate"].(string)})
+			writeGraphQLData(w, map[string]interface{}{
+				"getOnlineOrders": []interface{}{map[string]interface{}{
+					"pageNumber": 1, "totalNumberOfRecords": len(orders), "bcOrders": orders,
+				}},
+			})
+			return
+		}
+		if barcode, ok := req.Variables["barcode"].(string); ok {
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{"receipts": []map[string]interface{}{{
+					"transactionBarcode": barcode, "transactionDateTime": dates[barcode], "total": 10.00,
+				}}},
+			})
+			return
+		}
+		receiptRanges = append(receiptRanges, [2]string{receiptsRequestDate(t, req, "startDate"), receiptsRequestDate(t, req, "endDate")})
+		var receipts []map[string]interface{}
+		for barcode := range dates {
+			receipts = append(receipts, map[string]interface{}{"transactionBarcode": barcode})
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{"receipts": receipts},
+		})
+	})
+	ctx := context.Background()
+	today := time.Now().Format("2006-01-02")
+
+	_, err := client.SyncStore(ctx, "", false)
+	assert.ErrorContains(t, err, "start date is required")
+
+	// First sync starts at the given date
+	result, err := client.SyncStore(ctx, "2025-01-01", false)
+	require.NoError(t, err)
+	assert.Equal(t, "2025-01-01", result.StartDate)
+	assert.Equal(t, today, result.EndDate)
+	require.Len(t, result.NewReceipts, 2)
+	assert.Equal(t, "R1", result.NewReceipts[0].TransactionBarcode)
+	require.Len(t, result.NewOrders, 1)
+	assert.Equal(t, 2, result.TotalReceipts)
+	assert.Equal(t, 1, result.TotalOrders)
+
+	// Later syncs only fetch from today and report what's new
+	dates["R3"] = "2025-03-10T10:00:00"
+	orders[0]["status"] = "Delivered"
+	orders = append(orders, map[string]interface{}{"orderNumber": "O2", "orderPlacedDate": "2025-03-01"})
+	result, err = client.SyncStore(ctx, "2024-01-01", false)
+	require.NoError(t, err)
+	assert.Equal(t, today, result.StartDate)
+	require.Len(t, result.NewReceipts, 1)
+	assert.Equal(t, "R3", result.NewReceipts[0].TransactionBarcode)
+	require.Len(t, result.NewOrders, 1)
+	assert.Equal(t, "O2", result.NewOrders[0].OrderNumber)
+	assert.Equal(t, 3, result.TotalReceipts)
+	assert.Equal(t, 2, result.TotalOrders)
+
+	store, err := loadTransactionStore()
+	require.NoError(t, err)
+	assert.Equal(t, "Delivered", store.Orders.Orders[0].Status, "stored orders are refreshed")
+	assert.Equal(t, "2025-01-01", store.Orders.Start)
+
+	// Full sync backfills from the new start date
+	result, err = client.SyncStore(ctx, "2024-06-01", true)
+	require.NoError(t, err)
+	assert.Equal(t, "2024-06-01", result.StartDate)
+	assert.Empty(t, result.NewReceipts)
+	assert.Empty(t, result.NewOrders)
+
+	assert.Equal(t, "2024-06-01", receiptRanges[len(receiptRanges)-1][0])
+	assert.Equal(t, "2024-06-01", orderRanges[len(orderRanges)-1][0])
+
+	// Analytics read the synced receipts from the store
+	transactions, err := client.GetAllTransactionItems(ctx, "2025-01-01", "2025-02-28")
+	require.NoError(t, err)
+	assert.Len(t, transactions, 2)
+}
+
+func TestSyncStore_FailedDetails(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
+	dates := map[string]string{"R1": "2025-01-10T10:00:00", "R2": "2025-02-10T10:00:00"}
+	failures := 1
+	var mu sync.Mutex
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		mu.Lock()
+		defer mu.Unlock()
+
+		if req.Query == OnlineOrdersQuery {
+			writeGraphQLData(w, map[string]interface{}{
+				"getOnlineOrders": []interface{}{map[string]interface{}{"pageNumber": 1, "totalNumberOfRecords": 0, "bcOrders": []interface{}{}}},
+			})
+			return
+		}
+		if barcode, ok := req.Variables["barcode"].(string); ok {
+			if barcode == "R2" && failures > 0 {
+				failures--
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{"receipts": []map[string]interface{}{{
+					"transactionBarcode": barcode, "transactionDateTime": dates[barcode], "total": 10.00,
+				}}},
+			})
+			return
+		}
+		start := receiptsRequestDate(t, req, "startDate")
+		var receipts []map[string]interface{}
+		for barcode, date := range dates {
+			if date[:10] >= start {
+				receipts = append(receipts, map[string]interface{}{"transactionBarcode": barcode, "transactionDateTime": date})
+			}
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{"receipts": receipts},
+		})
+	})
+	ctx := context.Background()
+
+	result, err := client.SyncStore(ctx, "2025-01-01", false)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.FailedReceipts)
+	require.Len(t, result.NewReceipts, 1)
+	store, err := loadTransactionStore()
+	require.NoError(t, err)
+	assert.Equal(t, "2025-02-09", store.Segments["all/all"].Watermark, "synced up to the day before the failed receipt")
+
+	result, err = client.SyncStore(ctx, "", false)
+	require.NoError(t, err)
+	assert.Equal(t, "2025-02-10", result.StartDate)
+	assert.Zero(t, result.FailedReceipts)
+	require.Len(t, result.NewReceipts, 1)
+	assert.Equal(t, "R2", result.NewReceipts[0].TransactionBarcode)
+	assert.Equal(t, 2, result.TotalReceipts)
+}
+
+func TestSyncStore_ReadOnly(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
+	client := &Client{config: Config{ReadOnly: true}}
+	_, err := client.SyncStore(context.Background(), "2025-01-01", false)
+	assert.ErrorContains(t, err, "read-only")
+}
@@ -0,0 +1,203 @@
+package costco
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Local tags on transactions
+
+const tagsFile = "tags.json"
+
+// TransactionTags maps receipt barcodes to their tags. It is kept in ~/.costco/tags.json,
+// apart from the transaction store, so clearing or pruning the store keeps the tags.
+type TransactionTags map[string][]string
+
+// LoadTags reads the tags set with TagTransaction. A missing file means no tags.
+func LoadTags() (TransactionTags, error) {
+	tags := make(TransactionTags)
+
+	configPath, err := getConfigPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(filepath.Join(configPath, tagsFile))
+	if os.IsNotExist(err) {
+		return tags, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &tags); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", tagsFile, err)
+	}
+	return tags, nil
+}
+
+// saveTags writes ~/.costco/tags.json.
+func saveTags(tags TransactionTags) error {
+	if err := ensureConfigDir(); err != nil {
+		return err
+	}
+
+	configPath, err := getConfigPath()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(tags, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(configPath, tagsFile), data, 0600)
+}
+
+// TagTransaction adds tags to the receipt with a barcode and returns all of its tags.
+// Tags are lowercased and can't contain spaces or commas. Analytics calls fill in
+// TransactionWithItems.Tags, and Config.Tags limits them to tagged transactions.
+//
+// Example:
+//
+//	tags, err := costco.TagTransaction("21134300501862509061323", "vacation", "house-project")
+func TagTransaction(barcode string, tags ...string) ([]string, error) {
+	return updateTags(barcode, tags, func(current []string, tag string) []string {
+		for _, t := range current {
+			if t == tag {
+				return current
+			}
+		}
+		return append(current, tag)
+	})
+}
+
+// UntagTransaction removes tags from the receipt with a barcode, or all of its tags
+// when none are given, and returns the tags it has left.
+func UntagTransaction(barcode string, tags ...string) ([]string, error) {
+	if len(tags) == 0 {
+		return updateTags(barcode, nil, nil)
+	}
+	return updateTags(barcode, tags, func(current []string, tag string) []string {
+		kept := current[:0]
+		for _, t := range current {
+			if t != tag {
+				kept = append(kept, t)
+			}
+		}
+		return kept
+	})
+}
+
+// updateTags applies change for each tag to the barcode's tags and saves the result.
+// A nil change clears them.
+func updateTags(barcode string, tags []string, change func(current []string, tag string) []string) ([]string, error) {
+	barcode = strings.TrimSpace(barcode)
+	if barcode == "" {
+		return nil, fmt.Errorf("barcode is required")
+	}
+	normalized := make([]string, len(tags))
+	for i, tag := range tags {
+		var err error
+		if normalized[i], err = normalizeTag(tag); err != nil {
+			return nil, err
+		}
+	}
+
+	all, err := LoadTags()
+	if err != nil {
+		return nil, err
+	}
+	current := all[barcode]
+	if change == nil {
+		current = nil
+	}
+	for _, tag := range normalized {
+		current = change(current, tag)
+	}
+	sort.Strings(current)
+
+	if len(current) == 0 {
+		delete(all, barcode)
+	} else {
+		all[barcode] = current
+	}
+	if err := saveTags(all); err != nil {
+		return nil, err
+	}
+	return current, nil
+}
+
+// normalizeTag lowercases a tag and rejects ones that can't round-trip through -tag
+// flags and CSV export.
+func normalizeTag(tag string) (string, error) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if tag == "" || strings.ContainsAny(tag, ", \t;") {
+		return "", fmt.Errorf("invalid tag %q: use a single word such as vacation or house-project", tag)
+	}
+	return tag, nil
+}
+
+// Names returns every tag in use with the number of receipts carrying it.
+func (t TransactionTags) Names() map[string]int {
+	counts := make(map[string]int)
+	for _, tags := range t {
+		for _, tag := range tags {
+			counts[tag]++
+		}
+	}
+	return counts
+}
+
+// applyTags fills in each transaction's Tags and, with Config.Tags, keeps only the
+// transactions carrying at least one of them.
+func (c *Client) applyTags(transactions []TransactionWithItems) ([]TransactionWithItems, error) {
+	keep, err := c.tagFilter()
+	if err != nil {
+		return nil, err
+	}
+	kept := transactions[:0]
+	for _, tx := range transactions {
+		if keep(&tx) {
+			kept = append(kept, tx)
+		}
+	}
+	return kept, nil
+}
+
+// tagFilter loads the tags once and returns a function that fills in a transaction's
+// Tags and reports whether it passes Config.Tags. It is safe for concurrent use.
+func (c *Client) tagFilter() (func(tx *TransactionWithItems) bool, error) {
+	all, err := LoadTags()
+	if err != nil {
+		if len(c.config.Tags) > 0 {
+			return nil, fmt.Errorf("loading tags: %w", err)
+		}
+		c.getLogger().Warn("ignoring unreadable tags", slog.String("error", err.Error()))
+		return func(*TransactionWithItems) bool { return true }, nil
+	}
+
+	want := make(map[string]bool, len(c.config.Tags))
+	for _, tag := range c.config.Tags {
+		want[strings.ToLower(strings.TrimSpace(tag))] = true
+	}
+
+	return func(tx *TransactionWithItems) bool {
+		tx.Tags = all[tx.TransactionBarcode]
+		return len(want) == 0 || hasAnyTag(tx.Tags, want)
+	}, nil
+}
+
+func hasAnyTag(tags []string, want map[string]bool) bool {
+	for _, tag := range tags {
+		if want[tag] {
+			return true
+		}
+	}
+	return false
+}
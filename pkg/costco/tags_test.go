@@ -0,0 +1,89 @@
+package costco
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTagTransaction(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
+	tags, err := LoadTags()
+	require.NoError(t, err)
+	assert.Empty(t, tags)
+
+	got, err := TagTransaction("R1", "Vacation", "house-project", "vacation")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"house-project", "vacation"}, got)
+
+	_, err = TagTransaction("R2", "vacation")
+	require.NoError(t, err)
+	tags, err = LoadTags()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int{"vacation": 2, "house-project": 1}, tags.Names())
+
+	got, err = UntagTransaction("R1", "vacation")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"house-project"}, got)
+
+	got, err = UntagTransaction("R1")
+	require.NoError(t, err)
+	assert.Empty(t, got)
+	tags, err = LoadTags()
+	require.NoError(t, err)
+	assert.Equal(t, TransactionTags{"R2": {"vacation"}}, tags)
+
+	_, err = TagTransaction("R1", "two words")
+	assert.ErrorContains(t, err, "invalid tag")
+	_, err = TagTransaction(" ", "vacation")
+	assert.ErrorContains(t, err, "barcode is required")
+}
+
+func TestGetAllTransactionItems_Tags(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
+	day := func(d int) time.Time { return time.Date(2025, 1, d, 12, 0, 0, 0, time.UTC) }
+	require.NoError(t, saveTransactionStore(&transactionStore{Segments: map[string]*storeSegment{
+		"all/all": {Start: "2025-01-01", Watermark: "2025-01-31", Transactions: []TransactionWithItems{
+			{TransactionBarcode: "R1", TransactionDate: day(3), Total: 10},
+			{TransactionBarcode: "R2", TransactionDate: day(9), Total: 20},
+			{TransactionBarcode: "R3", TransactionDate: day(20), Total: 30},
+		}},
+	}}))
+	_, err := TagTransaction("R1", "vacation")
+	require.NoError(t, err)
+	_, err = TagTransaction("R3", "house-project", "vacation")
+	require.NoError(t, err)
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request to %s", r.URL.Path)
+	}
+	all, err := newMockClient(t, Config{UseLocalStore: true}, handler).
+		GetAllTransactionItems(context.Background(), "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	assert.Equal(t, []string{"vacation"}, all[0].Tags)
+	assert.Empty(t, all[1].Tags)
+
+	tagged, err := newMockClient(t, Config{UseLocalStore: true, Tags: []string{"House-Project"}}, handler).
+		GetAllTransactionItems(context.Background(), "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+	require.Len(t, tagged, 1)
+	assert.Equal(t, "R3", tagged[0].TransactionBarcode)
+
+	var buf bytes.Buffer
+	require.NoError(t, WriteExport(&buf, tagged, ExportOptions{Columns: []string{"barcode", "tags"}}))
+	assert.Equal(t, "barcode,tags\nR3,house-project;vacation\n", buf.String())
+
+	store, err := loadTransactionStore()
+	require.NoError(t, err)
+	assert.Empty(t, store.Segments["all/all"].Transactions[0].Tags, "tags stay out of the transaction store")
+}
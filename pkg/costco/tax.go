@@ -0,0 +1,81 @@
+package costco
+
+import (
+	"context"
+	"sort"
+)
+
+// Tax reporting from receipt SubTaxes
+
+// TaxSummary aggregates the taxes paid over a date range.
+// This is returned by GetTaxSummary.
+type TaxSummary struct {
+	Rates []TaxRateSummary // Sorted by legend, then rate
+	Total float64          // Sum of all tax lines
+}
+
+// TaxRateSummary is the tax paid under one jurisdiction (tax legend) at one rate.
+type TaxRateSummary struct {
+	Legend       string  // Jurisdiction as printed on receipts, e.g. "GST", "WA"
+	Percent      float64 // Tax rate in percent
+	Amount       float64 // Tax paid
+	ReceiptCount int     // Receipts with this tax
+}
+
+// GetTaxSummary aggregates the A/B/C/D/U tax buckets from SubTaxes across the
+// receipts in a date range, grouped by jurisdiction and rate. Receipts without
+// a tax breakdown (including Business Delivery orders) are not included.
+//
+// The startDate and endDate should be in YYYY-MM-DD format.
+//
+// Example:
+//
+//	summary, err := client.GetTaxSummary(ctx, "2025-01-01", "2025-12-31")
+//	for _, rate := range summary.Rates {
+//	    fmt.Printf("%s %.3g%%: $%.2f on %d receipts\n", rate.Legend, rate.Percent, rate.Amount, rate.ReceiptCount)
+//	}
+func (c *Client) GetTaxSummary(ctx context.Context, startDate, endDate string) (*TaxSummary, error) {
+	transactions, err := c.GetAllTransactionItems(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	type key struct {
+		legend  string
+		percent float64
+	}
+	rates := make(map[key]*TaxRateSummary)
+	summary := &TaxSummary{}
+
+	for _, tx := range transactions {
+		seen := make(map[key]bool)
+		for _, line := range tx.TaxLines {
+			k := key{line.Legend, line.Percent}
+			rate, exists := rates[k]
+			if !exists {
+				rate = &TaxRateSummary{Legend: line.Legend, Percent: line.Percent}
+				rates[k] = rate
+			}
+			rate.Amount += line.Amount
+			if !seen[k] {
+				seen[k] = true
+				rate.ReceiptCount++
+			}
+			summary.Total += line.Amount
+		}
+	}
+
+	for _, rate := range rates {
+		rate.Amount = roundTo(rate.Amount, 2)
+		summary.Rates = append(summary.Rates, *rate)
+	}
+	sort.Slice(summary.Rates, func(i, j int) bool {
+		if summary.Rates[i].Legend != summary.Rates[j].Legend {
+			return summary.Rates[i].Legend < summary.Rates[j].Legend
+		}
+		return summary.Rates[i].Percent < summary.Rates[j].Percent
+	})
+	summary.Total = roundTo(summary.Total, 2)
+
+	return summary, nil
+}
@@ -0,0 +1,57 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTaxSummary(t *testing.T) {
+	details := map[string]map[string]interface{}{
+		"A": {
+			"transactionBarcode": "A", "transactionDateTime": "2025-01-10T10:00:00",
+			"subTaxes": map[string]interface{}{
+				"aTaxLegend": "WA", "aTaxPercent": 10.1, "aTaxAmount": 5.05,
+				"bTaxLegend": "WA", "bTaxPercent": 10.1, "bTaxAmount": 1.01,
+			},
+		},
+		"B": {
+			"transactionBarcode": "B", "transactionDateTime": "2025-02-10T10:00:00",
+			"subTaxes": map[string]interface{}{
+				"aTaxLegend": "WA", "aTaxPercent": 10.1, "aTaxAmount": 2.02,
+				"cTaxLegend": "WA", "cTaxPercent": 10.4, "cTaxAmount": 1.04,
+			},
+		},
+		"C": {"transactionBarcode": "C", "transactionDateTime": "2025-03-10T10:00:00"},
+	}
+
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if barcode, ok := req.Variables["barcode"].(string); ok {
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{details[barcode]}},
+			})
+			return
+		}
+		writeGraphQLData(w, map[string]interface{}{
+			"receiptsWithCounts": map[string]interface{}{
+				"receipts": []map[string]interface{}{
+					{"transactionBarcode": "A"}, {"transactionBarcode": "B"}, {"transactionBarcode": "C"},
+				},
+			},
+		})
+	})
+
+	summary, err := client.GetTaxSummary(context.Background(), "2025-01-01", "2025-12-31")
+	require.NoError(t, err)
+
+	require.Len(t, summary.Rates, 2)
+	assert.Equal(t, TaxRateSummary{Legend: "WA", Percent: 10.1, Amount: 8.08, ReceiptCount: 2}, summary.Rates[0])
+	assert.Equal(t, TaxRateSummary{Legend: "WA", Percent: 10.4, Amount: 1.04, ReceiptCount: 1}, summary.Rates[1])
+	assert.Equal(t, 9.12, summary.Total)
+}
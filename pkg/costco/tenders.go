@@ -0,0 +1,138 @@
+package costco
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Spending by payment tender
+
+// TenderSummary breaks spending down by payment card or tender.
+// This is returned by GetSpendingByTender.
+type TenderSummary struct {
+	Tenders []TenderSpending // Largest total first
+	Total   float64
+}
+
+// TenderSpending is the spending on one card or tender.
+type TenderSpending struct {
+	Name     string  // Tender description, e.g. "VISA", "Costco Shop Card", "CASH"
+	LastFour string  // Last four digits of the card ("" for cash)
+	Total    float64 // Amount charged, net of refunds
+	Charges  []TenderCharge
+}
+
+// TenderCharge is one charge to a tender, for matching against a card statement.
+type TenderCharge struct {
+	Date      time.Time
+	Reference string // Receipt barcode or online order number
+	Source    string // TransactionSourceReceipt, TransactionSourceBusinessDelivery, or TransactionSourceOnline
+	Amount    float64
+}
+
+// LastFour returns the last four digits of the tender's account number, or "" if none.
+func (t *Tender) LastFour() string {
+	var digits strings.Builder
+	for _, r := range t.DisplayAccountNumber {
+		if r >= '0' && r <= '9' {
+			digits.WriteRune(r)
+		}
+	}
+	d := digits.String()
+	if len(d) < 4 {
+		return d
+	}
+	return d[len(d)-4:]
+}
+
+// Name returns the tender's description, falling back to its type name.
+func (t *Tender) Name() string {
+	if name := strings.TrimSpace(t.TenderDescription); name != "" {
+		return name
+	}
+	return strings.TrimSpace(t.TenderTypeName)
+}
+
+// GetSpendingByTender totals warehouse receipts and online orders by the card or
+// tender that paid for them, with each charge listed for reconciling against card
+// statements. Cards are told apart by their last four digits, so the Costco Visa
+// shows up separately from other Visa cards.
+//
+// The startDate and endDate should be in YYYY-MM-DD format.
+//
+// Example:
+//
+//	summary, err := client.GetSpendingByTender(ctx, "2025-01-01", "2025-12-31")
+//	for _, t := range summary.Tenders {
+//	    fmt.Printf("%s %s: $%.2f over %d charges\n", t.Name, t.LastFour, t.Total, len(t.Charges))
+//	}
+func (c *Client) GetSpendingByTender(ctx context.Context, startDate, endDate string) (*TenderSummary, error) {
+	transactions, err := c.GetAllTransactionItems(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	orders, err := c.getAllOnlineOrders(ctx, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	tenders := make(map[string]*TenderSpending)
+	add := func(name, lastFour string, charge TenderCharge) {
+		key := strings.ToUpper(name) + "|" + lastFour
+		spending, exists := tenders[key]
+		if !exists {
+			spending = &TenderSpending{Name: name, LastFour: lastFour}
+			tenders[key] = spending
+		}
+		spending.Total += charge.Amount
+		spending.Charges = append(spending.Charges, charge)
+	}
+
+	for _, tx := range transactions {
+		for _, tender := range tx.Tenders {
+			add(tender.Name(), tender.LastFour(), TenderCharge{
+				Date:      tx.TransactionDate,
+				Reference: tx.TransactionBarcode,
+				Source:    tx.Source,
+				Amount:    tender.AmountTender,
+			})
+		}
+	}
+
+	for _, order := range orders {
+		placed, _ := time.Parse("2006-01-02", order.OrderPlacedDate[:min(10, len(order.OrderPlacedDate))])
+		for _, payment := range order.Payments {
+			name := payment.CardType
+			if name == "" {
+				name = payment.TenderType
+			}
+			add(name, payment.LastFour, TenderCharge{
+				Date:      placed,
+				Reference: order.OrderNumber,
+				Source:    TransactionSourceOnline,
+				Amount:    payment.Amount,
+			})
+		}
+	}
+
+	summary := &TenderSummary{}
+	for _, spending := range tenders {
+		spending.Total = roundTo(spending.Total, 2)
+		sort.SliceStable(spending.Charges, func(i, j int) bool {
+			return spending.Charges[i].Date.Before(spending.Charges[j].Date)
+		})
+		summary.Total += spending.Total
+		summary.Tenders = append(summary.Tenders, *spending)
+	}
+	summary.Total = roundTo(summary.Total, 2)
+	sort.Slice(summary.Tenders, func(i, j int) bool {
+		if summary.Tenders[i].Total != summary.Tenders[j].Total {
+			return summary.Tenders[i].Total > summary.Tenders[j].Total
+		}
+		return summary.Tenders[i].Name+summary.Tenders[i].LastFour < summary.Tenders[j].Name+summary.Tenders[j].LastFour
+	})
+
+	return summary, nil
+}
@@ -0,0 +1,76 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTenderLastFour(t *testing.T) {
+	assert.Equal(t, "1234", (&Tender{DisplayAccountNumber: "************1234"}).LastFour())
+	assert.Equal(t, "", (&Tender{}).LastFour())
+	assert.Equal(t, "CASH", (&Tender{TenderTypeName: "CASH"}).Name())
+}
+
+func TestGetSpendingByTender(t *testing.T) {
+	details := map[string]map[string]interface{}{
+		"A": {"transactionBarcode": "A", "transactionDateTime": "2025-01-10T10:00:00", "total": 150.00,
+			"tenderArray": []map[string]interface{}{
+				{"tenderDescription": "VISA", "displayAccountNumber": "************1234", "amountTender": 100.00},
+				{"tenderDescription": "CASH", "amountTender": 50.00},
+			}},
+		"B": {"transactionBarcode": "B", "transactionDateTime": "2025-01-05T10:00:00", "total": 80.00,
+			"tenderArray": []map[string]interface{}{
+				{"tenderDescription": "VISA", "displayAccountNumber": "************9999", "amountTender": 80.00},
+			}},
+	}
+
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		switch {
+		case req.Query == OnlineOrdersQuery:
+			writeGraphQLData(w, map[string]interface{}{
+				"getOnlineOrders": []interface{}{map[string]interface{}{
+					"pageNumber": 1, "totalNumberOfRecords": 1,
+					"bcOrders": []map[string]interface{}{{
+						"orderNumber": "O1", "orderPlacedDate": "2025-01-02", "orderTotal": 40.00,
+						"paymentSummary": []map[string]interface{}{
+							{"tenderType": "CREDIT_CARD", "cardType": "Visa", "lastFourDigits": "1234", "amount": 40.00},
+						},
+					}},
+				}},
+			})
+		case req.Variables["barcode"] != nil:
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{"receipts": []interface{}{details[req.Variables["barcode"].(string)]}},
+			})
+		default:
+			writeGraphQLData(w, map[string]interface{}{
+				"receiptsWithCounts": map[string]interface{}{
+					"receipts": []map[string]interface{}{{"transactionBarcode": "A"}, {"transactionBarcode": "B"}},
+				},
+			})
+		}
+	})
+
+	summary, err := client.GetSpendingByTender(context.Background(), "2025-01-01", "2025-01-31")
+	require.NoError(t, err)
+	assert.Equal(t, 270.00, summary.Total)
+	require.Len(t, summary.Tenders, 3)
+
+	visa := summary.Tenders[0]
+	assert.Equal(t, "1234", visa.LastFour)
+	assert.Equal(t, 140.00, visa.Total, "receipt and online order on the same card")
+	require.Len(t, visa.Charges, 2)
+	assert.Equal(t, "O1", visa.Charges[0].Reference)
+	assert.Equal(t, TransactionSourceOnline, visa.Charges[0].Source)
+	assert.Equal(t, "A", visa.Charges[1].Reference)
+
+	assert.Equal(t, "9999", summary.Tenders[1].LastFour)
+	assert.Equal(t, "CASH", summary.Tenders[2].Name)
+}
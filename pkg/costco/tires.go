@@ -0,0 +1,164 @@
+package costco
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+// Tire center purchases, appointments, and service history
+
+// Tire service types
+const (
+	TireServiceInstallation = "INSTALLATION"
+	TireServiceRotation     = "ROTATION"
+	TireServiceBalance      = "BALANCE"
+	TireServiceFlatRepair   = "FLAT_REPAIR"
+	TireServiceInspection   = "INSPECTION"
+)
+
+// Vehicle identifies the vehicle a tire purchase or service was for
+type Vehicle struct {
+	Year  int    `json:"year"`
+	Make  string `json:"make"`
+	Model string `json:"model"`
+	Trim  string `json:"trim,omitempty"`
+}
+
+func (v Vehicle) String() string {
+	s := fmt.Sprintf("%d %s %s", v.Year, v.Make, v.Model)
+	if v.Trim != "" {
+		s += " " + v.Trim
+	}
+	return s
+}
+
+// TirePurchase represents a tire package bought at a tire center
+type TirePurchase struct {
+	InvoiceNumber      string  `json:"invoiceNumber"`
+	PurchaseDate       string  `json:"purchaseDate"` // YYYY-MM-DD
+	WarehouseNumber    string  `json:"warehouseNumber"`
+	TransactionBarcode string  `json:"transactionBarcode,omitempty"` // Matching warehouse receipt, when known
+	Vehicle            Vehicle `json:"vehicle"`
+	TireDescription    string  `json:"tireDescription"` // e.g. "Michelin Defender2 225/65R17"
+	Quantity           int     `json:"quantity"`
+	Total              float64 `json:"total"`
+	WarrantyMiles      int     `json:"warrantyMiles"`
+	Mileage            int     `json:"mileage"` // Odometer reading at installation
+}
+
+// TireAppointment represents a scheduled tire center appointment
+type TireAppointment struct {
+	AppointmentID   string  `json:"appointmentId"`
+	WarehouseNumber string  `json:"warehouseNumber"`
+	ScheduledAt     string  `json:"scheduledAt"` // YYYY-MM-DDTHH:MM:SS, warehouse local time
+	ServiceType     string  `json:"serviceType"` // One of the TireService constants
+	Status          string  `json:"status"`      // e.g. "Scheduled", "Completed", "Cancelled"
+	Vehicle         Vehicle `json:"vehicle"`
+}
+
+// TireService represents a completed tire service (rotation, balance, repair, ...)
+type TireService struct {
+	ServiceDate     string  `json:"serviceDate"` // YYYY-MM-DD
+	ServiceType     string  `json:"serviceType"` // One of the TireService constants
+	WarehouseNumber string  `json:"warehouseNumber"`
+	Mileage         int     `json:"mileage"`
+	Vehicle         Vehicle `json:"vehicle"`
+	Notes           string  `json:"notes,omitempty"`
+}
+
+// GetTirePurchases retrieves tire purchases within a date range.
+// The startDate and endDate should be in YYYY-MM-DD format.
+//
+// Example:
+//
+//	purchases, err := client.GetTirePurchases(ctx, "2020-01-01", "2025-12-31")
+//	for _, p := range purchases {
+//	    fmt.Printf("%s %s on %s: $%.2f\n", p.PurchaseDate, p.TireDescription, p.Vehicle, p.Total)
+//	}
+func (c *Client) GetTirePurchases(ctx context.Context, startDate, endDate string) ([]TirePurchase, error) {
+	c.getLogger().Info("fetching tire purchases",
+		slog.String("start_date", startDate),
+		slog.String("end_date", endDate))
+
+	params := url.Values{}
+	params.Set("startDate", startDate)
+	params.Set("endDate", endDate)
+
+	var result struct {
+		Purchases []TirePurchase `json:"purchases"`
+	}
+	if err := c.executeREST(ctx, http.MethodGet, TireCenterEndpoint+"/purchases", params, nil, true, &result); err != nil {
+		return nil, err
+	}
+
+	c.getLogger().Info("fetched tire purchases", slog.Int("purchase_count", len(result.Purchases)))
+
+	return result.Purchases, nil
+}
+
+// GetTireAppointments retrieves upcoming and past tire installation and service appointments.
+//
+// Example:
+//
+//	appointments, err := client.GetTireAppointments(ctx)
+//	for _, a := range appointments {
+//	    if a.Status == "Scheduled" {
+//	        fmt.Printf("%s at warehouse %s: %s\n", a.ScheduledAt, a.WarehouseNumber, a.ServiceType)
+//	    }
+//	}
+func (c *Client) GetTireAppointments(ctx context.Context) ([]TireAppointment, error) {
+	c.getLogger().Info("fetching tire appointments")
+
+	var result struct {
+		Appointments []TireAppointment `json:"appointments"`
+	}
+	if err := c.executeREST(ctx, http.MethodGet, TireCenterEndpoint+"/appointments", nil, nil, true, &result); err != nil {
+		return nil, err
+	}
+
+	c.getLogger().Info("fetched tire appointments", slog.Int("appointment_count", len(result.Appointments)))
+
+	return result.Appointments, nil
+}
+
+// GetTireServiceHistory retrieves completed tire services such as rotations, balancing,
+// and flat repairs for vehicles tied to the membership.
+//
+// Example:
+//
+//	services, err := client.GetTireServiceHistory(ctx)
+//	if last := costco.LastTireService(services, costco.TireServiceRotation); last != nil {
+//	    fmt.Printf("Last rotation: %s at %d miles\n", last.ServiceDate, last.Mileage)
+//	}
+func (c *Client) GetTireServiceHistory(ctx context.Context) ([]TireService, error) {
+	c.getLogger().Info("fetching tire service history")
+
+	var result struct {
+		Services []TireService `json:"services"`
+	}
+	if err := c.executeREST(ctx, http.MethodGet, TireCenterEndpoint+"/services", nil, nil, true, &result); err != nil {
+		return nil, err
+	}
+
+	c.getLogger().Info("fetched tire service history", slog.Int("service_count", len(result.Services)))
+
+	return result.Services, nil
+}
+
+// LastTireService returns the most recent service of the given type, or nil if there is none.
+func LastTireService(services []TireService, serviceType string) *TireService {
+	var last *TireService
+	for i := range services {
+		s := &services[i]
+		if s.ServiceType != serviceType {
+			continue
+		}
+		if last == nil || s.ServiceDate > last.ServiceDate {
+			last = s
+		}
+	}
+	return last
+}
@@ -0,0 +1,75 @@
+package costco
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func tireCenterServer(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ebusiness/tire/v1/purchases":
+			assert.Equal(t, "2020-01-01", r.URL.Query().Get("startDate"))
+			w.Write([]byte(`{"purchases": [{
+				"invoiceNumber": "T-1",
+				"purchaseDate": "2024-05-04",
+				"vehicle": {"year": 2019, "make": "Subaru", "model": "Outback"},
+				"tireDescription": "Michelin CrossClimate2 225/65R17",
+				"quantity": 4,
+				"total": 912.44,
+				"warrantyMiles": 60000
+			}]}`))
+		case "/ebusiness/tire/v1/appointments":
+			w.Write([]byte(`{"appointments": [{"appointmentId": "A1", "scheduledAt": "2026-10-20T09:00:00", "serviceType": "ROTATION", "status": "Scheduled"}]}`))
+		case "/ebusiness/tire/v1/services":
+			w.Write([]byte(`{"services": [
+				{"serviceDate": "2025-01-10", "serviceType": "ROTATION", "mileage": 41000},
+				{"serviceDate": "2025-07-02", "serviceType": "ROTATION", "mileage": 47000},
+				{"serviceDate": "2025-08-15", "serviceType": "FLAT_REPAIR", "mileage": 48000}
+			]}`))
+		default:
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+	}
+}
+
+func TestGetTirePurchases(t *testing.T) {
+	client := newMockClient(t, Config{}, tireCenterServer(t))
+
+	purchases, err := client.GetTirePurchases(context.Background(), "2020-01-01", "2025-12-31")
+	require.NoError(t, err)
+	require.Len(t, purchases, 1)
+
+	assert.Equal(t, 4, purchases[0].Quantity)
+	assert.Equal(t, 60000, purchases[0].WarrantyMiles)
+	assert.Equal(t, "2019 Subaru Outback", purchases[0].Vehicle.String())
+}
+
+func TestGetTireAppointments(t *testing.T) {
+	client := newMockClient(t, Config{}, tireCenterServer(t))
+
+	appointments, err := client.GetTireAppointments(context.Background())
+	require.NoError(t, err)
+	require.Len(t, appointments, 1)
+	assert.Equal(t, TireServiceRotation, appointments[0].ServiceType)
+	assert.Equal(t, "Scheduled", appointments[0].Status)
+}
+
+func TestGetTireServiceHistory(t *testing.T) {
+	client := newMockClient(t, Config{}, tireCenterServer(t))
+
+	services, err := client.GetTireServiceHistory(context.Background())
+	require.NoError(t, err)
+	require.Len(t, services, 3)
+
+	last := LastTireService(services, TireServiceRotation)
+	require.NotNil(t, last)
+	assert.Equal(t, "2025-07-02", last.ServiceDate)
+	assert.Equal(t, 47000, last.Mileage)
+
+	assert.Nil(t, LastTireService(services, TireServiceBalance))
+}
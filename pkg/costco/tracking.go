@@ -0,0 +1,89 @@
+package costco
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+)
+
+// Live shipment tracking
+
+// ShipmentTracking represents the latest carrier tracking status for a shipment
+type ShipmentTracking struct {
+	OrderNumber          string          `json:"orderNumber"`
+	TrackingNumber       string          `json:"trackingNumber"`
+	CarrierName          string          `json:"carrierName"`
+	TrackingSiteURL      string          `json:"trackingSiteUrl"`
+	Status               string          `json:"status"` // e.g. "In Transit", "Out for Delivery", "Delivered"
+	EstimatedArrivalDate string          `json:"estimatedArrivalDate"`
+	DeliveredDate        string          `json:"deliveredDate"`
+	IsDeliveryDelayed    bool            `json:"isDeliveryDelayed"`
+	Events               []TrackingEvent `json:"trackingEvents"` // Oldest first
+}
+
+// Delivered reports whether the carrier has marked the shipment delivered.
+func (s *ShipmentTracking) Delivered() bool {
+	return s.DeliveredDate != ""
+}
+
+// LatestEvent returns the most recent tracking event, or nil if there are none.
+func (s *ShipmentTracking) LatestEvent() *TrackingEvent {
+	if len(s.Events) == 0 {
+		return nil
+	}
+	return &s.Events[len(s.Events)-1]
+}
+
+// GetTracking queries the carrier tracking operation directly for the latest events
+// on a shipment. Unlike Shipment.TrackingEvent, which is a snapshot taken with the
+// order query, this returns the full, current event history.
+//
+// Example:
+//
+//	for _, item := range order.OrderLineItems {
+//	    if item.Shipment == nil || item.Shipment.TrackingNumber == "" {
+//	        continue
+//	    }
+//	    tracking, err := client.GetTracking(ctx, order.OrderNumber, item.Shipment.TrackingNumber)
+//	    if err != nil {
+//	        log.Fatal(err)
+//	    }
+//	    if latest := tracking.LatestEvent(); latest != nil {
+//	        fmt.Printf("%s: %s (%s)\n", tracking.TrackingNumber, latest.Event, latest.EventDate)
+//	    }
+//	}
+func (c *Client) GetTracking(ctx context.Context, orderNumber, trackingNumber string) (*ShipmentTracking, error) {
+	if orderNumber == "" || trackingNumber == "" {
+		return nil, fmt.Errorf("order number and tracking number are required")
+	}
+
+	c.getLogger().Info("fetching shipment tracking",
+		slog.String("order_number", orderNumber),
+		slog.String("tracking_number", trackingNumber))
+
+	variables := map[string]interface{}{
+		"orderNumber":    orderNumber,
+		"trackingNumber": trackingNumber,
+	}
+
+	c.getLogger().Debug("executing graphql query", slog.String("operation", "getShipmentTracking"))
+
+	var result struct {
+		ShipmentTracking *ShipmentTracking `json:"shipmentTracking"`
+	}
+
+	if err := c.executeGraphQL(ctx, ShipmentTrackingQuery, variables, &result); err != nil {
+		return nil, err
+	}
+
+	if result.ShipmentTracking == nil {
+		return nil, fmt.Errorf("no tracking found for %s on order %s", trackingNumber, orderNumber)
+	}
+
+	c.getLogger().Info("fetched shipment tracking",
+		slog.String("tracking_number", trackingNumber),
+		slog.String("status", result.ShipmentTracking.Status),
+		slog.Int("event_count", len(result.ShipmentTracking.Events)))
+
+	return result.ShipmentTracking, nil
+}
@@ -0,0 +1,60 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetTracking(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		var req GraphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, ShipmentTrackingQuery, req.Query)
+		assert.Equal(t, "1234567890", req.Variables["orderNumber"])
+		assert.Equal(t, "1Z999", req.Variables["trackingNumber"])
+
+		writeGraphQLData(w, map[string]interface{}{
+			"shipmentTracking": map[string]interface{}{
+				"orderNumber":    "1234567890",
+				"trackingNumber": "1Z999",
+				"carrierName":    "UPS",
+				"status":         "Out for Delivery",
+				"trackingEvents": []map[string]interface{}{
+					{"event": "Shipped", "eventDate": "2026-10-13"},
+					{"event": "Out for Delivery", "eventDate": "2026-10-15"},
+				},
+			},
+		})
+	})
+
+	tracking, err := client.GetTracking(context.Background(), "1234567890", "1Z999")
+	require.NoError(t, err)
+
+	assert.Equal(t, "UPS", tracking.CarrierName)
+	assert.False(t, tracking.Delivered())
+	require.NotNil(t, tracking.LatestEvent())
+	assert.Equal(t, "Out for Delivery", tracking.LatestEvent().Event)
+}
+
+func TestGetTracking_NotFound(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		writeGraphQLData(w, map[string]interface{}{"shipmentTracking": nil})
+	})
+
+	_, err := client.GetTracking(context.Background(), "1234567890", "1Z999")
+	assert.ErrorContains(t, err, "no tracking found")
+
+	_, err = client.GetTracking(context.Background(), "", "1Z999")
+	assert.Error(t, err)
+}
+
+func TestShipmentTracking_Empty(t *testing.T) {
+	tracking := &ShipmentTracking{DeliveredDate: "2026-10-15"}
+	assert.True(t, tracking.Delivered())
+	assert.Nil(t, tracking.LatestEvent())
+}
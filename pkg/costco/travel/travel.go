@@ -0,0 +1,168 @@
+// Package travel provides read-only access to Costco Travel reservations.
+//
+// Costco Travel bookings (vacation packages, cruises, rental cars, hotels) are
+// billed outside of warehouse receipts and online orders, so this spending is
+// invisible to the costco package's receipt and order methods. The travel client
+// reuses a costco.Client's session, so no separate sign-in is needed.
+//
+// Example:
+//
+//	client := costco.NewClient(config)
+//	tc := travel.NewClient(client)
+//
+//	bookings, err := tc.GetBookings(ctx, false)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	for _, b := range bookings {
+//	    fmt.Printf("%s %s %s: $%.2f\n", b.ConfirmationNumber, b.Type, b.Destination, b.TotalPrice)
+//	}
+package travel
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+)
+
+// DefaultBaseURL is the Costco Travel member API
+const DefaultBaseURL = "https://www.costcotravel.com/api/member/v1"
+
+// Booking types
+const (
+	BookingTypePackage = "PACKAGE"
+	BookingTypeCruise  = "CRUISE"
+	BookingTypeCar     = "CAR"
+	BookingTypeHotel   = "HOTEL"
+)
+
+// Booking represents a Costco Travel reservation summary
+type Booking struct {
+	BookingID          string  `json:"bookingId"`
+	ConfirmationNumber string  `json:"confirmationNumber"`
+	Type               string  `json:"type"` // One of the BookingType constants
+	Status             string  `json:"status"`
+	Destination        string  `json:"destination"`
+	BookedDate         string  `json:"bookedDate"` // YYYY-MM-DD
+	StartDate          string  `json:"startDate"`  // YYYY-MM-DD
+	EndDate            string  `json:"endDate"`    // YYYY-MM-DD
+	TotalPrice         float64 `json:"totalPrice"`
+	AmountPaid         float64 `json:"amountPaid"`
+	Currency           string  `json:"currency"`
+}
+
+// Itinerary represents the full detail of a booking
+type Itinerary struct {
+	Booking
+	Travelers []Traveler    `json:"travelers"`
+	Segments  []Segment     `json:"segments"`
+	Payments  []Payment     `json:"payments"`
+	ShopCard  *CashCardInfo `json:"costcoShopCard,omitempty"` // Digital Costco Shop Card earned by the booking
+}
+
+// Traveler represents a person on a booking
+type Traveler struct {
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	IsPrimary bool   `json:"isPrimary"`
+}
+
+// Segment represents one component of an itinerary (flight, hotel stay, car rental, sailing)
+type Segment struct {
+	Type          string `json:"type"` // e.g. "FLIGHT", "HOTEL", "CAR", "CRUISE"
+	Provider      string `json:"provider"`
+	Description   string `json:"description"`
+	StartDateTime string `json:"startDateTime"`
+	EndDateTime   string `json:"endDateTime"`
+	Confirmation  string `json:"confirmation"`
+}
+
+// Payment represents a payment made toward a booking
+type Payment struct {
+	Date       string  `json:"date"`
+	Amount     float64 `json:"amount"`
+	CardType   string  `json:"cardType"`
+	CardLast4  string  `json:"cardLast4"`
+	IsDeposit  bool    `json:"isDeposit"`
+	IsRefunded bool    `json:"isRefunded"`
+}
+
+// CashCardInfo describes the Digital Costco Shop Card earned with a booking
+type CashCardInfo struct {
+	Amount     float64 `json:"amount"`
+	IssueDate  string  `json:"issueDate"`
+	CardNumber string  `json:"cardNumber"` // Last digits only
+}
+
+// Client reads Costco Travel reservations using a costco.Client's session
+type Client struct {
+	costco  *costco.Client
+	baseURL string
+}
+
+// NewClient creates a travel client that shares the given client's tokens.
+func NewClient(c *costco.Client) *Client {
+	return &Client{
+		costco:  c,
+		baseURL: DefaultBaseURL,
+	}
+}
+
+// GetBookings retrieves the member's Costco Travel bookings.
+// Set includePast to include completed and cancelled trips.
+//
+// Example:
+//
+//	bookings, err := tc.GetBookings(ctx, true)
+func (t *Client) GetBookings(ctx context.Context, includePast bool) ([]Booking, error) {
+	params := url.Values{}
+	if includePast {
+		params.Set("includePast", "true")
+	}
+
+	var result struct {
+		Bookings []Booking `json:"bookings"`
+	}
+	if err := t.costco.DoJSON(ctx, http.MethodGet, t.baseURL+"/bookings", params, nil, &result); err != nil {
+		return nil, fmt.Errorf("fetching travel bookings: %w", err)
+	}
+
+	return result.Bookings, nil
+}
+
+// GetItinerary retrieves the full itinerary for a booking.
+//
+// Example:
+//
+//	itinerary, err := tc.GetItinerary(ctx, booking.BookingID)
+//	for _, seg := range itinerary.Segments {
+//	    fmt.Printf("%s %s %s\n", seg.StartDateTime, seg.Type, seg.Description)
+//	}
+func (t *Client) GetItinerary(ctx context.Context, bookingID string) (*Itinerary, error) {
+	if bookingID == "" {
+		return nil, fmt.Errorf("booking ID is required")
+	}
+
+	var itinerary Itinerary
+	endpoint := t.baseURL + "/bookings/" + url.PathEscape(bookingID)
+	if err := t.costco.DoJSON(ctx, http.MethodGet, endpoint, nil, nil, &itinerary); err != nil {
+		return nil, fmt.Errorf("fetching itinerary %s: %w", bookingID, err)
+	}
+
+	return &itinerary, nil
+}
+
+// TotalSpent sums the amount paid across bookings, skipping cancelled ones.
+func TotalSpent(bookings []Booking) float64 {
+	var total float64
+	for _, b := range bookings {
+		if b.Status == "CANCELLED" {
+			continue
+		}
+		total += b.AmountPaid
+	}
+	return total
+}
@@ -0,0 +1,89 @@
+package travel
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/eshaffer321/costco-go/pkg/costco"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestClient returns a travel client whose requests are served by handler.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c := costco.NewClient(costco.Config{
+		ReadOnly: true,
+		Tokens: &costco.StoredTokens{
+			IDToken:      "test-id-token",
+			RefreshToken: "test-refresh-token",
+			TokenExpiry:  time.Now().Add(1 * time.Hour),
+		},
+	})
+	tc := NewClient(c)
+	tc.baseURL = server.URL
+	return tc
+}
+
+func TestGetBookings(t *testing.T) {
+	tc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/bookings", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("includePast"))
+		assert.Equal(t, "Bearer test-id-token", r.Header.Get(costco.HeaderAuthorization))
+		w.Write([]byte(`{"bookings": [
+			{"bookingId": "b1", "confirmationNumber": "CT123", "type": "PACKAGE", "status": "CONFIRMED", "destination": "Maui", "amountPaid": 4200.50},
+			{"bookingId": "b2", "type": "CAR", "status": "CANCELLED", "amountPaid": 300}
+		]}`))
+	})
+
+	bookings, err := tc.GetBookings(context.Background(), true)
+	require.NoError(t, err)
+	require.Len(t, bookings, 2)
+
+	assert.Equal(t, "CT123", bookings[0].ConfirmationNumber)
+	assert.Equal(t, BookingTypePackage, bookings[0].Type)
+	assert.Equal(t, 4200.50, TotalSpent(bookings), "cancelled bookings are excluded")
+}
+
+func TestGetItinerary(t *testing.T) {
+	tc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/bookings/b1", r.URL.Path)
+		w.Write([]byte(`{
+			"bookingId": "b1",
+			"destination": "Maui",
+			"travelers": [{"firstName": "Pat", "lastName": "Smith", "isPrimary": true}],
+			"segments": [{"type": "HOTEL", "provider": "Grand Wailea", "startDateTime": "2027-02-01T15:00:00"}],
+			"payments": [{"amount": 500, "isDeposit": true}],
+			"costcoShopCard": {"amount": 84.01}
+		}`))
+	})
+
+	itinerary, err := tc.GetItinerary(context.Background(), "b1")
+	require.NoError(t, err)
+
+	assert.Equal(t, "Maui", itinerary.Destination)
+	require.Len(t, itinerary.Travelers, 1)
+	require.Len(t, itinerary.Segments, 1)
+	assert.Equal(t, "Grand Wailea", itinerary.Segments[0].Provider)
+	assert.True(t, itinerary.Payments[0].IsDeposit)
+	require.NotNil(t, itinerary.ShopCard)
+	assert.Equal(t, 84.01, itinerary.ShopCard.Amount)
+}
+
+func TestGetItinerary_Errors(t *testing.T) {
+	tc := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := tc.GetItinerary(context.Background(), "")
+	assert.Error(t, err)
+
+	_, err = tc.GetItinerary(context.Background(), "missing")
+	assert.ErrorContains(t, err, "404")
+}
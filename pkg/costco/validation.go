@@ -0,0 +1,199 @@
+package costco
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Configuration validation
+
+var (
+	emailPattern     = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+	warehousePattern = regexp.MustCompile(`^[0-9]{1,5}$`)
+)
+
+// maxTokenRefreshBuffer caps TokenRefreshBuffer; Costco ID tokens live for about
+// an hour, so a larger buffer would refresh on every request.
+const maxTokenRefreshBuffer = 30 * time.Minute
+
+// FieldError describes a single invalid configuration field.
+type FieldError struct {
+	Field   string // JSON-style field name (e.g. "warehouse_number")
+	Message string // What is wrong and how to fix it
+}
+
+func (e *FieldError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+// ValidationError collects every invalid field found by Validate.
+// Use errors.As to inspect the individual FieldErrors.
+//
+// Example:
+//
+//	var verr *costco.ValidationError
+//	if errors.As(err, &verr) {
+//	    for _, fe := range verr.Errors {
+//	        fmt.Printf("%s: %s\n", fe.Field, fe.Message)
+//	    }
+//	}
+type ValidationError struct {
+	Errors []*FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, fe := range e.Errors {
+		msgs[i] = fe.Error()
+	}
+	return "invalid config: " + strings.Join(msgs, "; ")
+}
+
+// Unwrap exposes the individual field errors to errors.Is and errors.As.
+func (e *ValidationError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i, fe := range e.Errors {
+		errs[i] = fe
+	}
+	return errs
+}
+
+// validator accumulates field errors.
+type validator struct {
+	errs []*FieldError
+}
+
+func (v *validator) add(field, format string, args ...interface{}) {
+	v.errs = append(v.errs, &FieldError{Field: field, Message: fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) err() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return &ValidationError{Errors: v.errs}
+}
+
+func (v *validator) email(email string) {
+	if email == "" || strings.HasPrefix(email, keychainRefPrefix) {
+		return
+	}
+	if !emailPattern.MatchString(email) {
+		v.add("email", "%q is not a valid email address", email)
+	}
+}
+
+func (v *validator) warehouse(number string) {
+	if number != "" && !warehousePattern.MatchString(number) {
+		v.add("warehouse_number", "%q must be 1-5 digits (e.g. \"847\")", number)
+	}
+}
+
+var (
+	documentTypes    = []string{DocumentTypeAll, DocumentTypeWarehouse, DocumentTypeFuel}
+	documentSubTypes = []string{DocumentSubTypeAll, DocumentSubTypeGas, DocumentSubTypeCarWash, DocumentSubTypeGasAndCarWash}
+)
+
+func (v *validator) documentFilters(documentType, documentSubType string) {
+	if documentType != "" && !slices.Contains(documentTypes, documentType) {
+		v.add("document_type", "%q must be one of: %s", documentType, strings.Join(documentTypes, ", "))
+	}
+	if documentSubType != "" && !slices.Contains(documentSubTypes, documentSubType) {
+		v.add("document_sub_type", "%q must be one of: %s", documentSubType, strings.Join(documentSubTypes, ", "))
+	}
+}
+
+func (v *validator) secretBackend(backend SecretBackend) {
+	switch backend {
+	case "", SecretBackendFile, SecretBackendKeychain:
+	default:
+		v.add("secret_backend", "%q must be %q or %q", backend, SecretBackendFile, SecretBackendKeychain)
+	}
+}
+
+func (v *validator) region(locale Locale, currency string) {
+	switch locale {
+	case "", LocaleEnUS, LocaleEnCA, LocaleFrCA:
+	default:
+		v.add("locale", "%q must be one of: %s, %s, %s", locale, LocaleEnUS, LocaleEnCA, LocaleFrCA)
+		return
+	}
+	switch currency {
+	case "":
+	case CurrencyUSD, CurrencyCAD:
+		if expected := locale.DefaultCurrency(); expected != "" && currency != expected {
+			v.add("currency", "%s does not match locale %s (expected %s)", currency, locale, expected)
+		}
+	default:
+		v.add("currency", "%q must be %s or %s", currency, CurrencyUSD, CurrencyCAD)
+	}
+}
+
+func (v *validator) budgets(budgets map[string]float64) {
+	for category, amount := range budgets {
+		if _, err := BudgetCategory(category); err != nil {
+			v.add("budgets", "%v", err)
+		} else if amount <= 0 {
+			v.add("budgets", "%s: %.2f must be positive", category, amount)
+		}
+	}
+}
+
+func (v *validator) calendar(cal FiscalCalendar) {
+	if cal.MonthStartDay < 0 || cal.MonthStartDay > 28 {
+		v.add("month_start_day", "%d must be between 1 and 28", cal.MonthStartDay)
+	}
+	if cal.YearStartMonth < 0 || cal.YearStartMonth > time.December {
+		v.add("year_start_month", "%d must be between 1 and 12", cal.YearStartMonth)
+	}
+}
+
+// Validate checks the client configuration and reports every invalid field at once.
+// Returns nil or a *ValidationError. NewClient calls this automatically; an invalid
+// config is logged and returned by the first API call.
+//
+// Example:
+//
+//	if err := config.Validate(); err != nil {
+//	    log.Fatal(err) // invalid config: warehouse_number: "84a" must be 1-5 digits (e.g. "847")
+//	}
+func (c Config) Validate() error {
+	var v validator
+	v.email(c.Email)
+	v.warehouse(c.WarehouseNumber)
+	v.documentFilters(c.DocumentType, c.DocumentSubType)
+	v.secretBackend(c.SecretBackend)
+	v.region(c.Locale, c.Currency)
+	if c.TokenRefreshBuffer < 0 || c.TokenRefreshBuffer > maxTokenRefreshBuffer {
+		v.add("token_refresh_buffer", "%s must be between 0 and %s", c.TokenRefreshBuffer, maxTokenRefreshBuffer)
+	}
+	if c.DetailWorkers < 0 {
+		v.add("detail_workers", "%d must not be negative", c.DetailWorkers)
+	}
+	v.calendar(c.Calendar)
+	return v.err()
+}
+
+// Validate checks the stored profile and reports every invalid field at once.
+// Returns nil or a *ValidationError.
+func (s *StoredConfig) Validate() error {
+	var v validator
+	v.email(s.Email)
+	v.warehouse(s.WarehouseNumber)
+	v.documentFilters(s.DocumentType, s.DocumentSubType)
+	v.secretBackend(s.SecretBackend)
+	v.region(s.Locale, s.Currency)
+	if s.DefaultDateRangeDays < 0 {
+		v.add("default_date_range_days", "%d must not be negative", s.DefaultDateRangeDays)
+	}
+	switch s.OutputFormat {
+	case "", OutputFormatText, OutputFormatJSON, OutputFormatJSONL:
+	default:
+		v.add("output_format", "%q must be %q, %q, or %q", s.OutputFormat, OutputFormatText, OutputFormatJSON, OutputFormatJSONL)
+	}
+	v.budgets(s.Budgets)
+	return v.err()
+}
@@ -0,0 +1,152 @@
+package costco
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		config     Config
+		wantFields []string
+	}{
+		{
+			name: "valid config",
+			config: Config{
+				Email:              "user@example.com",
+				WarehouseNumber:    "847",
+				DocumentType:       "warehouse",
+				TokenRefreshBuffer: 5 * time.Minute,
+			},
+		},
+		{
+			name:   "empty config is valid",
+			config: Config{},
+		},
+		{
+			name:   "keychain pointer email is not checked",
+			config: Config{Email: "keychain:email", SecretBackend: SecretBackendKeychain},
+		},
+		{
+			name:       "invalid email",
+			config:     Config{Email: "not-an-email"},
+			wantFields: []string{"email"},
+		},
+		{
+			name:       "non-digit warehouse",
+			config:     Config{WarehouseNumber: "84a"},
+			wantFields: []string{"warehouse_number"},
+		},
+		{
+			name:       "warehouse too long",
+			config:     Config{WarehouseNumber: "123456"},
+			wantFields: []string{"warehouse_number"},
+		},
+		{
+			name:       "negative buffer",
+			config:     Config{TokenRefreshBuffer: -1 * time.Minute},
+			wantFields: []string{"token_refresh_buffer"},
+		},
+		{
+			name:       "buffer longer than token lifetime",
+			config:     Config{TokenRefreshBuffer: 2 * time.Hour},
+			wantFields: []string{"token_refresh_buffer"},
+		},
+		{
+			name:       "negative detail workers",
+			config:     Config{DetailWorkers: -1},
+			wantFields: []string{"detail_workers"},
+		},
+		{
+			name:       "month start day past the 28th",
+			config:     Config{Calendar: FiscalCalendar{MonthStartDay: 31}},
+			wantFields: []string{"month_start_day"},
+		},
+		{
+			name:       "unknown document type",
+			config:     Config{DocumentType: "groceries"},
+			wantFields: []string{"document_type"},
+		},
+		{
+			name:       "unknown document sub-type",
+			config:     Config{DocumentType: DocumentTypeFuel, DocumentSubType: "diesel"},
+			wantFields: []string{"document_sub_type"},
+		},
+		{
+			name:       "unknown secret backend",
+			config:     Config{SecretBackend: "vault"},
+			wantFields: []string{"secret_backend"},
+		},
+		{
+			name: "reports every invalid field",
+			config: Config{
+				Email:           "bad",
+				WarehouseNumber: "abc",
+			},
+			wantFields: []string{"email", "warehouse_number"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if len(tt.wantFields) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+
+			var verr *ValidationError
+			require.True(t, errors.As(err, &verr), "expected *ValidationError, got %v", err)
+			var fields []string
+			for _, fe := range verr.Errors {
+				fields = append(fields, fe.Field)
+			}
+			assert.Equal(t, tt.wantFields, fields)
+		})
+	}
+}
+
+func TestStoredConfigValidate(t *testing.T) {
+	valid := &StoredConfig{
+		Email:                "user@example.com",
+		WarehouseNumber:      "847",
+		DefaultDateRangeDays: 30,
+		OutputFormat:         OutputFormatJSON,
+	}
+	assert.NoError(t, valid.Validate())
+
+	invalid := &StoredConfig{
+		Email:                "user@",
+		WarehouseNumber:      "8 4 7",
+		DefaultDateRangeDays: -5,
+		OutputFormat:         "yaml",
+	}
+	err := invalid.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "email:")
+	assert.Contains(t, err.Error(), "warehouse_number:")
+	assert.Contains(t, err.Error(), "default_date_range_days:")
+	assert.Contains(t, err.Error(), "output_format:")
+
+	var fe *FieldError
+	require.True(t, errors.As(err, &fe))
+	assert.Equal(t, "email", fe.Field)
+}
+
+func TestNewClient_InvalidConfigFailsAPICalls(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
+	client := NewClient(Config{WarehouseNumber: "not-a-number"})
+
+	_, err := client.GetOnlineOrders(context.Background(), "2025-01-01", "2025-01-31", 1, 10)
+	var verr *ValidationError
+	require.True(t, errors.As(err, &verr))
+	assert.Equal(t, "warehouse_number", verr.Errors[0].Field)
+}
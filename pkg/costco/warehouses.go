@@ -0,0 +1,255 @@
+package costco
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Warehouse locator
+
+// DefaultWarehouseSearchRadius is the radius in miles used when FindWarehouses is given 0.
+const DefaultWarehouseSearchRadius = 50
+
+// Warehouse represents a Costco warehouse location.
+type Warehouse struct {
+	Number     string             `json:"stlocID"`
+	Name       string             `json:"displayName"`
+	Address1   string             `json:"address1"`
+	Address2   string             `json:"address2,omitempty"`
+	City       string             `json:"city"`
+	State      string             `json:"state"`
+	PostalCode string             `json:"zipCode"`
+	Country    string             `json:"country"`
+	Phone      string             `json:"phone"`
+	Latitude   float64            `json:"latitude"`
+	Longitude  float64            `json:"longitude"`
+	Distance   float64            `json:"distance,omitempty"` // Miles from the search location (FindWarehouses only)
+	Hours      []string           `json:"warehouseHours"`     // e.g. "Mon-Fri. 10:00am - 8:30pm"
+	GasHours   []string           `json:"gasStationHours,omitempty"`
+	Services   []WarehouseService `json:"coreServices"`
+}
+
+// Service codes used in Warehouse.Services
+const (
+	ServiceGas        = "gas"
+	ServicePharmacy   = "pharmacy"
+	ServiceOptical    = "optical"
+	ServiceTireCenter = "tire"
+	ServiceHearingAid = "hearing"
+	ServiceFoodCourt  = "foodcourt"
+)
+
+// WarehouseService is a department or service offered at a warehouse (gas, pharmacy, tire center, ...).
+type WarehouseService struct {
+	Code string `json:"code"`
+	Name string `json:"localizedName"`
+}
+
+// HasService reports whether the warehouse offers the service with the given code (e.g. "gas").
+func (w *Warehouse) HasService(code string) bool {
+	for _, s := range w.Services {
+		if strings.EqualFold(s.Code, code) {
+			return true
+		}
+	}
+	return false
+}
+
+// FormattedAddress returns the warehouse's address on a single line.
+func (w *Warehouse) FormattedAddress() string {
+	street := w.Address1
+	if w.Address2 != "" {
+		street += ", " + w.Address2
+	}
+	return fmt.Sprintf("%s, %s, %s %s", street, w.City, w.State, w.PostalCode)
+}
+
+// FindWarehouses returns warehouses near a ZIP/postal code or a "lat,lng" pair, nearest first.
+// Radius is in miles; 0 uses DefaultWarehouseSearchRadius.
+// The locator is public, so this works without imported tokens.
+//
+// Example:
+//
+//	warehouses, err := client.FindWarehouses(ctx, "98101", 25)
+//	// or: client.FindWarehouses(ctx, "47.6062,-122.3321", 25)
+//	for _, w := range warehouses {
+//	    fmt.Printf("#%s %s (%.1f mi)\n", w.Number, w.Name, w.Distance)
+//	}
+func (c *Client) FindWarehouses(ctx context.Context, zipOrLatLng string, radius float64) ([]Warehouse, error) {
+	location := strings.TrimSpace(zipOrLatLng)
+	if location == "" {
+		return nil, fmt.Errorf("location is required")
+	}
+	if radius <= 0 {
+		radius = DefaultWarehouseSearchRadius
+	}
+
+	params := url.Values{}
+	params.Set("langId", "-1")
+	params.Set("numOfWarehouses", "50")
+	params.Set("distance", strconv.FormatFloat(radius, 'f', -1, 64))
+	params.Set("countryCode", c.countryCode())
+
+	if lat, lng, ok := strings.Cut(location, ","); ok {
+		latitude, err := strconv.ParseFloat(strings.TrimSpace(lat), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid latitude %q: %w", lat, err)
+		}
+		longitude, err := strconv.ParseFloat(strings.TrimSpace(lng), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid longitude %q: %w", lng, err)
+		}
+		params.Set("latitude", strconv.FormatFloat(latitude, 'f', -1, 64))
+		params.Set("longitude", strconv.FormatFloat(longitude, 'f', -1, 64))
+	} else {
+		params.Set("zipCode", location)
+	}
+
+	c.getLogger().Info("finding warehouses",
+		slog.String("location", location),
+		slog.Float64("radius", radius))
+
+	// The locator responds with a status object followed by the warehouses;
+	// entries without a warehouse number are skipped.
+	var result []Warehouse
+	if err := c.executeREST(ctx, http.MethodGet, WarehouseLocatorEndpoint, params, nil, false, &result); err != nil {
+		return nil, err
+	}
+
+	warehouses := make([]Warehouse, 0, len(result))
+	for _, w := range result {
+		if w.Number == "" || w.Distance > radius {
+			continue
+		}
+		warehouses = append(warehouses, w)
+	}
+
+	c.getLogger().Info("found warehouses",
+		slog.String("location", location),
+		slog.Int("warehouse_count", len(warehouses)))
+
+	return warehouses, nil
+}
+
+// GetWarehouse retrieves a single warehouse by number, e.g. the warehouseNumber on a receipt or order.
+//
+// Example:
+//
+//	w, err := client.GetWarehouse(ctx, "847")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("%s - %s\n", w.Name, w.FormattedAddress())
+func (c *Client) GetWarehouse(ctx context.Context, number string) (*Warehouse, error) {
+	if number == "" {
+		return nil, fmt.Errorf("warehouse number is required")
+	}
+
+	c.getLogger().Info("fetching warehouse", slog.String("warehouse_number", number))
+
+	params := url.Values{}
+	params.Set("langId", "-1")
+	params.Set("warehouseNumber", number)
+
+	var warehouse Warehouse
+	if err := c.executeREST(ctx, http.MethodGet, WarehouseDetailEndpoint, params, nil, false, &warehouse); err != nil {
+		return nil, err
+	}
+	if warehouse.Number == "" {
+		return nil, fmt.Errorf("warehouse %s not found", number)
+	}
+
+	return &warehouse, nil
+}
+
+// WarehouseDetails extends Warehouse with department hours and the holiday schedule
+type WarehouseDetails struct {
+	Warehouse
+	PharmacyHours   []string         `json:"pharmacyHours,omitempty"`
+	OpticalHours    []string         `json:"opticalHours,omitempty"`
+	TireCenterHours []string         `json:"tireCenterHours,omitempty"`
+	Holidays        []HolidayClosure `json:"holidayHours"`
+}
+
+// HolidayClosure is a date the warehouse is closed or keeps reduced hours
+type HolidayClosure struct {
+	Date   string `json:"date"` // YYYY-MM-DD
+	Name   string `json:"name"` // e.g. "Thanksgiving Day"
+	Closed bool   `json:"closed"`
+	Hours  string `json:"hours,omitempty"` // Reduced hours when not closed, e.g. "9:00am - 5:00pm"
+}
+
+// HasPharmacy reports whether the warehouse has a pharmacy.
+func (d *WarehouseDetails) HasPharmacy() bool { return d.HasService(ServicePharmacy) }
+
+// HasOptical reports whether the warehouse has an optical department.
+func (d *WarehouseDetails) HasOptical() bool { return d.HasService(ServiceOptical) }
+
+// HasTireCenter reports whether the warehouse has a tire center.
+func (d *WarehouseDetails) HasTireCenter() bool { return d.HasService(ServiceTireCenter) }
+
+// HolidayOn returns the holiday closure or reduced hours on the given day, or nil.
+//
+// Example:
+//
+//	if h := details.HolidayOn(time.Now()); h != nil && h.Closed {
+//	    fmt.Printf("Closed today for %s\n", h.Name)
+//	}
+func (d *WarehouseDetails) HolidayOn(date time.Time) *HolidayClosure {
+	day := date.Format("2006-01-02")
+	for i := range d.Holidays {
+		if d.Holidays[i].Date == day {
+			return &d.Holidays[i]
+		}
+	}
+	return nil
+}
+
+// GetWarehouseDetails retrieves a warehouse with gas station, pharmacy, optical, and
+// tire center hours and the upcoming holiday schedule. Use it to add where/when
+// context to receipts (e.g. receipt.WarehouseNumber).
+//
+// Example:
+//
+//	details, err := client.GetWarehouseDetails(ctx, "847")
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
+//	fmt.Printf("%s: pharmacy=%v tire center=%v\n", details.Name, details.HasPharmacy(), details.HasTireCenter())
+//	for _, h := range details.Holidays {
+//	    fmt.Printf("  %s %s closed=%v\n", h.Date, h.Name, h.Closed)
+//	}
+func (c *Client) GetWarehouseDetails(ctx context.Context, number string) (*WarehouseDetails, error) {
+	if number == "" {
+		return nil, fmt.Errorf("warehouse number is required")
+	}
+
+	c.getLogger().Info("fetching warehouse details", slog.String("warehouse_number", number))
+
+	params := url.Values{}
+	params.Set("langId", "-1")
+	params.Set("warehouseNumber", number)
+	params.Set("includeDepartmentHours", "true")
+	params.Set("includeHolidays", "true")
+
+	var details WarehouseDetails
+	if err := c.executeREST(ctx, http.MethodGet, WarehouseDetailEndpoint, params, nil, false, &details); err != nil {
+		return nil, err
+	}
+	if details.Number == "" {
+		return nil, fmt.Errorf("warehouse %s not found", number)
+	}
+
+	c.getLogger().Info("fetched warehouse details",
+		slog.String("warehouse_number", number),
+		slog.Int("service_count", len(details.Services)),
+		slog.Int("holiday_count", len(details.Holidays)))
+
+	return &details, nil
+}
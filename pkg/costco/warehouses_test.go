@@ -0,0 +1,148 @@
+package costco
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindWarehouses_ZipCode(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/AjaxWarehouseBrowseLookupView", r.URL.Path)
+		assert.Equal(t, "98101", r.URL.Query().Get("zipCode"))
+		assert.Equal(t, "25", r.URL.Query().Get("distance"))
+		assert.Equal(t, "US", r.URL.Query().Get("countryCode"))
+		assert.Empty(t, r.Header.Get(HeaderAuthorization), "locator should be anonymous")
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]map[string]interface{}{
+			{"success": true},
+			{
+				"stlocID":        "1",
+				"displayName":    "Seattle",
+				"address1":       "4401 4th Ave S",
+				"city":           "Seattle",
+				"state":          "WA",
+				"zipCode":        "98134",
+				"distance":       2.4,
+				"warehouseHours": []string{"Mon-Fri. 10:00am - 8:30pm"},
+				"coreServices":   []map[string]string{{"code": "gas", "localizedName": "Gas Station"}},
+			},
+			{"stlocID": "2", "displayName": "Far Away", "distance": 40.0},
+		})
+	})
+
+	warehouses, err := client.FindWarehouses(context.Background(), "98101", 25)
+	require.NoError(t, err)
+	require.Len(t, warehouses, 1, "status entry and out-of-radius warehouses are skipped")
+
+	w := warehouses[0]
+	assert.Equal(t, "1", w.Number)
+	assert.Equal(t, "Seattle", w.Name)
+	assert.Equal(t, "4401 4th Ave S, Seattle, WA 98134", w.FormattedAddress())
+	assert.Equal(t, []string{"Mon-Fri. 10:00am - 8:30pm"}, w.Hours)
+	assert.True(t, w.HasService("GAS"))
+	assert.False(t, w.HasService("pharmacy"))
+}
+
+func TestFindWarehouses_LatLng(t *testing.T) {
+	client := newMockClient(t, Config{Locale: LocaleEnCA}, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "49.2827", q.Get("latitude"))
+		assert.Equal(t, "-123.1207", q.Get("longitude"))
+		assert.Empty(t, q.Get("zipCode"))
+		assert.Equal(t, "50", q.Get("distance"), "zero radius uses the default")
+		assert.Equal(t, "CA", q.Get("countryCode"))
+		w.Write([]byte("[]"))
+	})
+
+	warehouses, err := client.FindWarehouses(context.Background(), "49.2827, -123.1207", 0)
+	require.NoError(t, err)
+	assert.Empty(t, warehouses)
+}
+
+func TestFindWarehouses_InvalidInput(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("no request expected")
+	})
+
+	_, err := client.FindWarehouses(context.Background(), "", 10)
+	assert.Error(t, err)
+
+	_, err = client.FindWarehouses(context.Background(), "north,-122.3", 10)
+	assert.ErrorContains(t, err, "invalid latitude")
+}
+
+func TestGetWarehouse(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/AjaxWarehouseDetailView", r.URL.Path)
+		if r.URL.Query().Get("warehouseNumber") != "847" {
+			w.Write([]byte("{}"))
+			return
+		}
+		w.Write([]byte(`{"stlocID":"847","displayName":"Issaquah","city":"Issaquah","state":"WA"}`))
+	})
+
+	warehouse, err := client.GetWarehouse(context.Background(), "847")
+	require.NoError(t, err)
+	assert.Equal(t, "Issaquah", warehouse.Name)
+
+	_, err = client.GetWarehouse(context.Background(), "99999")
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestGetWarehouseDetails(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/AjaxWarehouseDetailView", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("includeHolidays"))
+		w.Write([]byte(`{
+			"stlocID": "847",
+			"displayName": "Issaquah",
+			"gasStationHours": ["Mon-Fri. 6:00am - 9:30pm"],
+			"pharmacyHours": ["Mon-Fri. 10:00am - 7:00pm"],
+			"coreServices": [
+				{"code": "gas", "localizedName": "Gas Station"},
+				{"code": "pharmacy", "localizedName": "Pharmacy"},
+				{"code": "tire", "localizedName": "Tire Center"}
+			],
+			"holidayHours": [
+				{"date": "2026-11-26", "name": "Thanksgiving Day", "closed": true},
+				{"date": "2026-12-24", "name": "Christmas Eve", "hours": "9:00am - 5:00pm"}
+			]
+		}`))
+	})
+
+	details, err := client.GetWarehouseDetails(context.Background(), "847")
+	require.NoError(t, err)
+
+	assert.Equal(t, "Issaquah", details.Name)
+	assert.Equal(t, []string{"Mon-Fri. 6:00am - 9:30pm"}, details.GasHours)
+	assert.True(t, details.HasPharmacy())
+	assert.True(t, details.HasTireCenter())
+	assert.False(t, details.HasOptical())
+
+	thanksgiving := details.HolidayOn(time.Date(2026, 11, 26, 12, 0, 0, 0, time.UTC))
+	require.NotNil(t, thanksgiving)
+	assert.True(t, thanksgiving.Closed)
+
+	eve := details.HolidayOn(time.Date(2026, 12, 24, 8, 0, 0, 0, time.UTC))
+	require.NotNil(t, eve)
+	assert.False(t, eve.Closed)
+	assert.Equal(t, "9:00am - 5:00pm", eve.Hours)
+
+	assert.Nil(t, details.HolidayOn(time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestGetWarehouseDetails_NotFound(t *testing.T) {
+	client := newMockClient(t, Config{}, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{}`))
+	})
+
+	_, err := client.GetWarehouseDetails(context.Background(), "99999")
+	assert.ErrorContains(t, err, "not found")
+}
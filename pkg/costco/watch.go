@@ -0,0 +1,124 @@
+package costco
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Hot-reload of config and token files
+
+// DefaultWatchInterval is how often Watch polls the config directory.
+const DefaultWatchInterval = 30 * time.Second
+
+// WatchOptions configures Client.Watch.
+type WatchOptions struct {
+	Interval       time.Duration       // Poll interval (default: 30s)
+	OnConfigChange func(*StoredConfig) // Called with the reloaded config when config.json changes (optional)
+}
+
+// Watch polls ~/.costco for changes to tokens.json and config.json until ctx is done.
+// When another process rotates the tokens (e.g. a CLI import or a second client
+// refreshing), the new tokens are loaded into this client so long-running daemons
+// keep working without a restart. Config changes are passed to OnConfigChange.
+//
+// Watch blocks; run it in its own goroutine. It returns ctx.Err() when ctx is done.
+//
+// Example:
+//
+//	ctx, cancel := context.WithCancel(context.Background())
+//	defer cancel()
+//	go client.Watch(ctx, costco.WatchOptions{Interval: time.Minute})
+func (c *Client) Watch(ctx context.Context, opts WatchOptions) error {
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultWatchInterval
+	}
+
+	configPath, err := getConfigPath()
+	if err != nil {
+		return err
+	}
+	tokenPath := filepath.Join(configPath, tokenFile)
+	cfgPath := filepath.Join(configPath, configFile)
+
+	tokenMod := modTime(tokenPath)
+	cfgMod := modTime(cfgPath)
+
+	c.getLogger().Debug("watching config directory", slog.String("path", configPath), slog.Duration("interval", opts.Interval))
+
+	ticker := time.NewTicker(opts.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		if mod := modTime(tokenPath); !mod.Equal(tokenMod) {
+			tokenMod = mod
+			c.reloadTokens()
+		}
+
+		if mod := modTime(cfgPath); !mod.Equal(cfgMod) {
+			cfgMod = mod
+			c.reloadConfig(opts.OnConfigChange)
+		}
+	}
+}
+
+// reloadTokens loads tokens.json into the client if it holds a different token.
+func (c *Client) reloadTokens() {
+	tokens, err := LoadTokens()
+	if err != nil {
+		c.getLogger().Warn("failed to reload tokens", slog.String("error", err.Error()))
+		return
+	}
+	if tokens == nil {
+		return
+	}
+
+	c.mu.Lock()
+	changed := c.token == nil || c.token.IDToken != tokens.IDToken || c.token.RefreshToken != tokens.RefreshToken
+	if changed {
+		c.token = &TokenResponse{
+			IDToken:      tokens.IDToken,
+			RefreshToken: tokens.RefreshToken,
+		}
+		c.tokenExpiry = tokens.TokenExpiry
+	}
+	c.mu.Unlock()
+
+	if changed {
+		c.getLogger().Info("tokens reloaded from disk", slog.Time("token_expiry", tokens.TokenExpiry))
+	}
+}
+
+// reloadConfig loads config.json and hands it to onChange.
+func (c *Client) reloadConfig(onChange func(*StoredConfig)) {
+	config, err := LoadConfig()
+	if err != nil {
+		c.getLogger().Warn("failed to reload config", slog.String("error", err.Error()))
+		return
+	}
+	if config == nil {
+		return
+	}
+
+	c.getLogger().Info("config reloaded from disk")
+	if onChange != nil {
+		onChange(config)
+	}
+}
+
+// modTime returns the file's modification time, or the zero time if it doesn't exist.
+func modTime(path string) time.Time {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
@@ -0,0 +1,89 @@
+package costco
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatch_ReloadsRotatedTokens(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
+	require.NoError(t, SaveTokens(&StoredTokens{
+		IDToken:      "old-id",
+		RefreshToken: "old-refresh",
+		TokenExpiry:  time.Now().Add(1 * time.Hour),
+	}))
+
+	client := NewClient(Config{})
+	require.Equal(t, "old-id", client.token.IDToken)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- client.Watch(ctx, WatchOptions{Interval: 10 * time.Millisecond}) }()
+
+	// Another process rotates the tokens
+	time.Sleep(30 * time.Millisecond)
+	expiry := time.Now().Add(2 * time.Hour)
+	require.NoError(t, SaveTokens(&StoredTokens{
+		IDToken:      "new-id",
+		RefreshToken: "new-refresh",
+		TokenExpiry:  expiry,
+	}))
+	bumpModTime(t, tokenFile)
+
+	assert.Eventually(t, func() bool {
+		client.mu.RLock()
+		defer client.mu.RUnlock()
+		return client.token != nil && client.token.IDToken == "new-id"
+	}, time.Second, 10*time.Millisecond)
+
+	client.mu.RLock()
+	assert.Equal(t, "new-refresh", client.token.RefreshToken)
+	assert.WithinDuration(t, expiry, client.tokenExpiry, time.Second)
+	client.mu.RUnlock()
+
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+}
+
+func TestWatch_ReportsConfigChanges(t *testing.T) {
+	cleanup := SetupTestConfig(t)
+	defer cleanup()
+
+	client := NewClient(Config{})
+
+	changes := make(chan *StoredConfig, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go client.Watch(ctx, WatchOptions{
+		Interval:       10 * time.Millisecond,
+		OnConfigChange: func(c *StoredConfig) { changes <- c },
+	})
+
+	time.Sleep(30 * time.Millisecond)
+	require.NoError(t, SaveConfig(&StoredConfig{Email: "test@example.com", WarehouseNumber: "1234"}))
+
+	select {
+	case config := <-changes:
+		assert.Equal(t, "1234", config.WarehouseNumber)
+	case <-time.After(time.Second):
+		t.Fatal("config change was not reported")
+	}
+}
+
+// bumpModTime moves a config file's mtime forward so coarse filesystem
+// timestamps can't hide a rewrite from the watcher.
+func bumpModTime(t *testing.T, name string) {
+	t.Helper()
+	configPath, err := getConfigPath()
+	require.NoError(t, err)
+	future := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(filepath.Join(configPath, name), future, future))
+}
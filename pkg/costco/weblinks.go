@@ -0,0 +1,32 @@
+package costco
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Links to orders and receipts on costco.com
+
+// OrderURL returns the costco.com page of an online order, on costco.ca for RegionCA.
+//
+// Example:
+//
+//	fmt.Println(costco.OrderURL("1234567890", costco.RegionUS))
+//	// https://www.costco.com/OrderStatusDetailsView?orderId=1234567890
+func OrderURL(orderNumber string, region Region) string {
+	return regionPage(OrderDetailsPage, region) + "?orderId=" + url.QueryEscape(orderNumber)
+}
+
+// ReceiptsURL returns the costco.com page listing warehouse and gas station receipts,
+// on costco.ca for RegionCA. Costco has no page for a single receipt; find it in the
+// list by its date.
+func ReceiptsURL(region Region) string {
+	return regionPage(OrdersAndPurchasesPage, region)
+}
+
+func regionPage(page string, region Region) string {
+	if region == RegionCA {
+		return strings.Replace(page, "www.costco.com", "www.costco.ca", 1)
+	}
+	return page
+}
@@ -0,0 +1,15 @@
+package costco
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebLinks(t *testing.T) {
+	assert.Equal(t, "https://www.costco.com/OrderStatusDetailsView?orderId=1234567890", OrderURL("1234567890", RegionUS))
+	assert.Equal(t, "https://www.costco.ca/OrderStatusDetailsView?orderId=ORD+1", OrderURL("ORD 1", RegionCA))
+	assert.Equal(t, OrdersAndPurchasesPage, ReceiptsURL(""))
+	assert.Contains(t, ReceiptsURL(RegionCA), "https://www.costco.ca/myaccount/")
+	assert.Equal(t, RegionCA, LocaleFrCA.Region())
+}